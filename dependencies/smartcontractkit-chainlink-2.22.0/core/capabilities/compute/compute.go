@@ -100,6 +100,14 @@ type Compute struct {
 	maxResponseSizeBytes uint64
 	queue                chan request
 	wg                   sync.WaitGroup
+
+	// usageReporter, if set, receives the resources used by each execution.
+	usageReporter UsageReporter
+	defaultQuota  OwnerQuota
+	ownerQuotas   map[string]OwnerQuota
+
+	metersMu sync.Mutex
+	meters   map[string]*executionMeter
 }
 
 func (c *Compute) RegisterToWorkflow(ctx context.Context, request capabilities.RegisterToWorkflowRequest) error {
@@ -110,9 +118,17 @@ func (c *Compute) UnregisterFromWorkflow(ctx context.Context, request capabiliti
 	return nil
 }
 
-func generateID(binary []byte) string {
-	id := sha256.Sum256(binary)
-	return fmt.Sprintf("%x", id)
+// generateID returns the ID of the module of a binary. The memory and timeout
+// quotas of an owner are applied when its module is created, so modules are
+// not shared between owners: a module created for an owner with a low quota
+// would otherwise throttle the other owners running the same binary, and a
+// module created for an owner with a high quota would let the others exceed
+// theirs.
+func generateID(owner string, binary []byte) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(normalizeOwner(owner)))
+	_, _ = h.Write(binary)
+	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
 func (c *Compute) Execute(ctx context.Context, request capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
@@ -166,7 +182,15 @@ func (c *Compute) execute(ctx context.Context, respCh chan response, req capabil
 		return
 	}
 
-	id := generateID(cfg.Binary)
+	quota := c.quotaFor(copiedReq.Metadata.WorkflowOwner)
+	if quota.MaxMemoryMBs > 0 && cfg.ModuleConfig.MaxMemoryMBs > quota.MaxMemoryMBs {
+		cfg.ModuleConfig.MaxMemoryMBs = quota.MaxMemoryMBs
+	}
+	if quota.MaxTimeout > 0 && *cfg.ModuleConfig.Timeout > quota.MaxTimeout {
+		cfg.ModuleConfig.Timeout = &quota.MaxTimeout
+	}
+
+	id := generateID(copiedReq.Metadata.WorkflowOwner, cfg.Binary)
 
 	m, ok := c.modules.get(id)
 	if !ok {
@@ -179,7 +203,28 @@ func (c *Compute) execute(ctx context.Context, respCh chan response, req capabil
 		m = mod
 	}
 
+	meter := c.startMetering(copiedReq.Metadata)
+	defer c.stopMetering(copiedReq.Metadata)
+	fetchRequests, fetchBytes := meter.snapshot()
+
+	executeStart := time.Now()
 	resp, err := c.executeWithModule(ctx, m.module, cfg.Config, copiedReq)
+	executionTime := time.Since(executeStart)
+
+	// the module may have ignored the error of a fetch that exceeded the quota
+	if qerr := meter.err(); qerr != nil {
+		resp, err = capabilities.CapabilityResponse{}, qerr
+	}
+
+	if c.usageReporter != nil {
+		totalRequests, totalBytes := meter.snapshot()
+		c.usageReporter.ReportComputeUsage(copiedReq.Metadata, Usage{
+			ExecutionTime: executionTime,
+			FetchRequests: totalRequests - fetchRequests,
+			FetchBytes:    totalBytes - fetchBytes,
+		})
+	}
+
 	select {
 	case <-c.stopCh:
 	case <-ctx.Done():
@@ -190,7 +235,7 @@ func (c *Compute) execute(ctx context.Context, respCh chan response, req capabil
 func (c *Compute) initModule(id string, cfg *host.ModuleConfig, binary []byte, requestMetadata capabilities.RequestMetadata) (*module, error) {
 	initStart := time.Now()
 
-	cfg.Fetch = c.fetcherFactory.NewFetcher(c.log, c.emitter)
+	cfg.Fetch = c.meteredFetcher(c.fetcherFactory.NewFetcher(c.log, c.emitter))
 
 	cfg.MaxResponseSizeBytes = c.maxResponseSizeBytes
 	mod, err := host.NewModule(cfg, binary)
@@ -409,6 +454,12 @@ type Config struct {
 	MaxCompressedBinarySize   uint64
 	MaxDecompressedBinarySize uint64
 	MaxResponseSizeBytes      uint64
+
+	// DefaultOwnerQuota applies to the workflow owners without a quota of
+	// their own in OwnerQuotas.
+	DefaultOwnerQuota OwnerQuota
+	// OwnerQuotas are keyed by workflow owner address.
+	OwnerQuotas map[string]OwnerQuota
 }
 
 func (c *Config) ApplyDefaults() {
@@ -444,6 +495,11 @@ func NewAction(
 ) (*Compute, error) {
 	config.ApplyDefaults()
 
+	ownerQuotas := make(map[string]OwnerQuota, len(config.OwnerQuotas))
+	for owner, quota := range config.OwnerQuotas {
+		ownerQuotas[normalizeOwner(owner)] = quota
+	}
+
 	var (
 		lggr    = logger.Named(log, "CustomCompute")
 		labeler = custmsg.NewLabeler()
@@ -458,6 +514,9 @@ func NewAction(
 			queue:                make(chan request),
 			numWorkers:           config.NumWorkers,
 			maxResponseSizeBytes: config.MaxResponseSizeBytes,
+			defaultQuota:         config.DefaultOwnerQuota,
+			ownerQuotas:          ownerQuotas,
+			meters:               map[string]*executionMeter{},
 		}
 	)
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/jonboulle/clockwork"
@@ -304,6 +305,167 @@ func TestComputeFetchMaxResponseSizeBytes(t *testing.T) {
 	require.ErrorContains(t, err, fmt.Sprintf("response size %d exceeds maximum allowed size %d", 2056, 1*1024))
 }
 
+type usageRecorder struct {
+	mu     sync.Mutex
+	usages []Usage
+}
+
+func (r *usageRecorder) ReportComputeUsage(_ cappkg.RequestMetadata, usage Usage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.usages = append(r.usages, usage)
+}
+
+func (r *usageRecorder) get() []Usage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.usages
+}
+
+func TestComputeExecuteReportsUsage(t *testing.T) {
+	t.Parallel()
+	config := defaultConfig
+	config.DefaultOwnerQuota = OwnerQuota{MaxMemoryMBs: 64}
+	th := setup(t, config)
+	recorder := &usageRecorder{}
+	th.compute.usageReporter = recorder
+
+	require.NoError(t, th.compute.Start(tests.Context(t)))
+
+	binary := wasmtest.CreateTestBinary(simpleBinaryCmd, simpleBinaryLocation, true, t)
+	config2, err := values.WrapMap(map[string]any{
+		"config": []byte(""),
+		"binary": binary,
+	})
+	require.NoError(t, err)
+	inputs, err := values.WrapMap(map[string]any{
+		"arg0": map[string]any{
+			"cool_output": "foo",
+		},
+	})
+	require.NoError(t, err)
+	req := cappkg.CapabilityRequest{
+		Inputs: inputs,
+		Config: config2,
+		Metadata: cappkg.RequestMetadata{
+			WorkflowID:    "workflowID",
+			WorkflowOwner: "owner",
+			ReferenceID:   "compute",
+		},
+	}
+	_, err = th.compute.Execute(tests.Context(t), req)
+	require.NoError(t, err)
+
+	usages := recorder.get()
+	require.Len(t, usages, 1)
+	assert.Positive(t, usages[0].ExecutionTime)
+	assert.Zero(t, usages[0].FetchRequests)
+}
+
+// Modules are configured with the memory and timeout quota of the owner they
+// are first executed for, so the same binary gets a module per owner.
+func TestComputeModulesArePerOwner(t *testing.T) {
+	t.Parallel()
+	config := defaultConfig
+	config.OwnerQuotas = map[string]OwnerQuota{
+		"0xABCDEF": {MaxMemoryMBs: 64},
+	}
+	th := setup(t, config)
+
+	require.NoError(t, th.compute.Start(tests.Context(t)))
+
+	binary := wasmtest.CreateTestBinary(simpleBinaryCmd, simpleBinaryLocation, true, t)
+	assert.Equal(t, generateID("0xABCDEF", binary), generateID("abcdef", binary))
+	assert.NotEqual(t, generateID("abcdef", binary), generateID("123456", binary))
+
+	execute := func(owner string) {
+		config2, err := values.WrapMap(map[string]any{
+			"config": []byte(""),
+			"binary": binary,
+		})
+		require.NoError(t, err)
+		inputs, err := values.WrapMap(map[string]any{
+			"arg0": map[string]any{
+				"cool_output": "foo",
+			},
+		})
+		require.NoError(t, err)
+		_, err = th.compute.Execute(tests.Context(t), cappkg.CapabilityRequest{
+			Inputs: inputs,
+			Config: config2,
+			Metadata: cappkg.RequestMetadata{
+				WorkflowID:    "workflowID",
+				WorkflowOwner: owner,
+				ReferenceID:   "compute",
+			},
+		})
+		require.NoError(t, err)
+	}
+	execute("abcdef")
+	execute("123456")
+
+	limited, ok := th.compute.modules.get(generateID("abcdef", binary))
+	require.True(t, ok)
+	unlimited, ok := th.compute.modules.get(generateID("123456", binary))
+	require.True(t, ok)
+	assert.NotSame(t, limited.module, unlimited.module)
+}
+
+func TestComputeFetchQuotaExceeded(t *testing.T) {
+	t.Parallel()
+	workflowID := "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
+	workflowExecutionID := "95ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0abbadeed"
+	config := defaultConfig
+	config.OwnerQuotas = map[string]OwnerQuota{
+		"0xABCDEF": {MaxFetchBytes: 4},
+	}
+	th := setup(t, config)
+	recorder := &usageRecorder{}
+	th.compute.usageReporter = recorder
+
+	th.connector.EXPECT().DonID().Return("don-id")
+	th.connector.EXPECT().AwaitConnection(matches.AnyContext, "gateway1").Return(nil)
+	th.connector.EXPECT().GatewayIDs().Return([]string{"gateway1", "gateway2"})
+
+	msgID := strings.Join([]string{
+		workflowExecutionID,
+		ghcapabilities.MethodComputeAction,
+		validRequestUUID,
+	}, "/")
+
+	gatewayResp := gatewayResponse(t, msgID, []byte("response body"))
+	th.connector.On("SignAndSendToGateway", mock.Anything, "gateway1", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		th.connectorHandler.HandleGatewayMessage(context.Background(), "gateway1", gatewayResp)
+	}).Once()
+
+	require.NoError(t, th.compute.Start(tests.Context(t)))
+
+	binary := wasmtest.CreateTestBinary(fetchBinaryCmd, fetchBinaryLocation, true, t)
+	config2, err := values.WrapMap(map[string]any{
+		"config": []byte(""),
+		"binary": binary,
+	})
+	require.NoError(t, err)
+
+	req := cappkg.CapabilityRequest{
+		Config: config2,
+		Metadata: cappkg.RequestMetadata{
+			WorkflowID:          workflowID,
+			WorkflowExecutionID: workflowExecutionID,
+			WorkflowOwner:       "abcdef",
+			ReferenceID:         "compute",
+		},
+	}
+
+	_, err = th.compute.Execute(tests.Context(t), req)
+	require.ErrorIs(t, err, ErrQuotaExceeded)
+	require.ErrorContains(t, err, "more than 4 fetch bytes")
+
+	usages := recorder.get()
+	require.Len(t, usages, 1)
+	assert.Equal(t, uint32(1), usages[0].FetchRequests)
+}
+
 func gatewayResponse(t *testing.T, msgID string, body []byte) *api.Message {
 	headers := map[string]string{"Content-Type": "application/json"}
 	responsePayload, err := json.Marshal(ghcapabilities.Response{
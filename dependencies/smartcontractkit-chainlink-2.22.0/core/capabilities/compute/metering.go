@@ -0,0 +1,194 @@
+package compute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	wasmpb "github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/pb"
+)
+
+var computeQuotaExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "compute_quota_exceeded",
+	Help: "number of compute executions that exceeded the quota of their workflow owner",
+}, []string{"workflowOwner", "quota"})
+
+var ErrQuotaExceeded = errors.New("compute quota exceeded")
+
+// OwnerQuota limits the resources used by each compute execution of the
+// workflows of an owner. MaxMemoryMBs and MaxTimeout can only lower the global
+// limits of the Config; zero fetch limits are unlimited.
+type OwnerQuota struct {
+	MaxMemoryMBs     uint64
+	MaxTimeout       time.Duration
+	MaxFetchRequests uint32
+	MaxFetchBytes    uint64
+}
+
+// Usage is the resources used by a single compute execution.
+//
+// Fuel and peak memory are not metered yet: the WASM host neither reports the
+// fuel a module consumed nor its peak memory. Memory is only bounded by the
+// MaxMemoryMBs quota the module is created with, and fuel by the limits of the
+// host.
+// TODO: meter fuel and peak memory once host.Module reports them.
+type Usage struct {
+	// ExecutionTime is the time spent running the module.
+	ExecutionTime time.Duration
+	// FetchRequests is the number of outgoing requests made by the module.
+	FetchRequests uint32
+	// FetchBytes is the size of the bodies of the outgoing requests and of
+	// their responses.
+	FetchBytes uint64
+}
+
+// UsageReporter receives the resources used by each compute execution.
+type UsageReporter interface {
+	ReportComputeUsage(md capabilities.RequestMetadata, usage Usage)
+}
+
+// WithUsageReporter sets the reporter of the resources used by executions.
+func WithUsageReporter(r UsageReporter) func(*Compute) {
+	return func(c *Compute) {
+		c.usageReporter = r
+	}
+}
+
+// quotaFor returns the quota of a workflow owner.
+func (c *Compute) quotaFor(owner string) OwnerQuota {
+	if q, ok := c.ownerQuotas[normalizeOwner(owner)]; ok {
+		return q
+	}
+	return c.defaultQuota
+}
+
+func normalizeOwner(owner string) string {
+	return strings.TrimPrefix(strings.ToLower(owner), "0x")
+}
+
+// executionMeter tracks the fetches of an execution against the quota of its
+// workflow owner. It is shared by the compute steps of the execution that run
+// concurrently.
+type executionMeter struct {
+	owner string
+	quota OwnerQuota
+
+	mu            sync.Mutex
+	refs          int
+	fetchRequests uint32
+	fetchBytes    uint64
+	exceeded      error
+}
+
+func (m *executionMeter) snapshot() (uint32, uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fetchRequests, m.fetchBytes
+}
+
+// err returns the quota breach of the execution, if any.
+func (m *executionMeter) err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.exceeded
+}
+
+func (m *executionMeter) breach(quota string, format string, args ...any) error {
+	err := fmt.Errorf("%w: %s", ErrQuotaExceeded, fmt.Sprintf(format, args...))
+	if m.exceeded == nil {
+		m.exceeded = err
+		computeQuotaExceeded.WithLabelValues(m.owner, quota).Inc()
+	}
+	return err
+}
+
+func (m *executionMeter) startFetch(reqBytes int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.exceeded != nil {
+		return m.exceeded
+	}
+	if m.quota.MaxFetchRequests > 0 && m.fetchRequests+1 > m.quota.MaxFetchRequests {
+		return m.breach("fetch_requests", "more than %d fetch requests", m.quota.MaxFetchRequests)
+	}
+	m.fetchRequests++
+	return m.addFetchBytes(reqBytes)
+}
+
+func (m *executionMeter) endFetch(respBytes int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.addFetchBytes(respBytes)
+}
+
+// addFetchBytes must be called with mu held.
+func (m *executionMeter) addFetchBytes(n int) error {
+	m.fetchBytes += uint64(n) //nolint:gosec // n is the length of a slice
+	if m.quota.MaxFetchBytes > 0 && m.fetchBytes > m.quota.MaxFetchBytes {
+		return m.breach("fetch_bytes", "more than %d fetch bytes", m.quota.MaxFetchBytes)
+	}
+	return nil
+}
+
+// startMetering returns the meter of the execution of a request.
+func (c *Compute) startMetering(md capabilities.RequestMetadata) *executionMeter {
+	c.metersMu.Lock()
+	defer c.metersMu.Unlock()
+	m, ok := c.meters[md.WorkflowExecutionID]
+	if !ok {
+		m = &executionMeter{owner: md.WorkflowOwner, quota: c.quotaFor(md.WorkflowOwner)}
+		c.meters[md.WorkflowExecutionID] = m
+	}
+	m.refs++
+	return m
+}
+
+func (c *Compute) stopMetering(md capabilities.RequestMetadata) {
+	c.metersMu.Lock()
+	defer c.metersMu.Unlock()
+	m, ok := c.meters[md.WorkflowExecutionID]
+	if !ok {
+		return
+	}
+	m.refs--
+	if m.refs <= 0 {
+		delete(c.meters, md.WorkflowExecutionID)
+	}
+}
+
+func (c *Compute) meterFor(executionID string) (*executionMeter, bool) {
+	c.metersMu.Lock()
+	defer c.metersMu.Unlock()
+	m, ok := c.meters[executionID]
+	return m, ok
+}
+
+// meteredFetcher counts the requests made with fetch, and fails them once the
+// execution they are made for exceeds its quota.
+func (c *Compute) meteredFetcher(fetch FetcherFn) FetcherFn {
+	return func(ctx context.Context, req *wasmpb.FetchRequest) (*wasmpb.FetchResponse, error) {
+		m, ok := c.meterFor(req.GetMetadata().GetWorkflowExecutionId())
+		if !ok {
+			return fetch(ctx, req)
+		}
+
+		if err := m.startFetch(len(req.Body)); err != nil {
+			return nil, err
+		}
+		resp, err := fetch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err = m.endFetch(len(resp.Body)); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
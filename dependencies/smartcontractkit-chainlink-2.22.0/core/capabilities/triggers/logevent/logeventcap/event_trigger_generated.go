@@ -5,9 +5,13 @@ package logeventcap
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 )
 
 type Config struct {
+	// Confidence corresponds to the JSON schema field "confidence".
+	Confidence ConfigConfidence `json:"confidence,omitempty" yaml:"confidence,omitempty" mapstructure:"confidence,omitempty"`
+
 	// ContractAddress corresponds to the JSON schema field "contractAddress".
 	ContractAddress string `json:"contractAddress" yaml:"contractAddress" mapstructure:"contractAddress"`
 
@@ -20,8 +24,16 @@ type Config struct {
 	// ContractReaderConfig corresponds to the JSON schema field
 	// "contractReaderConfig".
 	ContractReaderConfig ConfigContractReaderConfig `json:"contractReaderConfig" yaml:"contractReaderConfig" mapstructure:"contractReaderConfig"`
+
+	// Delivery corresponds to the JSON schema field "delivery".
+	Delivery ConfigDelivery `json:"delivery,omitempty" yaml:"delivery,omitempty" mapstructure:"delivery,omitempty"`
 }
 
+type ConfigConfidence string
+
+const ConfigConfidenceFinalized ConfigConfidence = "finalized"
+const ConfigConfidenceUnconfirmed ConfigConfidence = "unconfirmed"
+
 type ConfigContractReaderConfig struct {
 	// Contracts corresponds to the JSON schema field "contracts".
 	Contracts ConfigContractReaderConfigContracts `json:"contracts" yaml:"contracts" mapstructure:"contracts"`
@@ -29,6 +41,36 @@ type ConfigContractReaderConfig struct {
 
 type ConfigContractReaderConfigContracts map[string]interface{}
 
+type ConfigDelivery string
+
+const ConfigDeliveryAtLeastOnce ConfigDelivery = "atLeastOnce"
+const ConfigDeliveryExactlyOnce ConfigDelivery = "exactlyOnce"
+
+var enumValues_ConfigConfidence = []interface{}{
+	"finalized",
+	"unconfirmed",
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *ConfigConfidence) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	var ok bool
+	for _, expected := range enumValues_ConfigConfidence {
+		if reflect.DeepEqual(v, expected) {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid value (expected one of %#v): %#v", enumValues_ConfigConfidence, v)
+	}
+	*j = ConfigConfidence(v)
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *ConfigContractReaderConfig) UnmarshalJSON(b []byte) error {
 	var raw map[string]interface{}
@@ -47,6 +89,31 @@ func (j *ConfigContractReaderConfig) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+var enumValues_ConfigDelivery = []interface{}{
+	"atLeastOnce",
+	"exactlyOnce",
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *ConfigDelivery) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	var ok bool
+	for _, expected := range enumValues_ConfigDelivery {
+		if reflect.DeepEqual(v, expected) {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid value (expected one of %#v): %#v", enumValues_ConfigDelivery, v)
+	}
+	*j = ConfigDelivery(v)
+	return nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *Config) UnmarshalJSON(b []byte) error {
 	var raw map[string]interface{}
@@ -70,6 +137,9 @@ func (j *Config) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &plain); err != nil {
 		return err
 	}
+	if v, ok := raw["confidence"]; !ok || v == nil {
+		plain.Confidence = "finalized"
+	}
 	if len(plain.ContractAddress) < 1 {
 		return fmt.Errorf("field %s length: must be >= %d", "contractAddress", 1)
 	}
@@ -79,6 +149,9 @@ func (j *Config) UnmarshalJSON(b []byte) error {
 	if len(plain.ContractName) < 1 {
 		return fmt.Errorf("field %s length: must be >= %d", "contractName", 1)
 	}
+	if v, ok := raw["delivery"]; !ok || v == nil {
+		plain.Delivery = "atLeastOnce"
+	}
 	*j = Config(plain)
 	return nil
 }
@@ -133,6 +206,9 @@ type Output struct {
 
 	// Head corresponds to the JSON schema field "Head".
 	Head Head `json:"Head" yaml:"Head" mapstructure:"Head"`
+
+	// Retracted corresponds to the JSON schema field "Retracted".
+	Retracted bool `json:"Retracted,omitempty" yaml:"Retracted,omitempty" mapstructure:"Retracted,omitempty"`
 }
 
 type OutputData map[string]interface{}
@@ -160,6 +236,9 @@ func (j *Output) UnmarshalJSON(b []byte) error {
 	if len(plain.Cursor) < 1 {
 		return fmt.Errorf("field %s length: must be >= %d", "Cursor", 1)
 	}
+	if v, ok := raw["Retracted"]; !ok || v == nil {
+		plain.Retracted = false
+	}
 	*j = Output(plain)
 	return nil
 }
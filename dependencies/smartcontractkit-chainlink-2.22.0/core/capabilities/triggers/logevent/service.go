@@ -31,6 +31,7 @@ type TriggerService struct {
 	triggers       CapabilitiesStore[logEventTrigger, capabilities.TriggerResponse]
 	relayer        core.Relayer
 	logEventConfig Config
+	states         stateStore
 	stopCh         services.StopChan
 }
 
@@ -52,10 +53,14 @@ var _ services.Service = &TriggerService{}
 
 // Creates a new Cron Trigger Service.
 // Scheduling will commence on calling .Start()
+// The progress of the triggers is persisted in store, so that they resume
+// where they left off after a restart, until they are unregistered. A nil
+// store keeps it in memory only.
 func NewTriggerService(ctx context.Context,
 	lggr logger.Logger,
 	relayer core.Relayer,
-	logEventConfig Config) (*TriggerService, error) {
+	logEventConfig Config,
+	store core.KeyValueStore) (*TriggerService, error) {
 	l := logger.Named(lggr, "LogEventTriggerCapabilityService")
 
 	logEventStore := NewCapabilitiesStore[logEventTrigger, capabilities.TriggerResponse]()
@@ -65,6 +70,7 @@ func NewTriggerService(ctx context.Context,
 		triggers:       logEventStore,
 		relayer:        relayer,
 		logEventConfig: logEventConfig,
+		states:         stateStore{kv: store},
 		stopCh:         make(services.StopChan),
 	}
 	var err error
@@ -99,7 +105,7 @@ func (s *TriggerService) RegisterTrigger(ctx context.Context,
 	var respCh chan capabilities.TriggerResponse
 	ok := s.IfNotStopped(func() {
 		respCh, err = s.triggers.InsertIfNotExists(req.TriggerID, func() (*logEventTrigger, chan capabilities.TriggerResponse, error) {
-			l, ch, tErr := newLogEventTrigger(ctx, s.lggr, req.Metadata.WorkflowID, reqConfig, s.logEventConfig, s.relayer)
+			l, ch, tErr := newLogEventTrigger(ctx, s.lggr, req.Metadata.WorkflowID, req.TriggerID, reqConfig, s.logEventConfig, s.relayer, s.states)
 			if tErr != nil {
 				return l, ch, tErr
 			}
@@ -129,6 +135,10 @@ func (s *TriggerService) UnregisterTrigger(ctx context.Context, req capabilities
 	}
 	// Remove from triggers context
 	s.triggers.Delete(req.TriggerID)
+	// Forget its progress, so that registering it again starts afresh
+	if err = s.states.delete(ctx, req.TriggerID); err != nil {
+		return fmt.Errorf("error deleting state of trigger %s (chainID %s): %w", req.TriggerID, s.logEventConfig.ChainID, err)
+	}
 	s.lggr.Infow("UnregisterTrigger", "triggerId", req.TriggerID, "WorkflowID", req.Metadata.WorkflowID)
 	return nil
 }
@@ -0,0 +1,129 @@
+package logevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+)
+
+// triggerState is the progress of a log event trigger. It is persisted in the
+// KeyValueStore of the capability, so that a trigger resumes where it left off
+// after a restart instead of looking back from the latest head again.
+type triggerState struct {
+	// Cursor of the last log read from the ContractReader.
+	Cursor string `json:"cursor"`
+	// Height of the block of the last log read from the ContractReader.
+	Height uint64 `json:"height"`
+	// Unfinalized are the logs delivered with unconfirmed confidence that can
+	// still be reorged out.
+	Unfinalized []unfinalizedLog `json:"unfinalized,omitempty"`
+	// Delivered are the logs delivered at or after the lowest height that can
+	// be read again, kept to deduplicate exactly-once deliveries.
+	Delivered []deliveredLog `json:"delivered,omitempty"`
+}
+
+type unfinalizedLog struct {
+	Cursor string `json:"cursor"`
+	Height uint64 `json:"height"`
+	// Outputs is the protobuf encoded outputs of the delivered event, used to
+	// build its retraction event.
+	Outputs []byte `json:"outputs"`
+}
+
+type deliveredLog struct {
+	Cursor string `json:"cursor"`
+	Height uint64 `json:"height"`
+}
+
+func (s *triggerState) isUnfinalized(cursor string) bool {
+	for _, u := range s.Unfinalized {
+		if u.Cursor == cursor {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *triggerState) isDelivered(cursor string) bool {
+	for _, d := range s.Delivered {
+		if d.Cursor == cursor {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *triggerState) removeDelivered(cursor string) {
+	delivered := s.Delivered[:0]
+	for _, d := range s.Delivered {
+		if d.Cursor != cursor {
+			delivered = append(delivered, d)
+		}
+	}
+	s.Delivered = delivered
+}
+
+// pruneDelivered forgets the delivered logs below the lowest height that can
+// be read again, which is the lowest unfinalized height or the current height.
+func (s *triggerState) pruneDelivered() {
+	lowest := s.Height
+	for _, u := range s.Unfinalized {
+		lowest = min(lowest, u.Height)
+	}
+	delivered := s.Delivered[:0]
+	for _, d := range s.Delivered {
+		if d.Height >= lowest {
+			delivered = append(delivered, d)
+		}
+	}
+	s.Delivered = delivered
+}
+
+// stateStore persists the state of the log event triggers in a KeyValueStore.
+// A nil KeyValueStore keeps the state in memory only.
+type stateStore struct {
+	kv core.KeyValueStore
+}
+
+func stateKey(triggerID string) string {
+	return "log-event-trigger/" + triggerID
+}
+
+// load returns the state of a trigger, and whether there is one.
+func (s stateStore) load(ctx context.Context, triggerID string) (triggerState, bool, error) {
+	var state triggerState
+	if s.kv == nil {
+		return state, false, nil
+	}
+	b, err := s.kv.Get(ctx, stateKey(triggerID))
+	if err != nil || len(b) == 0 {
+		// the KeyValueStore does not tell a missing key apart from other errors
+		return state, false, nil //nolint:nilerr
+	}
+	if err = json.Unmarshal(b, &state); err != nil {
+		return state, false, fmt.Errorf("invalid state for trigger %s: %w", triggerID, err)
+	}
+	return state, true, nil
+}
+
+func (s stateStore) save(ctx context.Context, triggerID string, state triggerState) error {
+	if s.kv == nil {
+		return nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return s.kv.Store(ctx, stateKey(triggerID), b)
+}
+
+// delete forgets the state of a trigger. The KeyValueStore can't delete keys,
+// so the state is overwritten with an empty one, which load reads as none.
+func (s stateStore) delete(ctx context.Context, triggerID string) error {
+	if s.kv == nil {
+		return nil
+	}
+	return s.kv.Store(ctx, stateKey(triggerID), []byte{})
+}
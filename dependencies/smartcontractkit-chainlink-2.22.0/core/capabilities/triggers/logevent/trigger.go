@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -16,10 +18,15 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	valuespb "github.com/smartcontractkit/chainlink-common/pkg/values/pb"
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/logevent/logeventcap"
 )
 
+// retractedEventIDSuffix is appended to the cursor of a log to get the ID of
+// the event retracting it.
+const retractedEventIDSuffix = "-retracted"
+
 // LogEventTrigger struct to listen for Contract events using ContractReader gRPC client
 // in a loop with a periodic delay of pollPeriod milliseconds, which is specified in
 // the job spec
@@ -33,6 +40,15 @@ type logEventTrigger struct {
 	relayer        core.Relayer
 	startBlockNum  uint64
 
+	// Confidence of the logs delivered, and their delivery guarantee
+	confidence primitives.ConfidenceLevel
+	delivery   logeventcap.ConfigDelivery
+
+	// The progress of the trigger, persisted under its triggerID
+	triggerID string
+	states    stateStore
+	state     triggerState
+
 	// Log Event Trigger config with pollPeriod and lookbackBlocks
 	logEventConfig Config
 	ticker         *time.Ticker
@@ -44,9 +60,20 @@ type logEventTrigger struct {
 func newLogEventTrigger(ctx context.Context,
 	lggr logger.Logger,
 	workflowID string,
+	triggerID string,
 	reqConfig *logeventcap.Config,
 	logEventConfig Config,
-	relayer core.Relayer) (*logEventTrigger, chan capabilities.TriggerResponse, error) {
+	relayer core.Relayer,
+	states stateStore) (*logEventTrigger, chan capabilities.TriggerResponse, error) {
+	confidence, err := parseConfidence(reqConfig.Confidence)
+	if err != nil {
+		return nil, nil, err
+	}
+	delivery, err := parseDelivery(reqConfig.Delivery)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	jsonBytes, err := json.Marshal(reqConfig.ContractReaderConfig)
 	if err != nil {
 		return nil, nil, err
@@ -72,18 +99,31 @@ func newLogEventTrigger(ctx context.Context,
 		return nil, nil, err
 	}
 
-	// Get current block HEAD/tip of the blockchain to start polling from
-	latestHead, err := relayer.LatestHead(ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting latestHead from relayer client: %w", err)
-	}
-	height, err := strconv.ParseUint(latestHead.Height, 10, 64)
+	lggr = logger.Named(lggr, fmt.Sprintf("LogEventTrigger.%s", workflowID))
+
+	// Resume from the stored cursor of the trigger, if any, or otherwise from
+	// the current block HEAD/tip of the blockchain minus the lookback
+	state, found, err := states.load(ctx, triggerID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("invalid height in latestHead from relayer client: %w", err)
+		return nil, nil, err
 	}
 	startBlockNum := uint64(0)
-	if height > logEventConfig.LookbackBlocks {
-		startBlockNum = height - logEventConfig.LookbackBlocks
+	if found {
+		startBlockNum = state.Height
+		lggr.Infow("Resuming from stored cursor", "cursor", state.Cursor, "height", state.Height)
+	} else {
+		latestHead, err := relayer.LatestHead(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting latestHead from relayer client: %w", err)
+		}
+		height, err := strconv.ParseUint(latestHead.Height, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid height in latestHead from relayer client: %w", err)
+		}
+		if height > logEventConfig.LookbackBlocks {
+			startBlockNum = height - logEventConfig.LookbackBlocks
+		}
+		state.Height = startBlockNum
 	}
 
 	// Setup callback channel, logger and ticker to poll ContractReader
@@ -97,13 +137,20 @@ func newLogEventTrigger(ctx context.Context,
 	// Initialise a Log Event Trigger
 	l := &logEventTrigger{
 		ch:   callbackCh,
-		lggr: logger.Named(lggr, fmt.Sprintf("LogEventTrigger.%s", workflowID)),
+		lggr: lggr,
 
 		reqConfig:      reqConfig,
 		contractReader: contractReader,
 		relayer:        relayer,
 		startBlockNum:  startBlockNum,
 
+		confidence: confidence,
+		delivery:   delivery,
+
+		triggerID: triggerID,
+		states:    states,
+		state:     state,
+
 		logEventConfig: logEventConfig,
 		ticker:         ticker,
 		stopChan:       make(services.StopChan),
@@ -112,6 +159,34 @@ func newLogEventTrigger(ctx context.Context,
 	return l, callbackCh, nil
 }
 
+// parseConfidence returns the confidence level of a trigger config, which is
+// finalized unless set otherwise.
+func parseConfidence(confidence logeventcap.ConfigConfidence) (primitives.ConfidenceLevel, error) {
+	switch confidence {
+	case "", logeventcap.ConfigConfidenceFinalized:
+		return primitives.Finalized, nil
+	case logeventcap.ConfigConfidenceUnconfirmed:
+		return primitives.Unconfirmed, nil
+	default:
+		return "", fmt.Errorf("invalid confidence %q, must be %q or %q", confidence,
+			logeventcap.ConfigConfidenceFinalized, logeventcap.ConfigConfidenceUnconfirmed)
+	}
+}
+
+// parseDelivery returns the delivery guarantee of a trigger config, which is
+// at-least-once unless set otherwise.
+func parseDelivery(delivery logeventcap.ConfigDelivery) (logeventcap.ConfigDelivery, error) {
+	switch delivery {
+	case "", logeventcap.ConfigDeliveryAtLeastOnce:
+		return logeventcap.ConfigDeliveryAtLeastOnce, nil
+	case logeventcap.ConfigDeliveryExactlyOnce:
+		return logeventcap.ConfigDeliveryExactlyOnce, nil
+	default:
+		return "", fmt.Errorf("invalid delivery %q, must be %q or %q", delivery,
+			logeventcap.ConfigDeliveryAtLeastOnce, logeventcap.ConfigDeliveryExactlyOnce)
+	}
+}
+
 func (l *logEventTrigger) Start(ctx context.Context) error {
 	go l.listen()
 	return nil
@@ -123,15 +198,6 @@ func (l *logEventTrigger) listen() {
 	defer cancel()
 	defer close(l.done)
 
-	// Listen for events from lookbackPeriod
-	var logs []types.Sequence
-	var err error
-	var logData values.Value
-	cursor := ""
-	limitAndSort := query.LimitAndSort{
-		SortBy: []query.SortBy{query.NewSortByTimestamp(query.Asc)},
-		Limit:  query.Limit{Count: l.logEventConfig.QueryCount},
-	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -143,44 +209,211 @@ func (l *logEventTrigger) listen() {
 		case t := <-l.ticker.C:
 			l.lggr.Infow("Polling event logs from ContractReader using QueryKey at", "time", t,
 				"startBlockNum", l.startBlockNum,
-				"cursor", cursor)
-			if cursor != "" {
-				limitAndSort.Limit = query.CursorLimit(cursor, query.CursorFollowing, l.logEventConfig.QueryCount)
+				"cursor", l.state.Cursor)
+			if l.confidence == primitives.Unconfirmed {
+				if err := l.retractReorgedLogs(ctx); err != nil {
+					l.lggr.Errorw("Failed to check delivered logs for reorgs", "err", err)
+				}
 			}
-			logs, err = l.contractReader.QueryKey(
-				ctx,
-				types.BoundContract{Name: l.reqConfig.ContractName, Address: l.reqConfig.ContractAddress},
-				query.KeyFilter{
-					Key: l.reqConfig.ContractEventName,
-					Expressions: []query.Expression{
-						query.Confidence(primitives.Finalized),
-						query.Block(fmt.Sprintf("%d", l.startBlockNum), primitives.Gte),
-					},
-				},
-				limitAndSort,
-				&logData,
-			)
-			if err != nil {
+			if err := l.poll(ctx); err != nil {
 				l.lggr.Errorw("QueryKey failure", "err", err)
-				continue
 			}
-			// ChainReader QueryKey API provides logs including the cursor value and not
-			// after the cursor value. If the response only consists of the log corresponding
-			// to the cursor and no log after it, then we understand that there are no new
-			// logs
-			if len(logs) == 1 && logs[0].Cursor == cursor {
-				l.lggr.Infow("No new logs since", "cursor", cursor)
-				continue
-			}
-			for _, log := range logs {
-				if log.Cursor == cursor {
-					continue
-				}
-				triggerResp := createTriggerResponse(log, l.logEventConfig.Version(ID))
-				l.ch <- triggerResp
-				cursor = log.Cursor
+		}
+	}
+}
+
+// poll delivers the logs following the cursor.
+func (l *logEventTrigger) poll(ctx context.Context) error {
+	limitAndSort := query.LimitAndSort{
+		SortBy: []query.SortBy{query.NewSortByTimestamp(query.Asc)},
+		Limit:  query.Limit{Count: l.logEventConfig.QueryCount},
+	}
+	cursor := l.state.Cursor
+	if cursor != "" {
+		limitAndSort.Limit = query.CursorLimit(cursor, query.CursorFollowing, l.logEventConfig.QueryCount)
+	}
+	logs, err := l.queryLogs(ctx, l.confidence, l.startBlockNum, nil, limitAndSort)
+	if err != nil {
+		return err
+	}
+	// ChainReader QueryKey API provides logs including the cursor value and not
+	// after the cursor value. If the response only consists of the log corresponding
+	// to the cursor and no log after it, then we understand that there are no new
+	// logs
+	if len(logs) == 1 && logs[0].Cursor == cursor {
+		l.lggr.Infow("No new logs since", "cursor", cursor)
+		return nil
+	}
+	for _, log := range logs {
+		if log.Cursor == cursor {
+			continue
+		}
+		if err = l.deliver(ctx, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver sends the trigger event of a log and moves the cursor past it. The
+// cursor is persisted after the event is sent, so that the event is sent again
+// if the node stops in between, and no log is ever lost. With exactly-once
+// delivery, the log is also recorded as delivered along with the cursor, and
+// logs recorded as delivered are not sent again when they are read again
+// after a restart or a reorg. Only a log whose event was sent right before the
+// node stopped, and before the state was persisted, can be sent twice.
+func (l *logEventTrigger) deliver(ctx context.Context, log types.Sequence) error {
+	height, err := strconv.ParseUint(log.Height, 10, 64)
+	if err != nil {
+		l.lggr.Warnw("Invalid log height, keeping the previous one", "cursor", log.Cursor, "height", log.Height, "err", err)
+		height = l.state.Height
+	}
+	l.state.Cursor, l.state.Height = log.Cursor, height
+
+	exactlyOnce := l.delivery == logeventcap.ConfigDeliveryExactlyOnce
+	if exactlyOnce && l.state.isDelivered(log.Cursor) {
+		l.lggr.Debugw("Log was delivered already, skipping it", "cursor", log.Cursor)
+		l.saveState(ctx)
+		return nil
+	}
+
+	triggerResp := createTriggerResponse(log, l.logEventConfig.Version(ID))
+	if l.confidence == primitives.Unconfirmed && triggerResp.Err == nil && !l.state.isUnfinalized(log.Cursor) {
+		outputs, err := proto.Marshal(values.ProtoMap(triggerResp.Event.Outputs))
+		if err != nil {
+			return fmt.Errorf("failed to encode outputs of log %s: %w", log.Cursor, err)
+		}
+		l.state.Unfinalized = append(l.state.Unfinalized, unfinalizedLog{Cursor: log.Cursor, Height: height, Outputs: outputs})
+	}
+
+	if err = l.send(ctx, triggerResp); err != nil {
+		return err
+	}
+	if exactlyOnce {
+		l.state.Delivered = append(l.state.Delivered, deliveredLog{Cursor: log.Cursor, Height: height})
+		l.state.pruneDelivered()
+	}
+	l.saveState(ctx)
+	return nil
+}
+
+// retractReorgedLogs sends a retraction event for each unfinalized log that
+// was delivered and is no longer part of the chain. Logs that got finalized
+// in the meantime can't be reorged out anymore and are forgotten.
+func (l *logEventTrigger) retractReorgedLogs(ctx context.Context) error {
+	if len(l.state.Unfinalized) == 0 {
+		return nil
+	}
+	from, to := l.state.Unfinalized[0].Height, l.state.Unfinalized[0].Height
+	for _, u := range l.state.Unfinalized {
+		from, to = min(from, u.Height), max(to, u.Height)
+	}
+
+	finalized, err := l.queryCursors(ctx, primitives.Finalized, from, to)
+	if err != nil {
+		return err
+	}
+	canonical, err := l.queryCursors(ctx, primitives.Unconfirmed, from, to)
+	if err != nil {
+		return err
+	}
+
+	var unfinalized, retracted []unfinalizedLog
+	for _, u := range l.state.Unfinalized {
+		if _, ok := finalized[u.Cursor]; ok {
+			continue
+		}
+		if _, ok := canonical[u.Cursor]; ok {
+			unfinalized = append(unfinalized, u)
+			continue
+		}
+		retracted = append(retracted, u)
+	}
+	l.state.Unfinalized = unfinalized
+	if len(retracted) == 0 {
+		l.saveState(ctx)
+		return nil
+	}
+
+	rewindTo := retracted[0].Height
+	for _, u := range retracted {
+		rewindTo = min(rewindTo, u.Height)
+		l.state.removeDelivered(u.Cursor)
+		l.lggr.Warnw("Delivered log was reorged out, retracting it", "cursor", u.Cursor, "height", u.Height)
+		if err = l.send(ctx, createRetractionResponse(u, l.logEventConfig.Version(ID))); err != nil {
+			return err
+		}
+	}
+
+	// read the logs again from the lowest retracted block, so that the logs
+	// that replaced the retracted ones are delivered
+	l.state.Cursor, l.state.Height = "", rewindTo
+	l.startBlockNum = rewindTo
+	l.saveState(ctx)
+	return nil
+}
+
+// queryCursors returns the cursors of all the logs between two blocks.
+func (l *logEventTrigger) queryCursors(ctx context.Context, confidence primitives.ConfidenceLevel, from, to uint64) (map[string]struct{}, error) {
+	cursors := map[string]struct{}{}
+	limitAndSort := query.LimitAndSort{
+		SortBy: []query.SortBy{query.NewSortByTimestamp(query.Asc)},
+		Limit:  query.Limit{Count: l.logEventConfig.QueryCount},
+	}
+	for {
+		logs, err := l.queryLogs(ctx, confidence, from, &to, limitAndSort)
+		if err != nil {
+			return nil, err
+		}
+		added := 0
+		for _, log := range logs {
+			if _, ok := cursors[log.Cursor]; !ok {
+				cursors[log.Cursor] = struct{}{}
+				added++
 			}
 		}
+		if added == 0 || uint64(len(logs)) < l.logEventConfig.QueryCount {
+			return cursors, nil
+		}
+		limitAndSort.Limit = query.CursorLimit(logs[len(logs)-1].Cursor, query.CursorFollowing, l.logEventConfig.QueryCount)
+	}
+}
+
+func (l *logEventTrigger) queryLogs(ctx context.Context, confidence primitives.ConfidenceLevel, from uint64, to *uint64, limitAndSort query.LimitAndSort) ([]types.Sequence, error) {
+	expressions := []query.Expression{
+		query.Confidence(confidence),
+		query.Block(strconv.FormatUint(from, 10), primitives.Gte),
+	}
+	if to != nil {
+		expressions = append(expressions, query.Block(strconv.FormatUint(*to, 10), primitives.Lte))
+	}
+	var logData values.Value
+	return l.contractReader.QueryKey(
+		ctx,
+		types.BoundContract{Name: l.reqConfig.ContractName, Address: l.reqConfig.ContractAddress},
+		query.KeyFilter{
+			Key:         l.reqConfig.ContractEventName,
+			Expressions: expressions,
+		},
+		limitAndSort,
+		&logData,
+	)
+}
+
+func (l *logEventTrigger) send(ctx context.Context, resp capabilities.TriggerResponse) error {
+	select {
+	case l.ch <- resp:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// saveState persists the state of the trigger. Failures are only logged, and
+// the state is persisted again along with the next delivered log.
+func (l *logEventTrigger) saveState(ctx context.Context) {
+	if err := l.states.save(ctx, l.triggerID, l.state); err != nil {
+		l.lggr.Errorw("Failed to persist trigger state", "cursor", l.state.Cursor, "err", err)
 	}
 }
 
@@ -224,6 +457,32 @@ func createTriggerResponse(log types.Sequence, version string) capabilities.Trig
 	}
 }
 
+// Create the event retracting a delivered log that was reorged out. It has the
+// outputs of the delivered event, with Retracted set.
+func createRetractionResponse(u unfinalizedLog, version string) capabilities.TriggerResponse {
+	pbMap := &valuespb.Map{}
+	if err := proto.Unmarshal(u.Outputs, pbMap); err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error decoding outputs of retracted log %s: %w", u.Cursor, err),
+		}
+	}
+	outputs, err := values.FromMapValueProto(pbMap)
+	if err != nil {
+		return capabilities.TriggerResponse{
+			Err: fmt.Errorf("error decoding outputs of retracted log %s: %w", u.Cursor, err),
+		}
+	}
+	outputs.Underlying["Retracted"] = values.NewBool(true)
+
+	return capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: version,
+			ID:          u.Cursor + retractedEventIDSuffix,
+			Outputs:     outputs,
+		},
+	}
+}
+
 // Close contract event listener for the current contract
 // This function is called when UnregisterTrigger is called individually
 // for a specific ContractAddress and EventName
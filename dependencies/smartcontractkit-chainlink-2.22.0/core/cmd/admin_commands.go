@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +21,7 @@ import (
 
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -68,6 +71,24 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "audit",
+			Usage: "Inspect the node's local audit trail",
+			Subcommands: cli.Commands{
+				{
+					Name:   "verify",
+					Usage:  "Validates that the hash chain of the audit trail is unbroken",
+					Action: s.VerifyAuditTrail,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page-size",
+							Usage: "number of audit events to fetch per request",
+							Value: 500,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "status",
 			Usage:  "Displays the health of various services running inside the node.",
@@ -302,6 +323,71 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+// VerifyAuditTrail fetches the whole local audit trail of the node and checks
+// that its hash chain is unbroken. The chain is recomputed here rather than on
+// the node, so a tampered node cannot simply report success.
+func (s *Shell) VerifyAuditTrail(c *cli.Context) error {
+	size := c.Int("page-size")
+	if size < 1 {
+		return s.errorOut(errors.New("page-size must be positive"))
+	}
+
+	var (
+		prevHash []byte
+		afterID  int64
+		verified int
+	)
+	for {
+		events, err := s.fetchAuditEvents(size, afterID)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		if len(events) == 0 {
+			break
+		}
+		if err = audit.VerifyChain(prevHash, events); err != nil {
+			return s.errorOut(err)
+		}
+		last := events[len(events)-1]
+		prevHash, afterID = last.Hash, last.ID
+		verified += len(events)
+	}
+
+	s.Logger.Infof("Audit trail verified: %d events, chain intact", verified)
+	return nil
+}
+
+func (s *Shell) fetchAuditEvents(size int, afterID int64) (events []audit.Event, err error) {
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/audit_events?size=%d&afterID=%d", size, afterID))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var resources []presenters.AuditEventResource
+	if err = s.deserializeAPIResponse(resp, &resources, &jsonapi.Links{}); err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		e := audit.Event{EventID: r.EventID, Data: r.Data, CreatedAt: r.CreatedAt}
+		if e.ID, err = strconv.ParseInt(r.ID, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid audit event id %q: %w", r.ID, err)
+		}
+		if e.PrevHash, err = hex.DecodeString(r.PrevHash); err != nil {
+			return nil, fmt.Errorf("invalid previous hash for audit event %d: %w", e.ID, err)
+		}
+		if e.Hash, err = hex.DecodeString(r.Hash); err != nil {
+			return nil, fmt.Errorf("invalid hash for audit event %d: %w", e.ID, err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,6 +52,22 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "sources",
+			Usage:  "Show the values, latencies and provider timestamps recorded for the external adapters of a job",
+			Action: s.ShowJobSources,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "how far back to show observations, e.g. 30m or 24h",
+					Value: "1h",
+				},
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
 	}
 }
 
@@ -317,3 +335,54 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// EASourceObservationPresenter wraps the JSONAPI EA source observation resource and adds rendering functionality
+type EASourceObservationPresenter struct {
+	JAID
+	presenters.EASourceObservationResource
+}
+
+// ToRow presents the observation as a slice of strings.
+func (p EASourceObservationPresenter) ToRow() []string {
+	return []string{
+		p.BridgeName,
+		p.DataSource,
+		strconv.FormatFloat(p.Value, 'f', -1, 64),
+		strconv.FormatInt(p.LatencyMs, 10),
+		friendlyUnixMilli(p.ProviderIndicatedTime),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// friendlyUnixMilli formats a provider timestamp, which is 0 when it isn't reported by the external adapter.
+func friendlyUnixMilli(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339Nano)
+}
+
+type EASourceObservationPresenters []EASourceObservationPresenter
+
+// RenderTable implements TableRenderer
+func (ps EASourceObservationPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Bridge", "Data Source", "Value", "Latency (ms)", "Provider Indicated Time", "Observed At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Job Sources", table)
+	return nil
+}
+
+// ShowJobSources displays the observations recorded for the external adapters of a job
+func (s *Shell) ShowJobSources(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the job"))
+	}
+	since := c.String("since")
+	if _, err = time.ParseDuration(since); err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid since duration"))
+	}
+	return s.getPage(fmt.Sprintf("/v2/jobs/%s/sources?since=%s", url.PathEscape(c.Args().First()), url.QueryEscape(since)), c.Int("page"), &EASourceObservationPresenters{})
+}
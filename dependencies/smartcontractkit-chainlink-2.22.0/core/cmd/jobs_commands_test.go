@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"flag"
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -362,6 +364,40 @@ func TestShell_ShowJob(t *testing.T) {
 	assert.Equal(t, createOutput.ID, job.ID)
 }
 
+func TestShell_ShowJobSources(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	now := time.Now()
+	require.NoError(t, ocrcommon.NewEASourceHistory(app.GetDB()).InsertObservations(testutils.Context(t), []ocrcommon.EASourceObservation{
+		{JobID: jb.ID, BridgeName: "bridge", DataSource: "adapter", Value: 1.5, BridgeTaskRunStartedAt: now.Add(-2*time.Hour - time.Second), BridgeTaskRunEndedAt: now.Add(-2 * time.Hour), CreatedAt: now.Add(-2 * time.Hour)},
+		{JobID: jb.ID, BridgeName: "bridge", DataSource: "adapter", Value: 2.5, BridgeTaskRunStartedAt: now.Add(-time.Second), BridgeTaskRunEndedAt: now, CreatedAt: now},
+	}))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowJobSources, set, "")
+	require.NoError(t, set.Parse([]string{strconv.Itoa(int(jb.ID))}))
+	require.NoError(t, client.ShowJobSources(cli.NewContext(nil, set, nil)))
+	observations := *r.Renders[0].(*cmd.EASourceObservationPresenters)
+	require.Len(t, observations, 1)
+	assert.Equal(t, []string{"bridge", "adapter", "2.5", "1000", ""}, observations[0].ToRow()[:5])
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowJobSources, set, "")
+	require.NoError(t, set.Parse([]string{"--since", "3h", strconv.Itoa(int(jb.ID))}))
+	require.NoError(t, client.ShowJobSources(cli.NewContext(nil, set, nil)))
+	assert.Len(t, *r.Renders[1].(*cmd.EASourceObservationPresenters), 2)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowJobSources, set, "")
+	require.NoError(t, set.Parse([]string{"--since", "3h", "--page", "2", strconv.Itoa(int(jb.ID))}))
+	require.NoError(t, client.ShowJobSources(cli.NewContext(nil, set, nil)))
+	assert.Empty(t, *r.Renders[2].(*cmd.EASourceObservationPresenters))
+}
+
 //go:embed ocr-bootstrap-spec.yml
 var ocrBootstrapSpec string
 
@@ -297,8 +297,8 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
+	// Configure the local audit trail and optionally start the audit log forwarder service
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger(), ds)
 	if err != nil {
 		return nil, err
 	}
@@ -313,6 +313,7 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 
 		Config:                     cfg,
 		DS:                         ds,
+		DBStats:                    db.Stats,
 		KeyStore:                   keyStore,
 		RelayerChainInteroperators: relayChainInterops,
 		MailMon:                    mailMon,
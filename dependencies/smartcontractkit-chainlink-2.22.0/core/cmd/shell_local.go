@@ -8,9 +8,11 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
@@ -30,8 +32,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+	"github.com/smartcontractkit/chainlink/v2/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/shutdown"
@@ -212,6 +216,23 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 					Before: s.validateDB,
 					Flags:  []cli.Flag{},
 				},
+				{
+					Name:      "restore",
+					Usage:     "Restore the database from a backup taken by the node. WARNING: This will REPLACE ALL DATA in the database referred to by CL_DATABASE_URL env variable or by the Database.URL field in a secrets TOML config.",
+					ArgsUsage: "<backup>",
+					Action:    s.RestoreDatabase,
+					Before:    s.validateDB,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "force",
+							Usage: "restore even if the backup was taken by a newer or unknown node version",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "skip the confirmation prompt",
+						},
+					},
+				},
 				{
 					Name:   "create-migration",
 					Usage:  "Create a new migration.",
@@ -545,6 +566,11 @@ func (s *Shell) runNode(c *cli.Context) error {
 		return nil
 	})
 
+	grp.Go(func() error {
+		reloadConfigOnSIGHUP(grpCtx, app, lggr)
+		return nil
+	})
+
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
 	grp.Go(func() error {
@@ -561,6 +587,24 @@ func (s *Shell) runNode(c *cli.Context) error {
 	return grp.Wait()
 }
 
+// reloadConfigOnSIGHUP reloads the config files each time SIGHUP is received, until ctx is done.
+func reloadConfigOnSIGHUP(ctx context.Context, app chainlink.Application, lggr logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			lggr.Info("Reloading config due to SIGHUP signal received...")
+			if _, err := app.ReloadConfig(); err != nil {
+				lggr.Errorw("Failed to reload config", "err", err)
+			}
+		}
+	}
+}
+
 func checkFilePermissions(lggr logger.Logger, rootDir string) error {
 	// Ensure tls sub directory (and children) permissions are <= `ownerPermsMask``
 	tlsDir := filepath.Join(rootDir, "tls")
@@ -901,6 +945,32 @@ func (s *Shell) StatusDatabase(_ *cli.Context) error {
 	return nil
 }
 
+// RestoreDatabase validates a backup and restores it into the database
+func (s *Shell) RestoreDatabase(c *cli.Context) error {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("You must specify the path of the backup to restore"))
+	}
+	cfg := s.Config.Database()
+	u := cfg.URL()
+	if u.String() == "" {
+		return s.errorOut(errDBURLMissing)
+	}
+	if !confirmAction(c) {
+		return nil
+	}
+
+	opts := periodicbackup.RestoreOpts{
+		AppVersion:       static.Version,
+		EncryptionKey:    cfg.Backup().EncryptionKey(),
+		SkipVersionCheck: c.Bool("force"),
+	}
+	if err := periodicbackup.Restore(s.ctx(), s.Logger, u, c.Args().First(), opts); err != nil {
+		return s.errorOut(err)
+	}
+	s.Logger.Info("Database restored successfully. Migrations will be applied on the next node start.")
+	return nil
+}
+
 // CreateMigration displays the database migration status
 func (s *Shell) CreateMigration(c *cli.Context) error {
 	ctx := s.ctx()
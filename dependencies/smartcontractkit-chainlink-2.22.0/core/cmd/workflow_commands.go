@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "executions",
+			Usage:  "List the executions of a workflow, most recent first",
+			Action: s.ListWorkflowExecutions,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "only list executions with this status, e.g. errored",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "only list executions created at or after this time (RFC3339)",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "only list executions created before this time (RFC3339)",
+				},
+			},
+		},
+		{
+			Name:   "show-execution",
+			Usage:  "Show the timeline of the steps of a workflow execution",
+			Action: s.ShowWorkflowExecution,
+		},
+		{
+			Name:   "rerun",
+			Usage:  "Re-run a failed workflow execution from the given step",
+			Action: s.RerunWorkflowExecution,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "step",
+					Usage: "ref of the step to re-run the execution from",
+				},
+			},
+		},
+		{
+			Name:   "cancel",
+			Usage:  "Cancel a running workflow execution",
+			Action: s.CancelWorkflowExecution,
+		},
+		{
+			Name:   "dead-letters",
+			Usage:  "List the failed executions of a workflow that can be replayed",
+			Action: s.ListWorkflowDeadLetters,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "replay",
+			Usage:  "Start a new execution with the trigger event of a dead letter",
+			Action: s.ReplayWorkflowDeadLetter,
+		},
+	}
+}
+
+type WorkflowExecutionPresenter struct {
+	JAID
+	presenters.WorkflowExecutionResource
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	table.Append(p.toRow())
+	render("Workflow Execution", table)
+
+	steps := rt.newTable([]string{"Ref", "Capability", "Status", "Started At", "Duration", "Error"})
+	for _, s := range p.Steps {
+		duration := ""
+		if s.DurationMs != nil {
+			duration = (time.Duration(*s.DurationMs) * time.Millisecond).String()
+		}
+		steps.Append([]string{s.Ref, s.CapabilityID, s.Status, formatTime(s.StartedAt), duration, s.Error})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+func (p *WorkflowExecutionPresenter) toRow() []string {
+	return []string{p.ID, p.WorkflowID, p.Status, formatTime(p.CreatedAt), formatTime(p.FinishedAt)}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// ListWorkflowExecutions lists the executions of the given workflow.
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow"))
+	}
+	q := url.Values{}
+	for _, name := range []string{"status", "since", "until"} {
+		if v := c.String(name); v != "" {
+			q.Set(name, v)
+		}
+	}
+	uri := url.URL{Path: "/v2/workflows/" + url.PathEscape(c.Args().First()) + "/executions", RawQuery: q.Encode()}
+	return s.getPage(uri.String(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution shows a workflow execution and the timeline of its steps.
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflow_executions/"+c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// RerunWorkflowExecution re-runs a failed workflow execution from the given step.
+func (s *Shell) RerunWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow execution"))
+	}
+	if c.String("step") == "" {
+		return s.errorOut(errors.New("must pass the step to re-run from with --step"))
+	}
+	body, err := json.Marshal(web.WorkflowExecutionRerunRequest{StepRef: c.String("step")})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflow_executions/"+c.Args().First()+"/rerun", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Execution re-run from step "+c.String("step"))
+}
+
+// CancelWorkflowExecution cancels a running workflow execution.
+func (s *Shell) CancelWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflow_executions/"+c.Args().First()+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Execution cancelled")
+}
+
+type WorkflowDeadLetterPresenter struct {
+	JAID
+	presenters.WorkflowDeadLetterResource
+}
+
+func (p *WorkflowDeadLetterPresenter) toRow() []string {
+	return []string{p.ID, p.ExecutionID, p.Status, p.Error, formatTime(&p.CreatedAt), formatTime(p.ReplayedAt), p.ReplayExecutionID}
+}
+
+type WorkflowDeadLetterPresenters []WorkflowDeadLetterPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowDeadLetterPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Execution ID", "Status", "Error", "Created At", "Replayed At", "Replay Execution ID"})
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+
+	render("Workflow Dead Letters", table)
+	return nil
+}
+
+// ListWorkflowDeadLetters lists the dead letters of the given workflow.
+func (s *Shell) ListWorkflowDeadLetters(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the workflow"))
+	}
+	return s.getPage("/v2/workflows/"+url.PathEscape(c.Args().First())+"/dead_letters", c.Int("page"), &WorkflowDeadLetterPresenters{})
+}
+
+// ReplayWorkflowDeadLetter starts a new execution with the trigger event of
+// the given dead letter.
+func (s *Shell) ReplayWorkflowDeadLetter(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the id of the dead letter"))
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/workflow_dead_letters/"+c.Args().First()+"/replay", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Dead letter replayed")
+}
@@ -0,0 +1,84 @@
+package cmd_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+)
+
+func TestShell_ListWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListWorkflowExecutions, set, "")
+	require.NoError(t, set.Parse([]string{"unknown-workflow"}))
+
+	require.NoError(t, client.ListWorkflowExecutions(cli.NewContext(nil, set, nil)))
+	executions := *r.Renders[0].(*cmd.WorkflowExecutionPresenters)
+	assert.Empty(t, executions)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListWorkflowExecutions, set, "")
+	require.NoError(t, set.Set("status", "bogus"))
+	require.NoError(t, set.Parse([]string{"unknown-workflow"}))
+	assert.Error(t, client.ListWorkflowExecutions(cli.NewContext(nil, set, nil)))
+}
+
+func TestShell_RerunWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RerunWorkflowExecution, set, "")
+	require.NoError(t, set.Parse([]string{"unknown-execution"}))
+	assert.ErrorContains(t, client.RerunWorkflowExecution(cli.NewContext(nil, set, nil)), "--step")
+
+	require.NoError(t, set.Set("step", "step-1"))
+	assert.Error(t, client.RerunWorkflowExecution(cli.NewContext(nil, set, nil)))
+}
+
+func TestShell_WorkflowDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListWorkflowDeadLetters, set, "")
+	require.NoError(t, set.Parse([]string{"unknown-workflow"}))
+
+	require.NoError(t, client.ListWorkflowDeadLetters(cli.NewContext(nil, set, nil)))
+	deadLetters := *r.Renders[0].(*cmd.WorkflowDeadLetterPresenters)
+	assert.Empty(t, deadLetters)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"1"}))
+	assert.Error(t, client.ReplayWorkflowDeadLetter(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"not-a-number"}))
+	assert.Error(t, client.ReplayWorkflowDeadLetter(cli.NewContext(nil, set, nil)))
+}
+
+func TestShell_CancelWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	assert.Error(t, client.CancelWorkflowExecution(cli.NewContext(nil, set, nil)))
+
+	require.NoError(t, set.Parse([]string{"unknown-execution"}))
+	assert.Error(t, client.CancelWorkflowExecution(cli.NewContext(nil, set, nil)))
+}
@@ -66,3 +66,12 @@ var (
 	DatabaseBackupModeLite DatabaseBackupMode = "lite"
 	DatabaseBackupModeFull DatabaseBackupMode = "full"
 )
+
+type DatabaseBackupCompression string
+
+var (
+	DatabaseBackupCompressionNone DatabaseBackupCompression = "none"
+	DatabaseBackupCompressionGzip DatabaseBackupCompression = "gzip"
+	DatabaseBackupCompressionLZ4  DatabaseBackupCompression = "lz4"
+	DatabaseBackupCompressionZstd DatabaseBackupCompression = "zstd"
+)
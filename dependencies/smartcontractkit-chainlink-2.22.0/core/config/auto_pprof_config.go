@@ -18,4 +18,9 @@ type AutoPprof interface {
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	DBPoolThreshold() float64
+	PipelineQueueThreshold() int64
+	HeadLagThreshold() commonconfig.Duration
+	ContinuousProfileInterval() commonconfig.Duration
+	ContinuousProfileCount() int
 }
@@ -11,6 +11,10 @@ type Backup interface {
 	Mode() DatabaseBackupMode
 	OnVersionUpgrade() bool
 	URL() *url.URL
+	MaxBackups() uint32
+	MaxAge() time.Duration
+	Compression() DatabaseBackupCompression
+	EncryptionKey() string
 }
 
 type Lock interface {
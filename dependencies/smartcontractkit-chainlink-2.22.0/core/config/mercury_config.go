@@ -6,6 +6,8 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type MercuryCache interface {
@@ -48,6 +50,8 @@ type MercuryTransmitter interface {
 	TransmitConcurrency() uint32
 	ReaperFrequency() commonconfig.Duration
 	ReaperMaxAge() commonconfig.Duration
+	TransmitQueueSpillDir() string
+	TransmitQueueSpillMaxSize() utils.FileSize
 }
 
 type Mercury interface {
@@ -204,6 +204,51 @@ func (_c *TelemetryIngress_MaxBatchSize_Call) RunAndReturn(run func() uint) *Tel
 	return _c
 }
 
+// OTLPExport provides a mock function with no fields
+func (_m *TelemetryIngress) OTLPExport() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OTLPExport")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryIngress_OTLPExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OTLPExport'
+type TelemetryIngress_OTLPExport_Call struct {
+	*mock.Call
+}
+
+// OTLPExport is a helper method to define mock.On call
+func (_e *TelemetryIngress_Expecter) OTLPExport() *TelemetryIngress_OTLPExport_Call {
+	return &TelemetryIngress_OTLPExport_Call{Call: _e.mock.On("OTLPExport")}
+}
+
+func (_c *TelemetryIngress_OTLPExport_Call) Run(run func()) *TelemetryIngress_OTLPExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TelemetryIngress_OTLPExport_Call) Return(_a0 bool) *TelemetryIngress_OTLPExport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TelemetryIngress_OTLPExport_Call) RunAndReturn(run func() bool) *TelemetryIngress_OTLPExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendInterval provides a mock function with no fields
 func (_m *TelemetryIngress) SendInterval() time.Duration {
 	ret := _m.Called()
@@ -13,6 +13,7 @@ type TelemetryIngress interface {
 	SendInterval() time.Duration
 	SendTimeout() time.Duration
 	UseBatchSend() bool
+	OTLPExport() bool
 	Endpoints() []TelemetryIngressEndpoint
 }
 
@@ -115,6 +115,10 @@ func (c *Core) ValidateConfig() (err error) {
 		}
 	}
 
+	if c.TelemetryIngress.OTLPExport != nil && *c.TelemetryIngress.OTLPExport && (c.Telemetry.Enabled == nil || !*c.Telemetry.Enabled) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "TelemetryIngress.OTLPExport", Value: true, Msg: "Telemetry.Enabled required to export telemetry to the OTLP collector. Please enable Telemetry or disable OTLPExport."})
+	}
+
 	return err
 }
 
@@ -178,6 +182,7 @@ func dbURLPasswordComplexity(err error) string {
 type DatabaseSecrets struct {
 	URL                  *models.SecretURL
 	BackupURL            *models.SecretURL
+	BackupEncryptionKey  *models.Secret
 	AllowSimplePasswords *bool
 }
 
@@ -240,6 +245,9 @@ func (d *DatabaseSecrets) SetFrom(f *DatabaseSecrets) (err error) {
 	if v := f.BackupURL; v != nil {
 		d.BackupURL = v
 	}
+	if v := f.BackupEncryptionKey; v != nil {
+		d.BackupEncryptionKey = v
+	}
 	if v := f.URL; v != nil {
 		d.URL = v
 	}
@@ -255,6 +263,10 @@ func (d *DatabaseSecrets) validateMerge(f *DatabaseSecrets) (err error) {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupURL"})
 	}
 
+	if d.BackupEncryptionKey != nil && f.BackupEncryptionKey != nil {
+		err = multierr.Append(err, configutils.ErrOverride{Name: "BackupEncryptionKey"})
+	}
+
 	if d.URL != nil && f.URL != nil {
 		err = multierr.Append(err, configutils.ErrOverride{Name: "URL"})
 	}
@@ -559,12 +571,28 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 
 // DatabaseBackup
 //
-// Note: url is stored in Secrets.DatabaseBackupURL
+// Note: url and encryption key are stored in Secrets.Database
 type DatabaseBackup struct {
 	Dir              *string
 	Frequency        *commonconfig.Duration
 	Mode             *config.DatabaseBackupMode
 	OnVersionUpgrade *bool
+	MaxBackups       *uint32
+	MaxAge           *commonconfig.Duration
+	Compression      *config.DatabaseBackupCompression
+}
+
+func (d *DatabaseBackup) ValidateConfig() (err error) {
+	if d.Compression != nil {
+		switch *d.Compression {
+		case config.DatabaseBackupCompressionNone, config.DatabaseBackupCompressionGzip,
+			config.DatabaseBackupCompressionLZ4, config.DatabaseBackupCompressionZstd:
+		default:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: "Compression", Value: *d.Compression,
+				Msg: "must be one of: none, gzip, lz4, zstd"})
+		}
+	}
+	return
 }
 
 func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
@@ -580,6 +608,15 @@ func (d *DatabaseBackup) setFrom(f *DatabaseBackup) {
 	if v := f.OnVersionUpgrade; v != nil {
 		d.OnVersionUpgrade = v
 	}
+	if v := f.MaxBackups; v != nil {
+		d.MaxBackups = v
+	}
+	if v := f.MaxAge; v != nil {
+		d.MaxAge = v
+	}
+	if v := f.Compression; v != nil {
+		d.Compression = v
+	}
 }
 
 type TelemetryIngress struct {
@@ -590,6 +627,7 @@ type TelemetryIngress struct {
 	SendInterval *commonconfig.Duration
 	SendTimeout  *commonconfig.Duration
 	UseBatchSend *bool
+	OTLPExport   *bool
 	Endpoints    []TelemetryIngressEndpoint `toml:",omitempty"`
 }
 
@@ -622,6 +660,9 @@ func (t *TelemetryIngress) setFrom(f *TelemetryIngress) {
 	if v := f.UseBatchSend; v != nil {
 		t.UseBatchSend = v
 	}
+	if v := f.OTLPExport; v != nil {
+		t.OTLPExport = v
+	}
 	if v := f.Endpoints; v != nil {
 		t.Endpoints = v
 	}
@@ -1299,6 +1340,22 @@ type AutoPprof struct {
 	MutexProfileFraction *int64 // runtime.SetMutexProfileFraction
 	MemThreshold         *utils.FileSize
 	GoroutineThreshold   *int64
+
+	DBPoolThreshold           *float64 // fraction of Database.MaxOpenConns in use
+	PipelineQueueThreshold    *int64
+	HeadLagThreshold          *commonconfig.Duration
+	ContinuousProfileInterval *commonconfig.Duration
+	ContinuousProfileCount    *int64
+}
+
+func (p *AutoPprof) ValidateConfig() (err error) {
+	if v := p.DBPoolThreshold; v != nil && (*v < 0 || *v > 1) {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "DBPoolThreshold", Value: *v, Msg: "must be between 0 and 1"})
+	}
+	if v := p.ContinuousProfileCount; v != nil && *v < 1 && p.ContinuousProfileInterval != nil && p.ContinuousProfileInterval.Duration() > 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "ContinuousProfileCount", Value: *v, Msg: "must be at least 1 when ContinuousProfileInterval is set"})
+	}
+	return
 }
 
 func (p *AutoPprof) setFrom(f *AutoPprof) {
@@ -1338,6 +1395,21 @@ func (p *AutoPprof) setFrom(f *AutoPprof) {
 	if v := f.GoroutineThreshold; v != nil {
 		p.GoroutineThreshold = v
 	}
+	if v := f.DBPoolThreshold; v != nil {
+		p.DBPoolThreshold = v
+	}
+	if v := f.PipelineQueueThreshold; v != nil {
+		p.PipelineQueueThreshold = v
+	}
+	if v := f.HeadLagThreshold; v != nil {
+		p.HeadLagThreshold = v
+	}
+	if v := f.ContinuousProfileInterval; v != nil {
+		p.ContinuousProfileInterval = v
+	}
+	if v := f.ContinuousProfileCount; v != nil {
+		p.ContinuousProfileCount = v
+	}
 }
 
 type Pyroscope struct {
@@ -1466,6 +1538,11 @@ type MercuryTransmitter struct {
 	TransmitConcurrency  *uint32
 	ReaperFrequency      *commonconfig.Duration
 	ReaperMaxAge         *commonconfig.Duration
+	// TransmitQueueSpillDir is where transmissions overflowing a full
+	// transmit queue are spilled to, instead of being dropped. Spilling is
+	// disabled if unset.
+	TransmitQueueSpillDir     *string
+	TransmitQueueSpillMaxSize *utils.FileSize
 }
 
 func (m *MercuryTransmitter) setFrom(f *MercuryTransmitter) {
@@ -1487,6 +1564,12 @@ func (m *MercuryTransmitter) setFrom(f *MercuryTransmitter) {
 	if v := f.ReaperMaxAge; v != nil {
 		m.ReaperMaxAge = v
 	}
+	if v := f.TransmitQueueSpillDir; v != nil {
+		m.TransmitQueueSpillDir = v
+	}
+	if v := f.TransmitQueueSpillMaxSize; v != nil {
+		m.TransmitQueueSpillMaxSize = v
+	}
 }
 
 type Mercury struct {
@@ -1619,7 +1702,8 @@ func (r *ExternalRegistry) setFrom(f *ExternalRegistry) {
 }
 
 type Workflows struct {
-	Limits Limits
+	Limits  Limits
+	DevMode DevMode
 }
 
 type Limits struct {
@@ -1629,6 +1713,7 @@ type Limits struct {
 
 func (r *Workflows) setFrom(f *Workflows) {
 	r.Limits.setFrom(&f.Limits)
+	r.DevMode.setFrom(&f.DevMode)
 }
 
 func (r *Limits) setFrom(f *Limits) {
@@ -1641,6 +1726,44 @@ func (r *Limits) setFrom(f *Limits) {
 	}
 }
 
+type DevMode struct {
+	Enabled         *bool
+	Directory       *string
+	PollInterval    *commonconfig.Duration
+	TriggerInterval *commonconfig.Duration
+}
+
+func (r *DevMode) setFrom(f *DevMode) {
+	if f.Enabled != nil {
+		r.Enabled = f.Enabled
+	}
+	if f.Directory != nil {
+		r.Directory = f.Directory
+	}
+	if f.PollInterval != nil {
+		r.PollInterval = f.PollInterval
+	}
+	if f.TriggerInterval != nil {
+		r.TriggerInterval = f.TriggerInterval
+	}
+}
+
+func (r *DevMode) ValidateConfig() (err error) {
+	if r.Enabled == nil || !*r.Enabled {
+		return
+	}
+	if r.Directory == nil || *r.Directory == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "Directory", Msg: "must be set when DevMode is enabled"})
+	}
+	if r.PollInterval != nil && r.PollInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "PollInterval", Value: r.PollInterval.Duration(), Msg: "must be positive"})
+	}
+	if r.TriggerInterval != nil && r.TriggerInterval.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "TriggerInterval", Value: r.TriggerInterval.Duration(), Msg: "must be positive"})
+	}
+	return
+}
+
 type WorkflowRegistry struct {
 	Address                 *string
 	NetworkID               *string
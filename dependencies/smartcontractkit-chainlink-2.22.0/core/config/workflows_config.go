@@ -1,10 +1,20 @@
 package config
 
+import "time"
+
 type Workflows interface {
 	Limits() WorkflowsLimits
+	DevMode() WorkflowsDevMode
 }
 
 type WorkflowsLimits interface {
 	Global() int32
 	PerOwner() int32
 }
+
+type WorkflowsDevMode interface {
+	Enabled() bool
+	Directory() string
+	PollInterval() time.Duration
+	TriggerInterval() time.Duration
+}
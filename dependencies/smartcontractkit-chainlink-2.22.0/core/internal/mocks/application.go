@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	permissions "github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+
 	big "math/big"
 
 	audit "github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -27,6 +29,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	ocrcommon "github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
@@ -43,8 +47,12 @@ import (
 
 	uuid "github.com/google/uuid"
 
+	vrfcommon "github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -108,6 +116,53 @@ func (_c *Application_AddJobV2_Call) RunAndReturn(run func(context.Context, *job
 	return _c
 }
 
+// AuditORM provides a mock function with no fields
+func (_m *Application) AuditORM() audit.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditORM")
+	}
+
+	var r0 audit.ORM
+	if rf, ok := ret.Get(0).(func() audit.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_AuditORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditORM'
+type Application_AuditORM_Call struct {
+	*mock.Call
+}
+
+// AuditORM is a helper method to define mock.On call
+func (_e *Application_Expecter) AuditORM() *Application_AuditORM_Call {
+	return &Application_AuditORM_Call{Call: _e.mock.On("AuditORM")}
+}
+
+func (_c *Application_AuditORM_Call) Run(run func()) *Application_AuditORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_AuditORM_Call) Return(_a0 audit.ORM) *Application_AuditORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_AuditORM_Call) RunAndReturn(run func() audit.ORM) *Application_AuditORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthenticationProvider provides a mock function with no fields
 func (_m *Application) AuthenticationProvider() sessions.AuthenticationProvider {
 	ret := _m.Called()
@@ -1153,6 +1208,100 @@ func (_c *Application_JobSpawner_Call) RunAndReturn(run func() job.Spawner) *App
 	return _c
 }
 
+// MedianSourceHealth provides a mock function with no fields
+func (_m *Application) MedianSourceHealth() *ocrcommon.SourceHealthRegistry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MedianSourceHealth")
+	}
+
+	var r0 *ocrcommon.SourceHealthRegistry
+	if rf, ok := ret.Get(0).(func() *ocrcommon.SourceHealthRegistry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ocrcommon.SourceHealthRegistry)
+		}
+	}
+
+	return r0
+}
+
+// Application_MedianSourceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MedianSourceHealth'
+type Application_MedianSourceHealth_Call struct {
+	*mock.Call
+}
+
+// MedianSourceHealth is a helper method to define mock.On call
+func (_e *Application_Expecter) MedianSourceHealth() *Application_MedianSourceHealth_Call {
+	return &Application_MedianSourceHealth_Call{Call: _e.mock.On("MedianSourceHealth")}
+}
+
+func (_c *Application_MedianSourceHealth_Call) Run(run func()) *Application_MedianSourceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_MedianSourceHealth_Call) Return(_a0 *ocrcommon.SourceHealthRegistry) *Application_MedianSourceHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_MedianSourceHealth_Call) RunAndReturn(run func() *ocrcommon.SourceHealthRegistry) *Application_MedianSourceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PermissionsORM provides a mock function with no fields
+func (_m *Application) PermissionsORM() permissions.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermissionsORM")
+	}
+
+	var r0 permissions.ORM
+	if rf, ok := ret.Get(0).(func() permissions.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(permissions.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_PermissionsORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermissionsORM'
+type Application_PermissionsORM_Call struct {
+	*mock.Call
+}
+
+// PermissionsORM is a helper method to define mock.On call
+func (_e *Application_Expecter) PermissionsORM() *Application_PermissionsORM_Call {
+	return &Application_PermissionsORM_Call{Call: _e.mock.On("PermissionsORM")}
+}
+
+func (_c *Application_PermissionsORM_Call) Run(run func()) *Application_PermissionsORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_PermissionsORM_Call) Return(_a0 permissions.ORM) *Application_PermissionsORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PermissionsORM_Call) RunAndReturn(run func() permissions.ORM) *Application_PermissionsORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PipelineORM provides a mock function with no fields
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -1200,6 +1349,61 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReloadConfig provides a mock function with no fields
+func (_m *Application) ReloadConfig() (chainlink.ReloadResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReloadConfig")
+	}
+
+	var r0 chainlink.ReloadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (chainlink.ReloadResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() chainlink.ReloadResult); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.ReloadResult)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_ReloadConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReloadConfig'
+type Application_ReloadConfig_Call struct {
+	*mock.Call
+}
+
+// ReloadConfig is a helper method to define mock.On call
+func (_e *Application_Expecter) ReloadConfig() *Application_ReloadConfig_Call {
+	return &Application_ReloadConfig_Call{Call: _e.mock.On("ReloadConfig")}
+}
+
+func (_c *Application_ReloadConfig_Call) Run(run func()) *Application_ReloadConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) Return(_a0 chainlink.ReloadResult, _a1 error) *Application_ReloadConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_ReloadConfig_Call) RunAndReturn(run func() (chainlink.ReloadResult, error)) *Application_ReloadConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -1644,6 +1848,53 @@ func (_c *Application_TxmStorageService_Call) RunAndReturn(run func() txmgr.EvmT
 	return _c
 }
 
+// VRFSubscriptions provides a mock function with no fields
+func (_m *Application) VRFSubscriptions() *vrfcommon.SubscriptionBalances {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VRFSubscriptions")
+	}
+
+	var r0 *vrfcommon.SubscriptionBalances
+	if rf, ok := ret.Get(0).(func() *vrfcommon.SubscriptionBalances); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vrfcommon.SubscriptionBalances)
+		}
+	}
+
+	return r0
+}
+
+// Application_VRFSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VRFSubscriptions'
+type Application_VRFSubscriptions_Call struct {
+	*mock.Call
+}
+
+// VRFSubscriptions is a helper method to define mock.On call
+func (_e *Application_Expecter) VRFSubscriptions() *Application_VRFSubscriptions_Call {
+	return &Application_VRFSubscriptions_Call{Call: _e.mock.On("VRFSubscriptions")}
+}
+
+func (_c *Application_VRFSubscriptions_Call) Run(run func()) *Application_VRFSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_VRFSubscriptions_Call) Return(_a0 *vrfcommon.SubscriptionBalances) *Application_VRFSubscriptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_VRFSubscriptions_Call) RunAndReturn(run func() *vrfcommon.SubscriptionBalances) *Application_VRFSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WakeSessionReaper provides a mock function with no fields
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -1676,6 +1927,53 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowExecutions provides a mock function with no fields
+func (_m *Application) WorkflowExecutions() *workflows.ExecutionHistory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowExecutions")
+	}
+
+	var r0 *workflows.ExecutionHistory
+	if rf, ok := ret.Get(0).(func() *workflows.ExecutionHistory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workflows.ExecutionHistory)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowExecutions'
+type Application_WorkflowExecutions_Call struct {
+	*mock.Call
+}
+
+// WorkflowExecutions is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowExecutions() *Application_WorkflowExecutions_Call {
+	return &Application_WorkflowExecutions_Call{Call: _e.mock.On("WorkflowExecutions")}
+}
+
+func (_c *Application_WorkflowExecutions_Call) Run(run func()) *Application_WorkflowExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowExecutions_Call) Return(_a0 *workflows.ExecutionHistory) *Application_WorkflowExecutions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowExecutions_Call) RunAndReturn(run func() *workflows.ExecutionHistory) *Application_WorkflowExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -15,6 +15,7 @@ import (
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -45,10 +46,13 @@ type AuditLoggerService struct {
 	hostname        string                   // The self-reported hostname of the machine
 	localIP         string                   // A non-loopback IP address as reported by the machine
 	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
+	orm             ORM                      // Local hash chained audit trail, nil when not persisted
 
 	loggingChannel chan wrappedAuditLog
+	persistChannel chan wrappedAuditLog
 	chStop         services.StopChan
 	chDone         chan struct{}
+	chPersistDone  chan struct{}
 }
 
 type wrappedAuditLog struct {
@@ -63,11 +67,26 @@ var NoopLogger AuditLogger = &AuditLoggerService{}
 // Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
 // AuditLogger instance. If the environment variables are not set, the logger
 // is disabled and short circuits execution via enabled flag.
-func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
+//
+// When ds is non-nil, every event is also appended to the local audit trail in
+// the database, regardless of whether forwarding is enabled.
+func NewAuditLogger(logger logger.Logger, config config.AuditLogger, ds sqlutil.DataSource) (AuditLogger, error) {
+	var orm ORM
+	if ds != nil {
+		orm = NewORM(ds)
+	}
+	disabled := &AuditLoggerService{orm: orm}
+	if orm != nil {
+		disabled.logger = logger.Helper(1)
+		disabled.persistChannel = make(chan wrappedAuditLog, bufferCapacity)
+		disabled.chStop = make(chan struct{})
+		disabled.chPersistDone = make(chan struct{})
+	}
+
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
 	if config == nil || !config.Enabled() {
-		return &AuditLoggerService{}, nil
+		return disabled, nil
 	}
 
 	hostname, err := os.Hostname()
@@ -77,12 +96,12 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 
 	forwardToUrl, err := config.ForwardToUrl()
 	if err != nil {
-		return &AuditLoggerService{}, nil
+		return disabled, nil
 	}
 
 	headers, err := config.Headers()
 	if err != nil {
-		return &AuditLoggerService{}, nil
+		return disabled, nil
 	}
 
 	loggingChannel := make(chan wrappedAuditLog, bufferCapacity)
@@ -98,10 +117,13 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		hostname:        hostname,
 		localIP:         getLocalIP(),
 		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		orm:             orm,
 
 		loggingChannel: loggingChannel,
+		persistChannel: disabled.persistChannel,
 		chStop:         make(chan struct{}),
 		chDone:         make(chan struct{}),
+		chPersistDone:  disabled.chPersistDone,
 	}
 
 	return &auditLogger, nil
@@ -111,21 +133,31 @@ func (l *AuditLoggerService) SetLoggingClient(newClient HTTPAuditLoggerInterface
 	l.loggingClient = newClient
 }
 
-// Entrypoint for new audit logs. This buffers all logs that come in they will
-// sent out by the goroutine that was started when the AuditLoggerService was
-// created. If this service was not enabled, this immeidately returns.
+// Entrypoint for new audit logs. Logs are buffered to be appended to the local
+// audit trail, if any, and to be sent out by the goroutines that were started
+// with the AuditLoggerService.
 //
-// This function never blocks.
+// This function does not block, unless the local audit trail buffer is full:
+// events are then persisted synchronously rather than dropped.
 func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
-	if !l.enabled {
-		return
-	}
-
 	wrappedLog := wrappedAuditLog{
 		eventID: eventID,
 		data:    data,
 	}
 
+	if l.orm != nil {
+		select {
+		case l.persistChannel <- wrappedLog:
+		default:
+			l.logger.Warnf("audit trail buffer is full. Persisting log with eventID synchronously: %s", eventID)
+			l.persist(eventID, data)
+		}
+	}
+
+	if !l.enabled {
+		return
+	}
+
 	select {
 	case l.loggingChannel <- wrappedLog:
 	default:
@@ -133,25 +165,36 @@ func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 	}
 }
 
-// Start the audit logger and begin processing logs on the channel
+// Start the audit logger and begin processing logs on the channels
 func (l *AuditLoggerService) Start(context.Context) error {
-	if !l.enabled {
+	if !l.enabled && l.orm == nil {
 		return errors.New("The audit logger is not enabled")
 	}
 
-	go l.runLoop()
+	if l.orm != nil {
+		go l.persistLoop()
+	}
+	if l.enabled {
+		go l.runLoop()
+	}
 	return nil
 }
 
-// Stops the logger and will close the channel.
+// Stops the logger and will close the channel. Events buffered for the local
+// audit trail are persisted before returning.
 func (l *AuditLoggerService) Close() error {
-	if !l.enabled {
+	if !l.enabled && l.orm == nil {
 		return errors.New("The audit logger is not enabled")
 	}
 
 	l.logger.Warnf("Disabled the audit logger service")
 	close(l.chStop)
-	<-l.chDone
+	if l.enabled {
+		<-l.chDone
+	}
+	if l.orm != nil {
+		<-l.chPersistDone
+	}
 
 	return nil
 }
@@ -162,22 +205,55 @@ func (l *AuditLoggerService) Name() string {
 
 func (l *AuditLoggerService) HealthReport() map[string]error {
 	var err error
-	if !l.enabled {
+	if !l.enabled && l.orm == nil {
 		err = errors.New("the audit logger is not enabled")
-	} else if len(l.loggingChannel) == bufferCapacity {
+	} else if l.enabled && len(l.loggingChannel) == bufferCapacity {
 		err = errors.New("buffer is full")
+	} else if l.orm != nil && len(l.persistChannel) == bufferCapacity {
+		err = errors.New("audit trail buffer is full")
 	}
 	return map[string]error{l.Name(): err}
 }
 
 func (l *AuditLoggerService) Ready() error {
-	if !l.enabled {
+	if !l.enabled && l.orm == nil {
 		return errors.New("the audit logger is not enabled")
 	}
 
 	return nil
 }
 
+// persistLoop appends buffered events to the local audit trail, in order. On
+// shutdown the remaining buffered events are persisted before it returns.
+func (l *AuditLoggerService) persistLoop() {
+	defer close(l.chPersistDone)
+
+	for {
+		select {
+		case <-l.chStop:
+			for {
+				select {
+				case event := <-l.persistChannel:
+					l.persist(event.eventID, event.data)
+				default:
+					return
+				}
+			}
+		case event := <-l.persistChannel:
+			l.persist(event.eventID, event.data)
+		}
+	}
+}
+
+// persist appends the event to the local audit trail.
+func (l *AuditLoggerService) persist(eventID EventID, data Data) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*webRequestTimeout)
+	defer cancel()
+	if _, err := l.orm.AppendEvent(ctx, eventID, data); err != nil {
+		l.logger.Criticalw("failed to persist audit event to the local audit trail", "eventID", eventID, "err", err)
+	}
+}
+
 // Entrypoint for our log handling goroutine. This waits on the channel and sends out
 // logs as they come in.
 //
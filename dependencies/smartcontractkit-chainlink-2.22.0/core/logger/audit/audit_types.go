@@ -75,6 +75,9 @@ const (
 	ExternalInitiatorCreated EventID = "EXTERNAL_INITIATOR_CREATED"
 	ExternalInitiatorDeleted EventID = "EXTERNAL_INITIATOR_DELETED"
 
+	PermissionGranted EventID = "PERMISSION_GRANTED"
+	PermissionRevoked EventID = "PERMISSION_REVOKED"
+
 	JobProposalSpecApproved EventID = "JOB_PROPOSAL_SPEC_APPROVED"
 	JobProposalSpecUpdated  EventID = "JOB_PROPOSAL_SPEC_UPDATED"
 	JobProposalSpecCanceled EventID = "JOB_PROPOSAL_SPEC_CANCELED"
@@ -85,8 +88,11 @@ const (
 	ConfigSqlLoggingDisabled EventID = "CONFIG_SQL_LOGGING_DISABLED"
 	GlobalLogLevelSet        EventID = "GLOBAL_LOG_LEVEL_SET"
 
-	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
-	JobRunSet         EventID = "JOB_RUN_SET"
+	JobErrorDismissed          EventID = "JOB_ERROR_DISMISSED"
+	JobRunSet                  EventID = "JOB_RUN_SET"
+	WorkflowExecutionRerun     EventID = "WORKFLOW_EXECUTION_RERUN"
+	WorkflowExecutionCancelled EventID = "WORKFLOW_EXECUTION_CANCELLED"
+	WorkflowDeadLetterReplayed EventID = "WORKFLOW_DEAD_LETTER_REPLAYED"
 
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
@@ -0,0 +1,157 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// advisoryLockID serializes appends to the audit_events hash chain across every
+// process writing to the same database.
+const advisoryLockID int64 = 0x61756469745f6576 // "audit_ev"
+
+// Event is a single entry in the local audit trail. Each event commits to the
+// hash of the event before it, so removing, reordering or editing any row
+// breaks the chain from that point on.
+type Event struct {
+	ID        int64     `db:"id"`
+	EventID   EventID   `db:"event_id"`
+	Data      string    `db:"data"`
+	PrevHash  []byte    `db:"prev_hash"`
+	Hash      []byte    `db:"hash"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ComputeHash returns the chain hash of the event, derived from the previous
+// hash and the event's contents. The stored Hash is ignored.
+func (e Event) ComputeHash() []byte {
+	h := sha256.New()
+	h.Write(e.PrevHash)
+	h.Write([]byte(e.EventID))
+	h.Write([]byte{0})
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(e.CreatedAt.UnixMicro())) //nolint:gosec // timestamps are positive
+	h.Write(ts[:])
+	h.Write([]byte(e.Data))
+	return h.Sum(nil)
+}
+
+// ChainError describes the first event at which the audit trail fails to verify.
+type ChainError struct {
+	ID     int64
+	Reason string
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("audit trail broken at event %d: %s", e.ID, e.Reason)
+}
+
+// VerifyChain checks that events, ordered by ID, form an unbroken hash chain
+// starting from prevHash. Pass a nil prevHash to verify from the first event.
+func VerifyChain(prevHash []byte, events []Event) error {
+	var prevID int64
+	for _, e := range events {
+		if prevID != 0 && e.ID <= prevID {
+			return &ChainError{ID: e.ID, Reason: fmt.Sprintf("out of order after event %d", prevID)}
+		}
+		if !bytes.Equal(e.PrevHash, prevHash) {
+			return &ChainError{ID: e.ID, Reason: "previous hash does not match the preceding event"}
+		}
+		if !bytes.Equal(e.ComputeHash(), e.Hash) {
+			return &ChainError{ID: e.ID, Reason: "hash does not match the event contents"}
+		}
+		prevID, prevHash = e.ID, e.Hash
+	}
+	return nil
+}
+
+// EventsFilter narrows the events returned by FindEvents. Zero values are ignored.
+type EventsFilter struct {
+	EventID EventID
+	Since   time.Time
+	Until   time.Time
+	AfterID int64
+}
+
+type ORM interface {
+	AppendEvent(ctx context.Context, eventID EventID, data Data) (Event, error)
+	FindEvents(ctx context.Context, filter EventsFilter, offset, limit int) ([]Event, int, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// AppendEvent adds an event to the end of the hash chain.
+func (o *orm) AppendEvent(ctx context.Context, eventID EventID, data Data) (event Event, err error) {
+	serialized, err := json.Marshal(data)
+	if err != nil {
+		return event, errors.Wrap(err, "failed to serialize audit event data")
+	}
+	event = Event{
+		EventID: eventID,
+		Data:    string(serialized),
+		// Postgres stores microseconds, truncate so the hash survives a round trip
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	err = sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, advisoryLockID); err != nil {
+			return errors.Wrap(err, "failed to lock audit trail")
+		}
+		var prevHash []byte
+		err = tx.GetContext(ctx, &prevHash, `SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1`)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "failed to load previous audit event")
+		}
+		event.PrevHash = prevHash
+		if event.PrevHash == nil {
+			event.PrevHash = []byte{}
+		}
+		event.Hash = event.ComputeHash()
+		return tx.GetContext(ctx, &event.ID, `INSERT INTO audit_events (event_id, data, prev_hash, hash, created_at)
+VALUES ($1, $2, $3, $4, $5) RETURNING id`, event.EventID, event.Data, event.PrevHash, event.Hash, event.CreatedAt)
+	})
+	return event, err
+}
+
+// FindEvents returns a page of events matching filter in chain order, along
+// with the total number of matching events.
+func (o *orm) FindEvents(ctx context.Context, filter EventsFilter, offset, limit int) (events []Event, count int, err error) {
+	where := `WHERE ($1::text = '' OR event_id = $1)
+AND ($2::timestamptz IS NULL OR created_at >= $2)
+AND ($3::timestamptz IS NULL OR created_at < $3)
+AND id > $4`
+	args := []any{string(filter.EventID), nullTime(filter.Since), nullTime(filter.Until), filter.AfterID}
+
+	if err = o.ds.GetContext(ctx, &count, `SELECT count(*) FROM audit_events `+where, args...); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count audit events")
+	}
+	args = append(args, offset, limit)
+	err = o.ds.SelectContext(ctx, &events, `SELECT * FROM audit_events `+where+` ORDER BY id ASC OFFSET $5 LIMIT $6`, args...)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to find audit events")
+	}
+	return events, count, nil
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,136 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func TestORM_AppendAndVerify(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	_, err := orm.AppendEvent(ctx, audit.JobCreated, audit.Data{"id": 1})
+	require.NoError(t, err)
+	_, err = orm.AppendEvent(ctx, audit.BridgeCreated, audit.Data{"name": "bridge"})
+	require.NoError(t, err)
+	_, err = orm.AppendEvent(ctx, audit.JobDeleted, audit.Data{"id": 1})
+	require.NoError(t, err)
+
+	events, count, err := orm.FindEvents(ctx, audit.EventsFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Len(t, events, 3)
+	assert.Empty(t, events[0].PrevHash)
+	assert.Equal(t, events[0].Hash, events[1].PrevHash)
+	require.NoError(t, audit.VerifyChain(nil, events))
+
+	// verifying page by page gives the same result
+	require.NoError(t, audit.VerifyChain(nil, events[:1]))
+	require.NoError(t, audit.VerifyChain(events[0].Hash, events[1:]))
+
+	t.Run("filters", func(t *testing.T) {
+		filtered, count, err := orm.FindEvents(ctx, audit.EventsFilter{EventID: audit.JobCreated}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, events[0].ID, filtered[0].ID)
+
+		filtered, count, err = orm.FindEvents(ctx, audit.EventsFilter{AfterID: events[0].ID}, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, events[1].ID, filtered[0].ID)
+
+		filtered, _, err = orm.FindEvents(ctx, audit.EventsFilter{Until: events[0].CreatedAt}, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+
+		filtered, _, err = orm.FindEvents(ctx, audit.EventsFilter{Since: time.Now().Add(time.Hour)}, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+	})
+
+	t.Run("rows cannot be modified", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, `UPDATE audit_events SET data = '{}' WHERE id = $1`, events[1].ID)
+		require.ErrorContains(t, err, "append only")
+	})
+}
+
+func TestVerifyChain_DetectsTampering(t *testing.T) {
+	t.Parallel()
+
+	var events []audit.Event
+	var prevHash []byte
+	for i, id := range []audit.EventID{audit.JobCreated, audit.KeyExported, audit.JobDeleted} {
+		e := audit.Event{
+			ID:        int64(i + 1),
+			EventID:   id,
+			Data:      `{"id":1}`,
+			PrevHash:  prevHash,
+			CreatedAt: time.Unix(int64(1700000000+i), 0),
+		}
+		e.Hash = e.ComputeHash()
+		prevHash = e.Hash
+		events = append(events, e)
+	}
+	require.NoError(t, audit.VerifyChain(nil, events))
+
+	tamper := func(f func([]audit.Event) []audit.Event) []audit.Event {
+		cp := make([]audit.Event, len(events))
+		copy(cp, events)
+		return f(cp)
+	}
+
+	tests := []struct {
+		name   string
+		events []audit.Event
+		id     int64
+	}{
+		{"edited data", tamper(func(es []audit.Event) []audit.Event { es[1].Data = `{"id":2}`; return es }), 2},
+		{"edited timestamp", tamper(func(es []audit.Event) []audit.Event { es[1].CreatedAt = es[1].CreatedAt.Add(time.Second); return es }), 2},
+		{"removed event", tamper(func(es []audit.Event) []audit.Event { return append(es[:1], es[2:]...) }), 3},
+		{"reordered events", tamper(func(es []audit.Event) []audit.Event { es[1], es[2] = es[2], es[1]; return es }), 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := audit.VerifyChain(nil, tt.events)
+			var chainErr *audit.ChainError
+			require.ErrorAs(t, err, &chainErr)
+			assert.Equal(t, tt.id, chainErr.ID)
+		})
+	}
+}
+
+func TestAuditLoggerService_PersistsAsynchronously(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	// forwarding is disabled, but events are still appended to the local audit trail
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), nil, db)
+	require.NoError(t, err)
+	require.NoError(t, auditLogger.Ready())
+	require.NoError(t, auditLogger.Start(ctx))
+
+	auditLogger.Audit(audit.JobCreated, audit.Data{"id": 1})
+	auditLogger.Audit(audit.JobDeleted, audit.Data{"id": 1})
+	// closing persists the buffered events
+	require.NoError(t, auditLogger.Close())
+
+	events, count, err := audit.NewORM(db).FindEvents(ctx, audit.EventsFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	assert.Equal(t, audit.JobCreated, events[0].EventID)
+	assert.Equal(t, audit.JobDeleted, events[1].EventID)
+	require.NoError(t, audit.VerifyChain(nil, events))
+}
@@ -22,11 +22,14 @@ type batchBHSConfig interface {
 }
 
 type BatchBlockhashStore struct {
-	config   batchBHSConfig
-	txm      txmgr.TxManager
-	abi      *abi.ABI
-	batchbhs batch_blockhash_store.BatchBlockhashStoreInterface
-	lggr     logger.Logger
+	config        batchBHSConfig
+	txm           txmgr.TxManager
+	abi           *abi.ABI
+	batchbhs      batch_blockhash_store.BatchBlockhashStoreInterface
+	lggr          logger.Logger
+	fromAddresses []types.EIP55Address
+	chainID       *big.Int
+	gethks        keystore.Eth
 }
 
 func NewBatchBHS(
@@ -43,11 +46,14 @@ func NewBatchBHS(
 		return nil, errors.Wrap(err, "building ABI")
 	}
 	return &BatchBlockhashStore{
-		config:   config,
-		txm:      txm,
-		abi:      abi,
-		batchbhs: batchbhs,
-		lggr:     lggr,
+		config:        config,
+		txm:           txm,
+		abi:           abi,
+		batchbhs:      batchbhs,
+		lggr:          lggr,
+		fromAddresses: fromAddresses,
+		chainID:       chainID,
+		gethks:        gethks,
 	}, nil
 }
 
@@ -79,3 +85,35 @@ func (b *BatchBlockhashStore) StoreVerifyHeader(ctx context.Context, blockNumber
 
 	return nil
 }
+
+// StoreBatch stores the blockhashes of the given blocks in the BlockhashStore the
+// BatchBlockhashStore wraps, in a single transaction, satisfying the BatchBHS interface.
+// The transaction has the default gas limit for each block it stores.
+func (b *BatchBlockhashStore) StoreBatch(ctx context.Context, blockNums []uint64) error {
+	blockNumbers := make([]*big.Int, len(blockNums))
+	for i, blockNum := range blockNums {
+		blockNumbers[i] = new(big.Int).SetUint64(blockNum)
+	}
+	payload, err := b.abi.Pack("store", blockNumbers)
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	fromAddress, err := b.gethks.GetRoundRobinAddress(ctx, b.chainID, SendingKeys(b.fromAddresses)...)
+	if err != nil {
+		return errors.Wrap(err, "getting next from address")
+	}
+
+	_, err = b.txm.CreateTransaction(ctx, txmgr.TxRequest{
+		FromAddress:    fromAddress,
+		ToAddress:      b.batchbhs.Address(),
+		EncodedPayload: payload,
+		FeeLimit:       b.config.LimitDefault() * uint64(len(blockNums)),
+		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
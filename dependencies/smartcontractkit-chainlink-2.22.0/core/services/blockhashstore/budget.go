@@ -0,0 +1,88 @@
+package blockhashstore
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// gasSpends are the gas limits of the store transactions sent on a chain. They are shared by
+// the feeders of every BHS job on the chain, so that a job's gas budget also accounts for the
+// transactions the other jobs on the chain sent.
+type gasSpends struct {
+	mu     sync.Mutex
+	spends []*gasSpend
+	// period is the longest gas budget period of the jobs on the chain, for which spends are kept
+	period time.Duration
+}
+
+type gasSpend struct {
+	at  time.Time
+	gas uint64
+}
+
+// used returns the gas spent within the period before now. It must be called with mu held.
+func (s *gasSpends) used(now time.Time, period time.Duration) uint64 {
+	var i int
+	for i < len(s.spends) && !s.spends[i].at.After(now.Add(-s.period)) {
+		i++
+	}
+	s.spends = s.spends[i:]
+
+	var total uint64
+	for _, spend := range s.spends {
+		if spend.at.After(now.Add(-period)) {
+			total += spend.gas
+		}
+	}
+	return total
+}
+
+// gasBudget limits the total gas limit of the store transactions sent on a chain within a
+// sliding window of time. A zero limit allows everything.
+type gasBudget struct {
+	limit  uint64
+	period time.Duration
+	spends *gasSpends
+}
+
+// newGasBudget creates a gasBudget accounting for the given spends, or for its own spends only
+// if spends is nil.
+func newGasBudget(limit uint64, period time.Duration, spends *gasSpends) gasBudget {
+	if spends == nil {
+		spends = &gasSpends{}
+	}
+	if limit > 0 {
+		spends.mu.Lock()
+		spends.period = max(spends.period, period)
+		spends.mu.Unlock()
+	}
+	return gasBudget{limit: limit, period: period, spends: spends}
+}
+
+// take records gas spent at now, unless it exceeds the budget. It returns whether the gas was
+// taken, and a func giving it back if the transaction it was taken for is not sent after all.
+func (b *gasBudget) take(now time.Time, gas uint64) (refund func(), ok bool) {
+	s := b.spends
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b.limit > 0 && s.used(now, b.period)+gas > b.limit {
+		return nil, false
+	}
+	spend := &gasSpend{at: now, gas: gas}
+	s.spends = append(s.spends, spend)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if i := slices.Index(s.spends, spend); i >= 0 {
+			s.spends = slices.Delete(s.spends, i, i+1)
+		}
+	}, true
+}
+
+// used returns the gas spent on the chain within the period before now.
+func (b *gasBudget) used(now time.Time) uint64 {
+	b.spends.mu.Lock()
+	defer b.spends.mu.Unlock()
+	return b.spends.used(now, b.period)
+}
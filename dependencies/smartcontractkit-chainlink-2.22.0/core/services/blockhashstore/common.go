@@ -30,6 +30,9 @@ type Event struct {
 
 	// Block that the request or fulfillment was included in.
 	Block uint64
+
+	// Payment offered for a request, if known. Only VRF V1 requests pay a fee upfront.
+	Payment *big.Int
 }
 
 // BHS defines an interface for interacting with a BlockhashStore contract.
@@ -54,14 +57,27 @@ func GetUnfulfilledBlocksAndRequests(
 	coordinator Coordinator,
 	fromBlock, toBlock uint64,
 ) (map[uint64]map[string]struct{}, error) {
+	blockToRequests, _, err := getUnfulfilledBlocksAndPayments(ctx, lggr, coordinator, fromBlock, toBlock)
+	return blockToRequests, err
+}
+
+// getUnfulfilledBlocksAndPayments is GetUnfulfilledBlocksAndRequests, also returning the total
+// known payment of the unfulfilled requests of every block.
+func getUnfulfilledBlocksAndPayments(
+	ctx context.Context,
+	lggr logger.Logger,
+	coordinator Coordinator,
+	fromBlock, toBlock uint64,
+) (map[uint64]map[string]struct{}, map[uint64]*big.Int, error) {
 	blockToRequests := make(map[uint64]map[string]struct{})
 	requestIDToBlock := make(map[string]uint64)
+	requestIDToPayment := make(map[string]*big.Int)
 
 	reqs, err := coordinator.Requests(ctx, fromBlock, toBlock)
 	if err != nil {
 		lggr.Errorw("Failed to fetch VRF requests",
 			"err", err)
-		return nil, errors.Wrap(err, "fetching VRF requests")
+		return nil, nil, errors.Wrap(err, "fetching VRF requests")
 	}
 	for _, req := range reqs {
 		if _, ok := blockToRequests[req.Block]; !ok {
@@ -69,13 +85,16 @@ func GetUnfulfilledBlocksAndRequests(
 		}
 		blockToRequests[req.Block][req.ID] = struct{}{}
 		requestIDToBlock[req.ID] = req.Block
+		if req.Payment != nil {
+			requestIDToPayment[req.ID] = req.Payment
+		}
 	}
 
 	fuls, err := coordinator.Fulfillments(ctx, fromBlock)
 	if err != nil {
 		lggr.Errorw("Failed to fetch VRF fulfillments",
 			"err", err)
-		return nil, errors.Wrap(err, "fetching VRF fulfillments")
+		return nil, nil, errors.Wrap(err, "fetching VRF fulfillments")
 	}
 	for _, ful := range fuls {
 		requestBlock, ok := requestIDToBlock[ful.ID]
@@ -85,7 +104,18 @@ func GetUnfulfilledBlocksAndRequests(
 		delete(blockToRequests[requestBlock], ful.ID)
 	}
 
-	return blockToRequests, nil
+	blockToPayment := make(map[uint64]*big.Int)
+	for block, reqs := range blockToRequests {
+		payment := new(big.Int)
+		for id := range reqs {
+			if p, ok := requestIDToPayment[id]; ok {
+				payment.Add(payment, p)
+			}
+		}
+		blockToPayment[block] = payment
+	}
+
+	return blockToRequests, blockToPayment, nil
 }
 
 // LimitReqIDs converts a set of request IDs to a slice limited to maxLength.
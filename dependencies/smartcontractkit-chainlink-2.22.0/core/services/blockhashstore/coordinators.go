@@ -112,7 +112,7 @@ func (v *V1Coordinator) Requests(
 		if !ok {
 			continue // malformed log should not break flow
 		}
-		reqs = append(reqs, Event{ID: hex.EncodeToString(request.RequestID[:]), Block: request.Raw.BlockNumber})
+		reqs = append(reqs, Event{ID: hex.EncodeToString(request.RequestID[:]), Block: request.Raw.BlockNumber, Payment: request.Fee})
 	}
 
 	return reqs, nil
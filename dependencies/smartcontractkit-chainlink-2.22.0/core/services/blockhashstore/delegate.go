@@ -10,9 +10,11 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-integrations/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/batch_blockhash_store"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/blockhash_store"
 	v1 "github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/solidity_vrf_coordinator_interface"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/trusted_blockhash_store"
@@ -36,6 +38,11 @@ type Delegate struct {
 	logger       logger.Logger
 	legacyChains legacyevm.LegacyChainContainer
 	ks           keystore.Eth
+	orm          ORM
+
+	gasSpendsMu sync.Mutex
+	// gasSpends are the store transactions sent on each chain, by chain ID
+	gasSpends map[string]*gasSpends
 }
 
 // NewDelegate creates a new Delegate.
@@ -44,13 +51,28 @@ func NewDelegate(
 	logger logger.Logger,
 	legacyChains legacyevm.LegacyChainContainer,
 	ks keystore.Eth,
+	ds sqlutil.DataSource,
 ) *Delegate {
 	return &Delegate{
 		cfg:          cfg,
 		logger:       logger,
 		legacyChains: legacyChains,
 		ks:           ks,
+		orm:          NewORM(ds),
+		gasSpends:    make(map[string]*gasSpends),
+	}
+}
+
+// chainGasSpends returns the store transactions sent on the given chain, shared by its jobs.
+func (d *Delegate) chainGasSpends(chainID string) *gasSpends {
+	d.gasSpendsMu.Lock()
+	defer d.gasSpendsMu.Unlock()
+	spends, ok := d.gasSpends[chainID]
+	if !ok {
+		spends = &gasSpends{}
+		d.gasSpends[chainID] = spends
 	}
+	return spends
 }
 
 // JobType satisfies the job.Delegate interface.
@@ -154,8 +176,9 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		coordinators = append(coordinators, coord)
 	}
 
+	gasEstimator := chain.Config().EVM().GasEstimator()
 	bpBHS, err := NewBulletproofBHS(
-		chain.Config().EVM().GasEstimator(),
+		gasEstimator,
 		d.cfg.Database(),
 		fromAddresses,
 		chain.TxManager(),
@@ -168,6 +191,54 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 		return nil, errors.Wrap(err, "building bulletproof bhs")
 	}
 
+	opts := FeederOptions{
+		JobID:           jb.ID,
+		GasBudget:       jb.BlockhashStoreSpec.GasBudget,
+		GasBudgetPeriod: jb.BlockhashStoreSpec.GasBudgetPeriod,
+		StoreGasLimit:   gasEstimator.LimitDefault(),
+		EVMChainID:      chain.ID().String(),
+		ORM:             d.orm,
+		gasSpends:       d.chainGasSpends(chain.ID().String()),
+	}
+	// txGasLimit is the largest gas limit of the store transactions of the job
+	txGasLimit := opts.StoreGasLimit
+	if jb.BlockhashStoreSpec.BatchBlockhashStoreAddress != nil && jb.BlockhashStoreSpec.BatchBlockhashStoreAddress.Hex() != EmptyAddress {
+		var batchBlockhashStore *batch_blockhash_store.BatchBlockhashStore
+		batchBlockhashStore, err = batch_blockhash_store.NewBatchBlockhashStore(
+			jb.BlockhashStoreSpec.BatchBlockhashStoreAddress.Address(), chain.Client())
+		if err != nil {
+			return nil, errors.Wrap(err, "building batch BHS")
+		}
+		var batchBHS *BatchBlockhashStore
+		batchBHS, err = NewBatchBHS(
+			gasEstimator,
+			fromAddresses,
+			chain.TxManager(),
+			batchBlockhashStore,
+			chain.ID(),
+			d.ks,
+			d.logger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "building batchBHS")
+		}
+		opts.BatchBHS = batchBHS
+		opts.BatchSize = int(jb.BlockhashStoreSpec.StoreBatchSize)
+		opts.BatchDeadline = jb.BlockhashStoreSpec.StoreBatchDeadline
+
+		txGasLimit = opts.StoreGasLimit * uint64(opts.BatchSize)
+		if limitMax := gasEstimator.LimitMax(); limitMax > 0 && txGasLimit > limitMax {
+			return nil, errors.Errorf(
+				`"storeBatchSize" of %d needs a gas limit of %d, above the maximum gas limit of chain %s of %d`,
+				opts.BatchSize, txGasLimit, opts.EVMChainID, limitMax)
+		}
+	}
+	if opts.GasBudget > 0 && opts.GasBudget < txGasLimit {
+		return nil, errors.Errorf(
+			`"gasBudget" of %d is below the gas limit of a store transaction on chain %s of %d`,
+			opts.GasBudget, opts.EVMChainID, txGasLimit)
+	}
+
 	log := d.logger.Named("BHSFeeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
 	feeder := NewFeeder(
 		log,
@@ -184,7 +255,8 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				return 0, errors.Wrap(err, "getting chain head")
 			}
 			return uint64(head.BlockNumber), nil
-		})
+		},
+		opts)
 
 	return []job.ServiceCtx{&service{
 		feeder:     feeder,
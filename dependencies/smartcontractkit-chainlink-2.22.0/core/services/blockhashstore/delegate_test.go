@@ -32,7 +32,7 @@ func TestDelegate_JobType(t *testing.T) {
 	t.Parallel()
 
 	lggr := logger.TestLogger(t)
-	delegate := blockhashstore.NewDelegate(nil, lggr, nil, nil)
+	delegate := blockhashstore.NewDelegate(nil, lggr, nil, nil, nil)
 
 	assert.Equal(t, job.BlockhashStore, delegate.JobType())
 }
@@ -73,7 +73,7 @@ func createTestDelegate(t *testing.T) (*blockhashstore.Delegate, *testData) {
 			LogPoller:      lp,
 		},
 	)
-	return blockhashstore.NewDelegate(cfg, lggr, legacyChains, kst), &testData{
+	return blockhashstore.NewDelegate(cfg, lggr, legacyChains, kst, db), &testData{
 		ethClient:    ethClient,
 		ethKeyStore:  kst,
 		legacyChains: legacyChains,
@@ -116,6 +116,35 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 		require.Len(t, services, 1)
 	})
 
+	t.Run("storeBatchSize above the maximum gas limit", func(t *testing.T) {
+		gasEstimator := testData.legacyChains.Slice()[0].Config().EVM().GasEstimator()
+		batchBHS := cltest.NewEIP55Address()
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks:                 defaultWaitBlocks,
+			BatchBlockhashStoreAddress: &batchBHS,
+			StoreBatchSize:             int32(gasEstimator.LimitMax()/gasEstimator.LimitDefault()) + 1,
+			EVMChainID:                 (*big.Big)(testutils.FixtureChainID),
+		}}
+		_, err := delegate.ServicesForSpec(testutils.Context(t), spec)
+		require.ErrorContains(t, err, `"storeBatchSize"`)
+
+		spec.BlockhashStoreSpec.StoreBatchSize--
+		services, err := delegate.ServicesForSpec(testutils.Context(t), spec)
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+	})
+
+	t.Run("gasBudget below the gas limit of a store transaction", func(t *testing.T) {
+		gasEstimator := testData.legacyChains.Slice()[0].Config().EVM().GasEstimator()
+		spec := job.Job{BlockhashStoreSpec: &job.BlockhashStoreSpec{
+			WaitBlocks: defaultWaitBlocks,
+			GasBudget:  gasEstimator.LimitDefault() - 1,
+			EVMChainID: (*big.Big)(testutils.FixtureChainID),
+		}}
+		_, err := delegate.ServicesForSpec(testutils.Context(t), spec)
+		require.ErrorContains(t, err, `"gasBudget"`)
+	})
+
 	t.Run("missing BlockhashStoreSpec", func(t *testing.T) {
 		spec := job.Job{BlockhashStoreSpec: nil}
 		_, err := delegate.ServicesForSpec(testutils.Context(t), spec)
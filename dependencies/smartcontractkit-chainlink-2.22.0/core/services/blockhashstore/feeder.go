@@ -3,11 +3,15 @@ package blockhashstore
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
 	"golang.org/x/exp/maps"
 
@@ -17,6 +21,68 @@ import (
 
 const trustedTimeout = 1 * time.Second
 
+var (
+	promBlocksStored = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blockhash_store_feeder_blocks_stored",
+		Help: "Number of blocks whose blockhash was stored by the feeder",
+	}, []string{"evm_chain_id", "job_id"})
+	promBlocksDeferred = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "blockhash_store_feeder_blocks_deferred",
+		Help: "Number of blocks with unfulfilled requests whose blockhash is not stored yet, by reason",
+	}, []string{"evm_chain_id", "job_id", "reason"})
+	promBlocksExpired = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blockhash_store_feeder_blocks_expired",
+		Help: "Number of blocks with unfulfilled requests which left the lookback window before their blockhash was stored",
+	}, []string{"evm_chain_id", "job_id"})
+	promGasBudgetUsed = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "blockhash_store_feeder_gas_budget_used",
+		Help: "Total gas limit of the store transactions sent on the chain within the gas budget period",
+	}, []string{"evm_chain_id", "job_id"})
+)
+
+// Reasons for deferring the storage of a blockhash.
+const (
+	deferredOverBudget   = "over_budget"
+	deferredBatching     = "batching"
+	deferredTrustedBatch = "trusted_batch_full"
+)
+
+// BatchBHS defines an interface for storing blockhashes in batches.
+type BatchBHS interface {
+	// StoreBatch stores the hashes associated with blockNums in a single transaction.
+	StoreBatch(ctx context.Context, blockNums []uint64) error
+}
+
+// FeederOptions configures how a Feeder prioritizes, batches and budgets the storage of
+// blockhashes. The zero value stores every block on its own as soon as it is found.
+type FeederOptions struct {
+	// JobID labels the feeder's metrics.
+	JobID int32
+
+	// BatchBHS, if set, stores the blockhashes of an untrusted BHS in batches of BatchSize.
+	// Blocks accumulate until a batch is full, or the oldest block has waited BatchDeadline.
+	BatchBHS      BatchBHS
+	BatchSize     int
+	BatchDeadline time.Duration
+
+	// GasBudget is the maximum total gas limit of the store transactions sent on the chain per
+	// GasBudgetPeriod, by this feeder and the others sharing its gasSpends. A transaction has a
+	// gas limit of StoreGasLimit per block it stores, except for trusted BHS transactions which
+	// have a gas limit of StoreGasLimit. Zero means no budget.
+	GasBudget       uint64
+	GasBudgetPeriod time.Duration
+	StoreGasLimit   uint64
+
+	// EVMChainID labels the feeder's metrics.
+	EVMChainID string
+
+	// ORM, if set, persists the blocks deferred by the feeder.
+	ORM ORM
+
+	// gasSpends are the store transactions sent on the chain by the feeders of all its jobs.
+	gasSpends *gasSpends
+}
+
 // NewFeeder creates a new Feeder instance.
 func NewFeeder(
 	logger logger.Logger,
@@ -28,6 +94,7 @@ func NewFeeder(
 	lookbackBlocks int,
 	heartbeatPeriod time.Duration,
 	latestBlock func(ctx context.Context) (uint64, error),
+	opts FeederOptions,
 ) *Feeder {
 	return &Feeder{
 		lggr:                logger,
@@ -43,6 +110,12 @@ func NewFeeder(
 		lastRunBlock:        0,
 		wgStored:            sync.WaitGroup{},
 		heartbeatPeriod:     heartbeatPeriod,
+		opts:                opts,
+		jobID:               strconv.Itoa(int(opts.JobID)),
+		budget:              newGasBudget(opts.GasBudget, opts.GasBudgetPeriod, opts.gasSpends),
+		firstSeen:           make(map[uint64]time.Time),
+		deferred:            make(map[uint64]string),
+		now:                 time.Now,
 	}
 }
 
@@ -70,6 +143,16 @@ type Feeder struct {
 	wgStored      sync.WaitGroup
 	batchLock     sync.Mutex
 	errsLock      sync.Mutex
+
+	opts   FeederOptions
+	jobID  string
+	budget gasBudget
+	// firstSeen is when blocks waiting to be stored in a batch were first found
+	firstSeen map[uint64]time.Time
+	// deferred are the blocks with unfulfilled requests found but not stored yet, with the
+	// reason why, so that we can tell which blocks are not covered
+	deferred map[uint64]string
+	now      func() time.Time
 }
 
 type Timer interface {
@@ -120,17 +203,19 @@ func (f *Feeder) Run(ctx context.Context) error {
 	}
 
 	lggr := f.lggr.With("latestBlock", latestBlock, "fromBlock", fromBlock, "toBlock", toBlock)
-	blockToRequests, err := GetUnfulfilledBlocksAndRequests(ctx, lggr, f.coordinator, fromBlock, toBlock)
+	blockToRequests, blockToPayment, err := getUnfulfilledBlocksAndPayments(ctx, lggr, f.coordinator, fromBlock, toBlock)
 	if err != nil {
 		return err
 	}
 
 	// For a trusted BHS, run our trusted logic.
 	if f.bhs.IsTrusted() {
-		return f.runTrusted(ctx, latestBlock, fromBlock, blockToRequests)
+		err = f.runTrusted(ctx, lggr, latestBlock, fromBlock, blockToRequests)
+		return multierr.Append(err, f.recordDeferred(ctx, fromBlock))
 	}
 
 	var errs error
+	var blocks []uint64
 	for block, unfulfilledReqs := range blockToRequests {
 		if len(unfulfilledReqs) == 0 {
 			continue
@@ -154,18 +239,26 @@ func (f *Feeder) Run(ctx context.Context) error {
 		}
 
 		// Block needs to be stored
-		err = f.bhs.Store(ctx, block)
-		if err != nil {
-			f.lggr.Errorw("Failed to store block", "err", err, "block", block)
-			errs = multierr.Append(errs, errors.Wrap(err, "storing block"))
-			continue
+		blocks = append(blocks, block)
+	}
+
+	// Store the blocks with the highest payment first, and the oldest first among those,
+	// as they are the first to leave the lookback window.
+	sort.Slice(blocks, func(i, j int) bool {
+		if c := blockToPayment[blocks[i]].Cmp(blockToPayment[blocks[j]]); c != 0 {
+			return c > 0
 		}
+		return blocks[i] < blocks[j]
+	})
+	f.updateDeferred(lggr, fromBlock)
 
-		f.lggr.Infow("Stored blockhash",
-			"block", block, "latestBlock", latestBlock,
-			"unfulfilledReqIDs", LimitReqIDs(unfulfilledReqs, 50))
-		f.stored[block] = struct{}{}
+	if f.opts.BatchBHS != nil {
+		errs = multierr.Append(errs, f.storeBatches(ctx, latestBlock, blocks, blockToRequests))
+	} else {
+		errs = multierr.Append(errs, f.storeEach(ctx, latestBlock, blocks, blockToRequests))
 	}
+	f.reportCoverage(lggr, len(blocks))
+	errs = multierr.Append(errs, f.recordDeferred(ctx, fromBlock))
 
 	if f.lastRunBlock != 0 {
 		// Prune stored, anything older than fromBlock can be discarded
@@ -181,13 +274,176 @@ func (f *Feeder) Run(ctx context.Context) error {
 	return errs
 }
 
+// storeEach stores the blocks one at a time, in order, until the gas budget runs out.
+func (f *Feeder) storeEach(
+	ctx context.Context,
+	latestBlock uint64,
+	blocks []uint64,
+	blockToRequests map[uint64]map[string]struct{},
+) error {
+	var errs error
+	for i, block := range blocks {
+		refund, ok := f.budget.take(f.now(), f.opts.StoreGasLimit)
+		if !ok {
+			f.deferBlocks(blocks[i:], deferredOverBudget)
+			break
+		}
+		err := f.bhs.Store(ctx, block)
+		if err != nil {
+			refund()
+			f.lggr.Errorw("Failed to store block", "err", err, "block", block)
+			errs = multierr.Append(errs, errors.Wrap(err, "storing block"))
+			continue
+		}
+
+		f.lggr.Infow("Stored blockhash",
+			"block", block, "latestBlock", latestBlock,
+			"unfulfilledReqIDs", LimitReqIDs(blockToRequests[block], 50))
+		f.markStored(block)
+	}
+	return errs
+}
+
+// storeBatches accumulates the blocks, in order, into batches which are stored once full or
+// once their oldest block has waited for the batch deadline, until the gas budget runs out.
+func (f *Feeder) storeBatches(
+	ctx context.Context,
+	latestBlock uint64,
+	blocks []uint64,
+	blockToRequests map[uint64]map[string]struct{},
+) error {
+	now := f.now()
+	pending := make(map[uint64]time.Time, len(blocks))
+	for _, block := range blocks {
+		seen, ok := f.firstSeen[block]
+		if !ok {
+			seen = now
+		}
+		pending[block] = seen
+	}
+	// forget the blocks which were fulfilled, stored or left the lookback window
+	f.firstSeen = pending
+
+	var errs error
+	for len(blocks) > 0 {
+		batchSize := max(1, f.opts.BatchSize)
+		batch := blocks[:min(len(blocks), batchSize)]
+		due := len(batch) == batchSize
+		for _, block := range batch {
+			if now.Sub(f.firstSeen[block]) >= f.opts.BatchDeadline {
+				due = true
+			}
+		}
+		if !due {
+			f.deferBlocks(blocks, deferredBatching)
+			break
+		}
+		// the batch transaction has a gas limit of StoreGasLimit per block, see BatchBlockhashStore.StoreBatch
+		refund, ok := f.budget.take(now, f.opts.StoreGasLimit*uint64(len(batch)))
+		if !ok {
+			f.deferBlocks(blocks, deferredOverBudget)
+			break
+		}
+		blocks = blocks[len(batch):]
+
+		err := f.opts.BatchBHS.StoreBatch(ctx, batch)
+		if err != nil {
+			refund()
+			f.lggr.Errorw("Failed to store batch", "err", err, "blocks", batch)
+			errs = multierr.Append(errs, errors.Wrap(err, "storing batch"))
+			continue
+		}
+
+		for _, block := range batch {
+			f.lggr.Infow("Stored blockhash",
+				"block", block, "latestBlock", latestBlock,
+				"unfulfilledReqIDs", LimitReqIDs(blockToRequests[block], 50))
+			f.markStored(block)
+			delete(f.firstSeen, block)
+		}
+	}
+	return errs
+}
+
+func (f *Feeder) markStored(block uint64) {
+	f.stored[block] = struct{}{}
+	delete(f.deferred, block)
+	promBlocksStored.WithLabelValues(f.opts.EVMChainID, f.jobID).Inc()
+}
+
+func (f *Feeder) deferBlocks(blocks []uint64, reason string) {
+	for _, block := range blocks {
+		f.deferred[block] = reason
+	}
+}
+
+// updateDeferred reports the blocks deferred by the previous run which left the lookback
+// window without being stored, and forgets the deferred blocks for this run to defer again.
+func (f *Feeder) updateDeferred(lggr logger.Logger, fromBlock uint64) {
+	var expired []uint64
+	for block := range f.deferred {
+		if block < fromBlock {
+			expired = append(expired, block)
+		}
+	}
+	if len(expired) > 0 {
+		sort.Slice(expired, func(i, j int) bool { return expired[i] < expired[j] })
+		lggr.Criticalw("Blocks with unfulfilled requests left the lookback window before their blockhash was stored",
+			"blocks", expired)
+		promBlocksExpired.WithLabelValues(f.opts.EVMChainID, f.jobID).Add(float64(len(expired)))
+	}
+	f.deferred = make(map[uint64]string)
+}
+
+// recordDeferred persists the blocks deferred by the latest run, if the feeder has an ORM.
+func (f *Feeder) recordDeferred(ctx context.Context, fromBlock uint64) error {
+	if f.opts.ORM == nil {
+		return nil
+	}
+	if err := f.opts.ORM.RecordDeferredBlocks(ctx, f.opts.JobID, fromBlock, f.deferred); err != nil {
+		f.lggr.Errorw("Failed to record deferred blocks", "err", err)
+		return errors.Wrap(err, "recording deferred blocks")
+	}
+	return nil
+}
+
+// reportCoverage logs and exports the blocks deferred by the latest run.
+func (f *Feeder) reportCoverage(lggr logger.Logger, needed int) {
+	byReason := map[string][]uint64{
+		deferredOverBudget:   nil,
+		deferredBatching:     nil,
+		deferredTrustedBatch: nil,
+	}
+	for block, reason := range f.deferred {
+		byReason[reason] = append(byReason[reason], block)
+	}
+	for reason, blocks := range byReason {
+		promBlocksDeferred.WithLabelValues(f.opts.EVMChainID, f.jobID, reason).Set(float64(len(blocks)))
+		sort.Slice(blocks, func(i, j int) bool { return blocks[i] < blocks[j] })
+	}
+	promGasBudgetUsed.WithLabelValues(f.opts.EVMChainID, f.jobID).Set(float64(f.budget.used(f.now())))
+	if len(f.deferred) > 0 {
+		lggr.Infow("Deferred storing blockhashes",
+			"nBlocksNeeded", needed,
+			"overBudget", byReason[deferredOverBudget],
+			"batching", byReason[deferredBatching],
+			"trustedBatchFull", byReason[deferredTrustedBatch],
+			"gasBudgetUsed", f.budget.used(f.now()),
+			"gasBudget", f.opts.GasBudget)
+	}
+}
+
 func (f *Feeder) runTrusted(
 	ctx context.Context,
+	lggr logger.Logger,
 	latestBlock uint64,
 	fromBlock uint64,
 	blockToRequests map[uint64]map[string]struct{},
 ) error {
 	var errs error
+	f.updateDeferred(lggr, fromBlock)
+	var needed int
+	defer func() { f.reportCoverage(lggr, needed) }()
 
 	// Iterate through each request block via waitGroup.
 	// For blocks with pending requests, add them to the batch to be stored.
@@ -226,8 +482,11 @@ func (f *Feeder) runTrusted(
 
 			// If there's room, store the block in the batch. Threadsafe.
 			f.batchLock.Lock()
+			needed++
 			if len(batch) < int(f.trustedBHSBatchSize) {
 				batch[block] = struct{}{}
+			} else {
+				f.deferred[block] = deferredTrustedBatch
 			}
 			f.batchLock.Unlock()
 		}()
@@ -274,9 +533,16 @@ func (f *Feeder) runTrusted(
 			f.lggr.Debugw("no blocks to store", "latestBlock", latestBlock)
 			return errs
 		}
+		// the trusted BHS stores the whole batch in one transaction with a gas limit of StoreGasLimit
+		refund, ok := f.budget.take(f.now(), f.opts.StoreGasLimit)
+		if !ok {
+			f.deferBlocks(blocksToStore, deferredOverBudget)
+			return errs
+		}
 		// Store the batch of blocks and their blockhashes.
 		err = f.bhs.StoreTrusted(ctx, blocksToStore, blockhashesToStore, latestBlock, latestBlockhash)
 		if err != nil {
+			refund()
 			f.lggr.Errorw("Failed to store trusted",
 				"err", err,
 				"blocks", blocksToStore,
@@ -289,6 +555,7 @@ func (f *Feeder) runTrusted(
 		}
 		for i, block := range blocksToStore {
 			f.storedTrusted[block] = blockhashesToStore[i]
+			promBlocksStored.WithLabelValues(f.opts.EVMChainID, f.jobID).Inc()
 		}
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
@@ -240,7 +241,8 @@ func TestStartHeartbeats(t *testing.T) {
 			expectedDuration,
 			func(ctx context.Context) (uint64, error) {
 				return tests[0].latest, nil
-			})
+			},
+			FeederOptions{})
 
 		ctx, cancel := context.WithCancel(testutils.Context(t))
 		mockTimer := bhsmocks.NewTimer(t)
@@ -285,7 +287,8 @@ func TestStartHeartbeats(t *testing.T) {
 			expectedDuration,
 			func(ctx context.Context) (uint64, error) {
 				return tests[0].latest, nil
-			})
+			},
+			FeederOptions{})
 
 		ctx, cancel := context.WithCancel(testutils.Context(t))
 		mockTimer := bhsmocks.NewTimer(t)
@@ -332,7 +335,8 @@ func TestStartHeartbeats(t *testing.T) {
 			expectedDuration,
 			func(ctx context.Context) (uint64, error) {
 				return tests[0].latest, nil
-			})
+			},
+			FeederOptions{})
 
 		mockTimer := bhsmocks.NewTimer(t)
 		mockLogger.On("Infow", "Not starting heartbeat blockhash using storeEarliest").Once()
@@ -384,7 +388,8 @@ func (test testCase) testFeeder(t *testing.T) {
 		600*time.Second,
 		func(ctx context.Context) (uint64, error) {
 			return test.latest, nil
-		})
+		},
+		FeederOptions{})
 
 	err := feeder.Run(testutils.Context(t))
 	if test.expectedErrMsg == "" {
@@ -478,7 +483,8 @@ func (test testCase) testFeederWithLogPollerVRFv1(t *testing.T) {
 		600*time.Second,
 		func(ctx context.Context) (uint64, error) {
 			return test.latest, nil
-		})
+		},
+		FeederOptions{})
 
 	// Run feeder and assert correct results.
 	err = feeder.Run(testutils.Context(t))
@@ -576,7 +582,8 @@ func (test testCase) testFeederWithLogPollerVRFv2(t *testing.T) {
 		600*time.Second,
 		func(ctx context.Context) (uint64, error) {
 			return test.latest, nil
-		})
+		},
+		FeederOptions{})
 
 	// Run feeder and assert correct results.
 	err = feeder.Run(testutils.Context(t))
@@ -674,7 +681,8 @@ func (test testCase) testFeederWithLogPollerVRFv2Plus(t *testing.T) {
 		600*time.Second,
 		func(ctx context.Context) (uint64, error) {
 			return test.latest, nil
-		})
+		},
+		FeederOptions{})
 
 	// Run feeder and assert correct results.
 	err = feeder.Run(testutils.Context(t))
@@ -706,7 +714,8 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 		600*time.Second,
 		func(ctx context.Context) (uint64, error) {
 			return 250, nil
-		})
+		},
+		FeederOptions{})
 
 	// Should store block 100
 	require.NoError(t, feeder.Run(testutils.Context(t)))
@@ -726,6 +735,177 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 	require.Empty(t, feeder.stored)
 }
 
+type testBatchBHS struct {
+	batches [][]uint64
+}
+
+func (t *testBatchBHS) StoreBatch(_ context.Context, blockNums []uint64) error {
+	t.batches = append(t.batches, blockNums)
+	return nil
+}
+
+func TestFeeder_GasBudget(t *testing.T) {
+	coordinator := &TestCoordinator{
+		RequestEvents: []Event{
+			{Block: 150, ID: "1000"},
+			{Block: 155, ID: "1001"},
+			{Block: 160, ID: "1002", Payment: big.NewInt(5)},
+		},
+	}
+	bhs := &TestBHS{}
+	orm := &testORM{}
+	latest := uint64(200)
+	now := time.Now()
+	feeder := NewFeeder(
+		logger.TestLogger(t),
+		coordinator,
+		bhs,
+		&mocklp.LogPoller{},
+		0,
+		10,
+		100,
+		600*time.Second,
+		func(ctx context.Context) (uint64, error) {
+			return latest, nil
+		},
+		FeederOptions{JobID: 46001, GasBudget: 200, GasBudgetPeriod: time.Hour, StoreGasLimit: 100, EVMChainID: "1", ORM: orm})
+	feeder.now = func() time.Time { return now }
+
+	// paid requests first, then the oldest
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, []uint64{160, 150}, bhs.Stored)
+	require.Equal(t, map[uint64]string{155: deferredOverBudget}, feeder.deferred)
+	require.Equal(t, uint64(100), orm.fromBlock)
+	require.Equal(t, map[uint64]string{155: deferredOverBudget}, orm.deferred)
+	require.Equal(t, float64(1), promtestutil.ToFloat64(promBlocksDeferred.WithLabelValues("1", "46001", deferredOverBudget)))
+
+	// the budget is spent for the period
+	now = now.Add(30 * time.Minute)
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, []uint64{160, 150}, bhs.Stored)
+
+	// blocks leaving the lookback window unstored are reported
+	latest = 300
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Empty(t, feeder.deferred)
+	require.Empty(t, orm.deferred)
+	require.Equal(t, float64(1), promtestutil.ToFloat64(promBlocksExpired.WithLabelValues("1", "46001")))
+	require.Equal(t, float64(2), promtestutil.ToFloat64(promBlocksStored.WithLabelValues("1", "46001")))
+}
+
+func TestFeeder_Batching(t *testing.T) {
+	coordinator := &TestCoordinator{
+		RequestEvents: []Event{
+			{Block: 150, ID: "1000"},
+			{Block: 151, ID: "1001"},
+		},
+	}
+	bhs := &TestBHS{}
+	batchBHS := &testBatchBHS{}
+	now := time.Now()
+	feeder := NewFeeder(
+		logger.TestLogger(t),
+		coordinator,
+		bhs,
+		&mocklp.LogPoller{},
+		0,
+		10,
+		100,
+		600*time.Second,
+		func(ctx context.Context) (uint64, error) {
+			return 200, nil
+		},
+		FeederOptions{JobID: 46002, BatchBHS: batchBHS, BatchSize: 3, BatchDeadline: time.Minute, StoreGasLimit: 100})
+	feeder.now = func() time.Time { return now }
+
+	// waits for the batch to fill up
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Empty(t, batchBHS.batches)
+	require.Equal(t, map[uint64]string{150: deferredBatching, 151: deferredBatching}, feeder.deferred)
+
+	// stores a full batch right away, the rest waits
+	coordinator.RequestEvents = append(coordinator.RequestEvents,
+		Event{Block: 152, ID: "1002"},
+		Event{Block: 153, ID: "1003"},
+	)
+	now = now.Add(30 * time.Second)
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, [][]uint64{{150, 151, 152}}, batchBHS.batches)
+	require.Equal(t, map[uint64]string{153: deferredBatching}, feeder.deferred)
+	require.Equal(t, uint64(300), feeder.budget.used(now))
+
+	// stores a partial batch once its oldest block waited for the deadline
+	now = now.Add(time.Minute)
+	require.NoError(t, feeder.Run(testutils.Context(t)))
+	require.Equal(t, [][]uint64{{150, 151, 152}, {153}}, batchBHS.batches)
+	require.Empty(t, feeder.deferred)
+	require.Empty(t, bhs.Stored)
+	require.Equal(t, uint64(400), feeder.budget.used(now))
+}
+
+func TestFeeder_ChainGasBudget(t *testing.T) {
+	spends := &gasSpends{}
+	now := time.Now()
+	newFeeder := func(jobID int32, bhs BHS, opts FeederOptions) *Feeder {
+		opts.JobID = jobID
+		opts.gasSpends = spends
+		feeder := NewFeeder(
+			logger.TestLogger(t),
+			&TestCoordinator{RequestEvents: []Event{{Block: 150, ID: "1000"}, {Block: 151, ID: "1001"}}},
+			bhs,
+			&mocklp.LogPoller{},
+			0,
+			10,
+			100,
+			600*time.Second,
+			func(ctx context.Context) (uint64, error) {
+				return 200, nil
+			},
+			opts)
+		feeder.now = func() time.Time { return now }
+		return feeder
+	}
+
+	// the jobs of a chain share its gas budget, even if they do not have one
+	unbudgetedBHS := &TestBHS{}
+	unbudgeted := newFeeder(46003, unbudgetedBHS, FeederOptions{StoreGasLimit: 100})
+	budgetedBHS := &TestBHS{}
+	budgeted := newFeeder(46004, budgetedBHS, FeederOptions{GasBudget: 300, GasBudgetPeriod: time.Hour, StoreGasLimit: 100})
+
+	require.NoError(t, unbudgeted.Run(testutils.Context(t)))
+	require.Equal(t, []uint64{150, 151}, unbudgetedBHS.Stored)
+
+	require.NoError(t, budgeted.Run(testutils.Context(t)))
+	require.Equal(t, []uint64{150}, budgetedBHS.Stored)
+	require.Equal(t, map[uint64]string{151: deferredOverBudget}, budgeted.deferred)
+	require.Equal(t, uint64(300), budgeted.budget.used(now))
+
+	// failed stores give their gas back
+	failing := newFeeder(46005, &TestBHS{ErrorsStore: []uint64{150, 151}}, FeederOptions{StoreGasLimit: 100})
+	require.Error(t, failing.Run(testutils.Context(t)))
+	require.Equal(t, uint64(300), budgeted.budget.used(now))
+
+	// the budget frees up after its period
+	now = now.Add(time.Hour)
+	require.NoError(t, budgeted.Run(testutils.Context(t)))
+	require.Equal(t, []uint64{150, 151}, budgetedBHS.Stored)
+}
+
+type testORM struct {
+	fromBlock uint64
+	deferred  map[uint64]string
+}
+
+func (o *testORM) RecordDeferredBlocks(_ context.Context, _ int32, fromBlock uint64, deferred map[uint64]string) error {
+	o.fromBlock = fromBlock
+	o.deferred = maps.Clone(deferred)
+	return nil
+}
+
+func (o *testORM) FindDeferredBlocks(context.Context, int32) ([]DeferredBlock, error) {
+	return nil, nil
+}
+
 func newRandomnessRequestedLogV1(
 	t *testing.T,
 	requestBlock uint64,
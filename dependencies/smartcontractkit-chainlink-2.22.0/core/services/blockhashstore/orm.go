@@ -0,0 +1,88 @@
+package blockhashstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// DeferredBlocksMaxAge is how long the blocks which left the lookback window before their
+// blockhash was stored are kept.
+const DeferredBlocksMaxAge = 7 * 24 * time.Hour
+
+// DeferredBlock is a block with unfulfilled requests whose blockhash a feeder has not
+// stored yet, and why. An expired block left the lookback window before it was stored.
+type DeferredBlock struct {
+	JobID       int32     `db:"job_id"`
+	BlockNumber int64     `db:"block_number"`
+	Reason      string    `db:"reason"`
+	Expired     bool      `db:"expired"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// ORM persists the blocks deferred by feeders, so that their coverage can be checked.
+type ORM interface {
+	RecordDeferredBlocks(ctx context.Context, jobID int32, fromBlock uint64, deferred map[uint64]string) error
+	FindDeferredBlocks(ctx context.Context, jobID int32) ([]DeferredBlock, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM creates an ORM backed by the database.
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// RecordDeferredBlocks replaces the blocks of a job deferred by its previous run with the
+// given ones. Previously deferred blocks older than fromBlock are kept as expired, until
+// DeferredBlocksMaxAge.
+func (o *orm) RecordDeferredBlocks(ctx context.Context, jobID int32, fromBlock uint64, deferred map[uint64]string) error {
+	blockNumbers := make([]int64, 0, len(deferred))
+	reasons := make([]string, 0, len(deferred))
+	for block, reason := range deferred {
+		blockNumbers = append(blockNumbers, int64(block))
+		reasons = append(reasons, reason)
+	}
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		if _, err := tx.ExecContext(ctx, `
+UPDATE blockhash_store_deferred_blocks SET expired = TRUE, updated_at = NOW()
+WHERE job_id = $1 AND NOT expired AND block_number < $2`, jobID, int64(fromBlock)); err != nil {
+			return errors.Wrap(err, "expiring blocks")
+		}
+		if _, err := tx.ExecContext(ctx, `
+DELETE FROM blockhash_store_deferred_blocks
+WHERE job_id = $1 AND NOT expired AND NOT (block_number = ANY($2))`, jobID, pq.Array(blockNumbers)); err != nil {
+			return errors.Wrap(err, "deleting blocks no longer deferred")
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO blockhash_store_deferred_blocks (job_id, block_number, reason, created_at, updated_at)
+SELECT $1, block_number, reason, NOW(), NOW() FROM UNNEST($2::bigint[], $3::text[]) AS d(block_number, reason)
+ON CONFLICT (job_id, block_number) DO UPDATE SET reason = EXCLUDED.reason, expired = FALSE, updated_at = NOW()`,
+			jobID, pq.Array(blockNumbers), pq.Array(reasons)); err != nil {
+			return errors.Wrap(err, "upserting deferred blocks")
+		}
+		if _, err := tx.ExecContext(ctx, `
+DELETE FROM blockhash_store_deferred_blocks
+WHERE job_id = $1 AND expired AND updated_at < $2`, jobID, time.Now().Add(-DeferredBlocksMaxAge)); err != nil {
+			return errors.Wrap(err, "pruning expired blocks")
+		}
+		return nil
+	})
+	return errors.Wrap(err, "BHS ORM failed to RecordDeferredBlocks")
+}
+
+// FindDeferredBlocks returns the deferred and expired blocks of a job, by block number.
+func (o *orm) FindDeferredBlocks(ctx context.Context, jobID int32) (blocks []DeferredBlock, err error) {
+	err = o.ds.SelectContext(ctx, &blocks, `
+SELECT * FROM blockhash_store_deferred_blocks WHERE job_id = $1 ORDER BY block_number`, jobID)
+	return blocks, errors.Wrap(err, "BHS ORM failed to FindDeferredBlocks")
+}
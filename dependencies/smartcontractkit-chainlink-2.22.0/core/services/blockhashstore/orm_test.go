@@ -0,0 +1,57 @@
+package blockhashstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
+)
+
+func TestORM_DeferredBlocks(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := blockhashstore.NewORM(db)
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	otherJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	type block struct {
+		number  int64
+		reason  string
+		expired bool
+	}
+	blocksOf := func(jobID int32) []block {
+		deferred, err := orm.FindDeferredBlocks(ctx, jobID)
+		require.NoError(t, err)
+		var blocks []block
+		for _, d := range deferred {
+			assert.Equal(t, jobID, d.JobID)
+			blocks = append(blocks, block{d.BlockNumber, d.Reason, d.Expired})
+		}
+		return blocks
+	}
+
+	require.NoError(t, orm.RecordDeferredBlocks(ctx, jb.ID, 100, map[uint64]string{
+		150: "over_budget",
+		151: "batching",
+		152: "batching",
+	}))
+	require.NoError(t, orm.RecordDeferredBlocks(ctx, otherJob.ID, 100, map[uint64]string{150: "batching"}))
+	assert.Equal(t, []block{{150, "over_budget", false}, {151, "batching", false}, {152, "batching", false}}, blocksOf(jb.ID))
+
+	t.Run("replaces the blocks no longer deferred and expires those out of the window", func(t *testing.T) {
+		require.NoError(t, orm.RecordDeferredBlocks(ctx, jb.ID, 151, map[uint64]string{152: "over_budget", 200: "batching"}))
+		assert.Equal(t, []block{{150, "over_budget", true}, {152, "over_budget", false}, {200, "batching", false}}, blocksOf(jb.ID))
+
+		require.NoError(t, orm.RecordDeferredBlocks(ctx, jb.ID, 151, nil))
+		assert.Equal(t, []block{{150, "over_budget", true}}, blocksOf(jb.ID))
+	})
+
+	t.Run("does not touch the blocks of other jobs", func(t *testing.T) {
+		assert.Equal(t, []block{{150, "batching", false}}, blocksOf(otherJob.ID))
+	})
+}
@@ -55,6 +55,14 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 		return jb, notSet("trustedBlockhashStoreBatchSize")
 	}
 
+	batched := spec.BatchBlockhashStoreAddress != nil && spec.BatchBlockhashStoreAddress.Hex() != EmptyAddress
+	if batched && spec.StoreBatchSize <= 0 {
+		return jb, notSet("storeBatchSize")
+	}
+	if batched && spec.TrustedBlockhashStoreAddress != nil && spec.TrustedBlockhashStoreAddress.Hex() != EmptyAddress {
+		return jb, errors.New(`"batchBlockhashStoreAddress" cannot be used with "trustedBlockhashStoreAddress", which stores in batches already`)
+	}
+
 	// Defaults
 	if spec.WaitBlocks == 0 {
 		spec.WaitBlocks = 100
@@ -72,6 +80,19 @@ func ValidatedSpec(tomlString string) (job.Job, error) {
 		return jb, errors.New(`"heartbeatPeriod" must be greater than 0`)
 	}
 	// spec.HeartbeatPeriodTime == 0, default is heartbeat disabled
+	if batched && spec.StoreBatchDeadline == 0 {
+		spec.StoreBatchDeadline = time.Minute
+	}
+	if spec.StoreBatchDeadline < 0 {
+		return jb, errors.New(`"storeBatchDeadline" must be greater than 0`)
+	}
+	if spec.GasBudget > 0 && spec.GasBudgetPeriod == 0 {
+		spec.GasBudgetPeriod = time.Hour
+	}
+	if spec.GasBudgetPeriod < 0 {
+		return jb, errors.New(`"gasBudgetPeriod" must be greater than 0`)
+	}
+	// spec.GasBudget == 0, default is no gas budget
 
 	// Validation
 	if spec.WaitBlocks >= spec.LookbackBlocks {
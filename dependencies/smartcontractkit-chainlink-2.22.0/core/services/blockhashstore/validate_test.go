@@ -220,6 +220,56 @@ evmChainID = "4"`,
 				require.EqualError(t, err, `"trustedBlockhashStoreBatchSize" must be set`)
 			},
 		},
+		{
+			name: "batching and gas budget",
+			toml: `
+type = "blockhashstore"
+name = "batched-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+storeBatchSize = 10
+gasBudget = 5000000
+evmChainID = "4"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				batchBHS := types.EIP55Address("0x469aA2CD13e037DC5236320783dCfd0e641c0559")
+				require.Equal(t, &batchBHS, os.BlockhashStoreSpec.BatchBlockhashStoreAddress)
+				require.Equal(t, int32(10), os.BlockhashStoreSpec.StoreBatchSize)
+				require.Equal(t, time.Minute, os.BlockhashStoreSpec.StoreBatchDeadline)
+				require.Equal(t, uint64(5000000), os.BlockhashStoreSpec.GasBudget)
+				require.Equal(t, time.Hour, os.BlockhashStoreSpec.GasBudgetPeriod)
+			},
+		},
+		{
+			name: "batch BHS without batch size",
+			toml: `
+type = "blockhashstore"
+name = "batched-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+evmChainID = "4"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `"storeBatchSize" must be set`)
+			},
+		},
+		{
+			name: "batch BHS with trusted BHS",
+			toml: `
+type = "blockhashstore"
+name = "batched-test"
+coordinatorV2Address = "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+batchBlockhashStoreAddress = "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+storeBatchSize = 10
+trustedBlockhashStoreAddress = "0x0ad9FE7a58216242a8475ca92F222b0640E26B63"
+trustedBlockhashStoreBatchSize = 20
+evmChainID = "4"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, `"batchBlockhashStoreAddress" cannot be used with "trustedBlockhashStoreAddress", which stores in batches already`)
+			},
+		},
 		{
 			name: "invalid toml",
 			toml: `
@@ -3,6 +3,7 @@ package chainlink
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -57,6 +58,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2"
@@ -73,6 +75,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
@@ -82,6 +85,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
@@ -98,6 +102,7 @@ type Application interface {
 	GetDB() sqlutil.DataSource
 	GetConfig() GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	ReloadConfig() (ReloadResult, error)
 	GetKeyStore() keystore.Master
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
@@ -115,6 +120,8 @@ type Application interface {
 	BridgeORM() bridges.ORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	PermissionsORM() permissions.ORM
+	AuditORM() audit.ORM
 	TxmStorageService() txmgr.EvmTxStore
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
@@ -126,6 +133,15 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// WorkflowExecutions gives access to the execution history of workflows.
+	WorkflowExecutions() *workflows.ExecutionHistory
+
+	// VRFSubscriptions gives access to the balances of the subscriptions served by VRF jobs.
+	VRFSubscriptions() *vrfcommon.SubscriptionBalances
+
+	// MedianSourceHealth gives access to the source scores of OCR2 median jobs tracking the health of their sources.
+	MedianSourceHealth() *ocrcommon.SourceHealthRegistry
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
@@ -153,8 +169,13 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	permissionsORM           permissions.ORM
+	auditORM                 audit.ORM
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
+	workflowExecutions       *workflows.ExecutionHistory
+	vrfSubscriptions         *vrfcommon.SubscriptionBalances
+	medianSourceHealth       *ocrcommon.SourceHealthRegistry
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
@@ -184,6 +205,7 @@ type ApplicationOpts struct {
 	Logger                     logger.Logger
 	MailMon                    *mailbox.Monitor
 	DS                         sqlutil.DataSource
+	DBStats                    func() sql.DBStats // optional, used by the Nurse to detect DB pool saturation
 	KeyStore                   keystore.Master
 	RelayerChainInteroperators *CoreRelayerChainInteroperators
 	AuditLogger                audit.AuditLogger
@@ -325,10 +347,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Debug("Pyroscope (automatic pprof profiling) is disabled")
 	}
 
+	var nurse *services.Nurse
 	ap := cfg.AutoPprof()
 	if ap.Enabled() {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
-		srvcs = append(srvcs, services.NewNurse(ap, globalLogger))
+		nurse = services.NewNurse(ap, globalLogger)
+		srvcs = append(srvcs, nurse)
 	} else {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is disabled")
 	}
@@ -412,6 +436,22 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	)
 	srvcs = append(srvcs, workflowORM)
 
+	if nurse != nil {
+		if opts.DBStats != nil {
+			nurse.AddCheck("db_pool", services.NewDBPoolCheck(opts.DBStats, ap.DBPoolThreshold()))
+		}
+		nurse.AddCheck("pipeline_queue", services.NewPipelineQueueCheck(pipelineRunner.InFlightRuns, ap.PipelineQueueThreshold()))
+		nurse.AddCheck("head_lag", services.NewHeadLagCheck(func() map[string]time.Time {
+			latest := make(map[string]time.Time)
+			for _, chain := range legacyEVMChains.Slice() {
+				if head := chain.HeadTracker().LatestChain(); head != nil {
+					latest[chain.ID().String()] = head.Timestamp
+				}
+			}
+			return latest
+		}, ap.HeadLagThreshold().Duration()))
+	}
+
 	promReporter := headreporter.NewPrometheusReporter(opts.DS, legacyEVMChains)
 	chainIDs := make([]*big.Int, legacyEVMChains.Len())
 	for i, chain := range legacyEVMChains.Slice() {
@@ -429,6 +469,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	loopRegistrarConfig := plugins.NewRegistrarConfig(opts.GRPCOpts, opts.LoopRegistry.Register, opts.LoopRegistry.Unregister)
 
+	vrfSubscriptions := vrfcommon.NewSubscriptionBalances()
+	medianSourceHealth := ocrcommon.NewSourceHealthRegistry()
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
@@ -452,7 +495,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				pipelineORM,
 				legacyEVMChains,
 				globalLogger,
-				mailMon),
+				mailMon,
+				vrfSubscriptions),
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
@@ -464,7 +508,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				cfg,
 				globalLogger,
 				legacyEVMChains,
-				keyStore.Eth()),
+				keyStore.Eth(),
+				opts.DS),
 			job.BlockHeaderFeeder: blockheaderfeeder.NewDelegate(
 				cfg,
 				globalLogger,
@@ -491,6 +536,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		workflowORM,
 		creServices.workflowRateLimiter,
 		creServices.workflowLimits,
+		creServices.workflowExecutions,
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
@@ -536,6 +582,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		peerWrapper,
 		opts.NewOracleFactoryFn,
 		opts.FetcherFactoryFn,
+		creServices.workflowExecutions,
 	)
 
 	if cfg.OCR().Enabled() {
@@ -578,6 +625,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				MailMon:               mailMon,
 				CapabilitiesRegistry:  opts.CapabilitiesRegistry,
 				RetirementReportCache: opts.RetirementReportCache,
+				SourceHealth:          medianSourceHealth,
 			},
 			ocr2DelegateConfig,
 		)
@@ -665,8 +713,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		bridgeORM:                bridgeORM,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		permissionsORM:           permissions.NewORM(opts.DS),
+		auditORM:                 audit.NewORM(opts.DS),
 		txmStorageService:        txmORM,
 		FeedsService:             feedsService,
+		workflowExecutions:       creServices.workflowExecutions,
+		vrfSubscriptions:         vrfSubscriptions,
+		medianSourceHealth:       medianSourceHealth,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -729,6 +782,11 @@ type CREServices struct {
 	// gatewayConnectorWrapper is the wrapper for the gateway connector
 	// it is exposed because there are contingent services in the application
 	gatewayConnectorWrapper *gatewayconnector.ServiceWrapper
+
+	// workflowExecutions gives access to workflow executions and re-runs them
+	// it is exposed because it is shared by the workflow delegate and the web API
+	workflowExecutions *workflows.ExecutionHistory
+
 	// srvs are all the services that are created, including those that are explicitly exposed
 	srvs []services.ServiceCtx
 }
@@ -744,6 +802,7 @@ func newCREServices(cscfg creServiceConfig) (*CREServices, error) {
 		ds                   = cscfg.DS
 	)
 	var srvcs []services.ServiceCtx
+	workflowExecutions := workflows.NewExecutionHistory(workflowstore.NewDBStore(ds, globalLogger, clockwork.NewRealClock()))
 	workflowRateLimiter, err := ratelimiter.NewRateLimiter(ratelimiter.Config{
 		GlobalRPS:      capCfg.RateLimit().GlobalRPS(),
 		GlobalBurst:    capCfg.RateLimit().GlobalBurst(),
@@ -844,17 +903,9 @@ func newCREServices(cscfg creServiceConfig) (*CREServices, error) {
 					fetcherFunc = opts.FetcherFunc
 				}
 
-				err = keyStore.Workflow().EnsureKey(context.Background())
+				key, err := ensureWorkflowKey(keyStore)
 				if err != nil {
-					return nil, fmt.Errorf("failed to ensure workflow key: %w", err)
-				}
-
-				keys, err := keyStore.Workflow().GetAll()
-				if err != nil {
-					return nil, fmt.Errorf("failed to get all workflow keys: %w", err)
-				}
-				if len(keys) != 1 {
-					return nil, fmt.Errorf("expected 1 key, got %d", len(keys))
+					return nil, err
 				}
 
 				eventHandler := syncer.NewEventHandler(
@@ -865,9 +916,10 @@ func newCREServices(cscfg creServiceConfig) (*CREServices, error) {
 					opts.CapabilitiesRegistry,
 					custmsg.NewLabeler(),
 					clockwork.NewRealClock(),
-					keys[0],
+					key,
 					workflowRateLimiter,
 					workflowLimits,
+					syncer.WithExecutionHistory(workflowExecutions),
 					syncer.WithMaxArtifactSize(
 						syncer.ArtifactConfig{
 							MaxBinarySize:  uint64(capCfg.WorkflowRegistry().MaxBinarySize()),
@@ -903,14 +955,60 @@ func newCREServices(cscfg creServiceConfig) (*CREServices, error) {
 		globalLogger.Debug("External registry not configured, skipping registry syncer and starting with an empty registry")
 		opts.CapabilitiesRegistry.SetLocalRegistry(&capabilities.TestMetadataRegistry{})
 	}
+
+	if wCfg.DevMode().Enabled() {
+		key, err := ensureWorkflowKey(keyStore)
+		if err != nil {
+			return nil, err
+		}
+
+		lggr := globalLogger.Named("WorkflowDevMode")
+		eventHandler := syncer.NewEventHandler(
+			lggr,
+			syncer.NewWorkflowRegistryDS(ds, globalLogger),
+			nil,
+			workflowstore.NewDBStore(ds, lggr, clockwork.NewRealClock()),
+			opts.CapabilitiesRegistry,
+			custmsg.NewLabeler(),
+			clockwork.NewRealClock(),
+			key,
+			workflowRateLimiter,
+			workflowLimits,
+			syncer.WithExecutionHistory(workflowExecutions),
+		)
+		srvcs = append(srvcs, syncer.NewDevModeWatcher(globalLogger, eventHandler, syncer.DevModeConfig{
+			Directory:       wCfg.DevMode().Directory(),
+			PollInterval:    wCfg.DevMode().PollInterval(),
+			TriggerInterval: wCfg.DevMode().TriggerInterval(),
+		}))
+	}
+
 	return &CREServices{
 		workflowRateLimiter:     workflowRateLimiter,
 		workflowLimits:          workflowLimits,
 		gatewayConnectorWrapper: gatewayConnectorWrapper,
+		workflowExecutions:      workflowExecutions,
 		srvs:                    srvcs,
 	}, nil
 }
 
+// ensureWorkflowKey returns the workflow key of the node, creating it if needed.
+func ensureWorkflowKey(keyStore creKeystore) (workflowkey.Key, error) {
+	err := keyStore.Workflow().EnsureKey(context.Background())
+	if err != nil {
+		return workflowkey.Key{}, fmt.Errorf("failed to ensure workflow key: %w", err)
+	}
+
+	keys, err := keyStore.Workflow().GetAll()
+	if err != nil {
+		return workflowkey.Key{}, fmt.Errorf("failed to get all workflow keys: %w", err)
+	}
+	if len(keys) != 1 {
+		return workflowkey.Key{}, fmt.Errorf("expected 1 key, got %d", len(keys))
+	}
+	return keys[0], nil
+}
+
 func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	if err := app.Config.SetLogLevel(lvl); err != nil {
 		return err
@@ -919,6 +1017,24 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// ReloadConfig re-reads the config files and applies changes to the reloadable
+// fields, see GeneralConfig.Reload.
+func (app *ChainlinkApplication) ReloadConfig() (ReloadResult, error) {
+	result, err := app.Config.Reload()
+	if err != nil {
+		return result, err
+	}
+	if result.LogLevelChanged() {
+		app.logger.SetLogLevel(app.Config.Log().Level())
+	}
+	if len(result.Applied) > 0 {
+		app.logger.Infow("Reloaded config", "applied", result.Applied, "restartRequired", result.RestartRequired)
+	} else if len(result.RestartRequired) > 0 {
+		app.logger.Warnw("Reloaded config, but changes require a restart", "restartRequired", result.RestartRequired)
+	}
+	return result, nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -1075,6 +1191,14 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) PermissionsORM() permissions.ORM {
+	return app.permissionsORM
+}
+
+func (app *ChainlinkApplication) AuditORM() audit.ORM {
+	return app.auditORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
@@ -1200,6 +1324,21 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+// WorkflowExecutions returns the execution history of workflows.
+func (app *ChainlinkApplication) WorkflowExecutions() *workflows.ExecutionHistory {
+	return app.workflowExecutions
+}
+
+// VRFSubscriptions returns the balances of the subscriptions served by VRF jobs.
+func (app *ChainlinkApplication) VRFSubscriptions() *vrfcommon.SubscriptionBalances {
+	return app.vrfSubscriptions
+}
+
+// MedianSourceHealth returns the source scores of OCR2 median jobs tracking the health of their sources.
+func (app *ChainlinkApplication) MedianSourceHealth() *ocrcommon.SourceHealthRegistry {
+	return app.medianSourceHealth
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	chain, err := app.GetRelayers().LegacyEVMChains().Get(chainID.String())
@@ -67,3 +67,23 @@ func (a *autoPprofConfig) ProfileRoot() string {
 	}
 	return s
 }
+
+func (a *autoPprofConfig) DBPoolThreshold() float64 {
+	return *a.c.DBPoolThreshold
+}
+
+func (a *autoPprofConfig) PipelineQueueThreshold() int64 {
+	return *a.c.PipelineQueueThreshold
+}
+
+func (a *autoPprofConfig) HeadLagThreshold() commonconfig.Duration {
+	return *a.c.HeadLagThreshold
+}
+
+func (a *autoPprofConfig) ContinuousProfileInterval() commonconfig.Duration {
+	return *a.c.ContinuousProfileInterval
+}
+
+func (a *autoPprofConfig) ContinuousProfileCount() int {
+	return int(*a.c.ContinuousProfileCount)
+}
@@ -30,4 +30,9 @@ func TestAutoPprofTest(t *testing.T) {
 	assert.Equal(t, 2, ap.MutexProfileFraction())
 	assert.Equal(t, utils.FileSize(1*utils.GB), ap.MemThreshold())
 	assert.Equal(t, 999, ap.GoroutineThreshold())
+	assert.Equal(t, 0.75, ap.DBPoolThreshold())
+	assert.Equal(t, int64(200), ap.PipelineQueueThreshold())
+	assert.Equal(t, 2*time.Minute, ap.HeadLagThreshold().Duration())
+	assert.Equal(t, 15*time.Minute, ap.ContinuousProfileInterval().Duration())
+	assert.Equal(t, 24, ap.ContinuousProfileCount())
 }
@@ -33,6 +33,25 @@ func (b *backupConfig) URL() *url.URL {
 	return b.s.BackupURL.URL()
 }
 
+func (b *backupConfig) MaxBackups() uint32 {
+	return *b.c.MaxBackups
+}
+
+func (b *backupConfig) MaxAge() time.Duration {
+	return b.c.MaxAge.Duration()
+}
+
+func (b *backupConfig) Compression() config.DatabaseBackupCompression {
+	return *b.c.Compression
+}
+
+func (b *backupConfig) EncryptionKey() string {
+	if b.s.BackupEncryptionKey == nil {
+		return ""
+	}
+	return string(*b.s.BackupEncryptionKey)
+}
+
 type lockConfig struct {
 	c toml.DatabaseLock
 }
@@ -3,13 +3,13 @@ package chainlink
 import "github.com/smartcontractkit/chainlink/v2/core/config/toml"
 
 type fluxMonitorConfig struct {
-	c toml.FluxMonitor
+	c func() toml.FluxMonitor // reloadable
 }
 
 func (f *fluxMonitorConfig) DefaultTransactionQueueDepth() uint32 {
-	return *f.c.DefaultTransactionQueueDepth
+	return *f.c().DefaultTransactionQueueDepth
 }
 
 func (f *fluxMonitorConfig) SimulateTransactions() bool {
-	return *f.c.SimulateTransactions
+	return *f.c().SimulateTransactions
 }
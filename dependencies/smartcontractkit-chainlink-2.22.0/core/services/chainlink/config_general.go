@@ -44,6 +44,11 @@ type generalConfig struct {
 
 	logMu sync.RWMutex // for the mutable fields Log.Level & Log.SQL
 
+	reloadMu    sync.RWMutex     // for reloaded
+	reloaded    reloadableConfig // the reloadable fields, which are never written to c
+	configFiles []string         // config files to re-read on Reload
+	reloadable  bool
+
 	passwordMu sync.RWMutex // passwords are set after initialization
 }
 
@@ -61,23 +66,17 @@ type GeneralConfigOpts struct {
 	OverrideFn func(*Config, *Secrets)
 
 	SkipEnv bool
+
+	configFiles []string // set by Setup, for reloading
 }
 
 func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) error {
-	configs := []string{}
-	for _, fileName := range configFiles {
-		b, err := os.ReadFile(fileName)
-		if err != nil {
-			return errors.Wrapf(err, "failed to read config file: %s", fileName)
-		}
-		configs = append(configs, string(b))
-	}
-
-	if configTOML := env.Config.Get(); configTOML != "" {
-		configs = append(configs, configTOML)
+	configs, err := readConfigFiles(configFiles)
+	if err != nil {
+		return err
 	}
-
 	o.ConfigStrings = configs
+	o.configFiles = configFiles
 
 	secrets := []string{}
 	for _, fileName := range secretsFiles {
@@ -92,6 +91,23 @@ func (o *GeneralConfigOpts) Setup(configFiles []string, secretsFiles []string) e
 	return nil
 }
 
+// readConfigFiles reads the given config files, followed by the CL_CONFIG env var.
+func readConfigFiles(configFiles []string) ([]string, error) {
+	configs := []string{}
+	for _, fileName := range configFiles {
+		b, err := os.ReadFile(fileName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read config file: %s", fileName)
+		}
+		configs = append(configs, string(b))
+	}
+
+	if configTOML := env.Config.Get(); configTOML != "" {
+		configs = append(configs, configTOML)
+	}
+	return configs, nil
+}
+
 // parseConfig sets Config from the given TOML string, overriding any existing duplicate Config fields.
 func (o *GeneralConfigOpts) parseConfig(config string) error {
 	var c Config
@@ -164,6 +180,10 @@ func (o GeneralConfigOpts) New() (GeneralConfig, error) {
 		c:             &o.Config,
 		secrets:       &o.Secrets,
 		warning:       warning,
+		reloaded:      newReloadableConfig(&o.Config),
+		configFiles:   o.configFiles,
+		// test overrides would be lost on reload
+		reloadable: o.configFiles != nil && o.OverrideFn == nil,
 	}
 	if lvl := o.Config.Log.Level; lvl != nil {
 		cfg.logLevelDefault = zapcore.Level(*lvl)
@@ -276,6 +296,8 @@ func (g *generalConfig) LogConfiguration(log, warn coreconfig.LogfFn) {
 
 // ConfigTOML implements chainlink.ConfigV2
 func (g *generalConfig) ConfigTOML() (user, effective string) {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
 	return g.inputTOML, g.effectiveTOML
 }
 
@@ -357,7 +379,7 @@ func (g *generalConfig) TronEnabled() bool {
 }
 
 func (g *generalConfig) WebServer() config.WebServer {
-	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir}
+	return &webServerConfig{c: g.c.WebServer, s: g.secrets.WebServer, rootDir: g.RootDir, rateLimit: g.webServerRateLimit}
 }
 
 func (g *generalConfig) AutoPprofBlockProfileRate() int {
@@ -425,7 +447,7 @@ func (g *generalConfig) ShutdownGracePeriod() time.Duration {
 }
 
 func (g *generalConfig) FluxMonitor() config.FluxMonitor {
-	return &fluxMonitorConfig{c: g.c.FluxMonitor}
+	return &fluxMonitorConfig{c: g.fluxMonitor}
 }
 
 func (g *generalConfig) InsecureFastScrypt() bool {
@@ -441,7 +463,7 @@ func (g *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 }
 
 func (g *generalConfig) JobPipeline() coreconfig.JobPipeline {
-	return &jobPipelineConfig{c: g.c.JobPipeline}
+	return &jobPipelineConfig{c: g.c.JobPipeline, httpRequest: g.jobPipelineHTTPRequest}
 }
 
 func (g *generalConfig) Keeper() config.Keeper {
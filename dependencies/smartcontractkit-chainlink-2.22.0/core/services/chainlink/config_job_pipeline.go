@@ -11,15 +11,16 @@ import (
 var _ config.JobPipeline = (*jobPipelineConfig)(nil)
 
 type jobPipelineConfig struct {
-	c toml.JobPipeline
+	c           toml.JobPipeline
+	httpRequest func() toml.JobPipelineHTTPRequest // reloadable
 }
 
 func (j *jobPipelineConfig) DefaultHTTPLimit() int64 {
-	return int64(*j.c.HTTPRequest.MaxSize)
+	return int64(*j.httpRequest().MaxSize)
 }
 
 func (j *jobPipelineConfig) DefaultHTTPTimeout() commonconfig.Duration {
-	return *j.c.HTTPRequest.DefaultTimeout
+	return *j.httpRequest().DefaultTimeout
 }
 
 func (j *jobPipelineConfig) MaxRunDuration() time.Duration {
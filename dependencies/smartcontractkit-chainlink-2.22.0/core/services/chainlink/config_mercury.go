@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 var _ config.MercuryCache = (*mercuryCacheConfig)(nil)
@@ -66,6 +67,20 @@ func (m *mercuryTransmitterConfig) ReaperMaxAge() commonconfig.Duration {
 	return *m.c.ReaperMaxAge
 }
 
+func (m *mercuryTransmitterConfig) TransmitQueueSpillDir() string {
+	if m.c.TransmitQueueSpillDir == nil {
+		return ""
+	}
+	return *m.c.TransmitQueueSpillDir
+}
+
+func (m *mercuryTransmitterConfig) TransmitQueueSpillMaxSize() utils.FileSize {
+	if m.c.TransmitQueueSpillMaxSize == nil {
+		return 0
+	}
+	return *m.c.TransmitQueueSpillMaxSize
+}
+
 type mercuryConfig struct {
 	c toml.Mercury
 	s toml.MercurySecrets
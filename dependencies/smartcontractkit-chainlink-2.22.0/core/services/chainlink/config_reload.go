@@ -0,0 +1,207 @@
+package chainlink
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	gotoml "github.com/pelletier/go-toml/v2"
+	"go.uber.org/zap/zapcore"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+// ErrReloadUnsupported is returned by Reload when the config was not loaded from files.
+var ErrReloadUnsupported = errors.New("config was not loaded from files and cannot be reloaded")
+
+// reloadableFields are the config fields, or parent tables, that can be changed
+// without restarting the node.
+var reloadableFields = []string{
+	"Database.LogQueries",
+	"Log.Level",
+	"JobPipeline.HTTPRequest",
+	"WebServer.RateLimit",
+	"FluxMonitor",
+}
+
+// reloadableConfig holds the config tables which can be changed by Reload. They
+// are kept apart from generalConfig.c, which is read without locking, and are
+// only accessed while holding reloadMu.
+type reloadableConfig struct {
+	jobPipelineHTTPRequest toml.JobPipelineHTTPRequest
+	webServerRateLimit     toml.WebServerRateLimit
+	fluxMonitor            toml.FluxMonitor
+}
+
+func newReloadableConfig(c *Config) reloadableConfig {
+	return reloadableConfig{
+		jobPipelineHTTPRequest: c.JobPipeline.HTTPRequest,
+		webServerRateLimit:     c.WebServer.RateLimit,
+		fluxMonitor:            c.FluxMonitor,
+	}
+}
+
+// ReloadResult reports the outcome of a config reload.
+type ReloadResult struct {
+	// Applied lists the changed fields which are now in effect.
+	Applied []string
+	// RestartRequired lists the changed fields which are ignored until the node is restarted.
+	RestartRequired []string
+}
+
+// LogLevelChanged returns true if the reload applied a new Log.Level.
+func (r ReloadResult) LogLevelChanged() bool {
+	return slices.Contains(r.Applied, "Log.Level")
+}
+
+// Reload re-reads the config files the node was started with, validates them,
+// and applies changes to the reloadable fields. Services read those fields on
+// use, so changes take effect without a restart. Secrets are not reloaded.
+func (g *generalConfig) Reload() (ReloadResult, error) {
+	var result ReloadResult
+	if !g.reloadable {
+		return result, ErrReloadUnsupported
+	}
+
+	configs, err := readConfigFiles(g.configFiles)
+	if err != nil {
+		return result, err
+	}
+	var o GeneralConfigOpts
+	for _, c := range configs {
+		if err = o.parseConfig(c); err != nil {
+			return result, err
+		}
+	}
+	o.Config.setDefaults()
+	if err = o.Config.Validate(); err != nil {
+		_, errList := commonconfig.MultiErrorList(err)
+		return result, errList
+	}
+
+	g.reloadMu.Lock()
+	defer g.reloadMu.Unlock()
+
+	current, err := g.currentTOML()
+	if err != nil {
+		return result, err
+	}
+	next, err := o.Config.TOMLString()
+	if err != nil {
+		return result, err
+	}
+	changed, err := changedFields(current, next)
+	if err != nil {
+		return result, err
+	}
+
+	for _, field := range changed {
+		if !isReloadable(field) {
+			result.RestartRequired = append(result.RestartRequired, field)
+			continue
+		}
+		result.Applied = append(result.Applied, field)
+	}
+	if len(result.Applied) == 0 {
+		return result, nil
+	}
+
+	if result.LogLevelChanged() {
+		if err = g.SetLogLevel(zapcore.Level(*o.Config.Log.Level)); err != nil {
+			return result, err
+		}
+	}
+	if slices.Contains(result.Applied, "Database.LogQueries") {
+		g.SetLogSQL(*o.Config.Database.LogQueries)
+	}
+	g.reloaded = newReloadableConfig(&o.Config)
+
+	g.effectiveTOML, err = g.currentTOML()
+	return result, err
+}
+
+// currentTOML returns the config in effect, with the reloaded fields. Callers must hold reloadMu.
+func (g *generalConfig) currentTOML() (string, error) {
+	g.logMu.RLock()
+	defer g.logMu.RUnlock()
+	c := *g.c
+	c.JobPipeline.HTTPRequest = g.reloaded.jobPipelineHTTPRequest
+	c.WebServer.RateLimit = g.reloaded.webServerRateLimit
+	c.FluxMonitor = g.reloaded.fluxMonitor
+	return c.TOMLString()
+}
+
+func (g *generalConfig) jobPipelineHTTPRequest() toml.JobPipelineHTTPRequest {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.reloaded.jobPipelineHTTPRequest
+}
+
+func (g *generalConfig) webServerRateLimit() toml.WebServerRateLimit {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.reloaded.webServerRateLimit
+}
+
+func (g *generalConfig) fluxMonitor() toml.FluxMonitor {
+	g.reloadMu.RLock()
+	defer g.reloadMu.RUnlock()
+	return g.reloaded.fluxMonitor
+}
+
+func isReloadable(field string) bool {
+	for _, r := range reloadableFields {
+		if field == r || strings.HasPrefix(field, r+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// changedFields returns the sorted, dotted paths of the fields which differ
+// between two TOML documents. Arrays of tables, like chain configs, are compared
+// as a whole.
+func changedFields(a, b string) ([]string, error) {
+	var am, bm map[string]any
+	if err := gotoml.Unmarshal([]byte(a), &am); err != nil {
+		return nil, fmt.Errorf("failed to decode current config: %w", err)
+	}
+	if err := gotoml.Unmarshal([]byte(b), &bm); err != nil {
+		return nil, fmt.Errorf("failed to decode new config: %w", err)
+	}
+	af, bf := make(map[string]any), make(map[string]any)
+	flatten("", am, af)
+	flatten("", bm, bf)
+
+	var changed []string
+	for k, v := range af {
+		if w, ok := bf[k]; !ok || !reflect.DeepEqual(v, w) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range bf {
+		if _, ok := af[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+func flatten(prefix string, m map[string]any, out map[string]any) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if sub, ok := v.(map[string]any); ok {
+			flatten(key, sub, out)
+			continue
+		}
+		out[key] = v
+	}
+}
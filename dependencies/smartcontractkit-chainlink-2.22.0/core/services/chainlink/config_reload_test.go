@@ -0,0 +1,128 @@
+package chainlink
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfig_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	writeConfig := func(s string) {
+		require.NoError(t, os.WriteFile(path, []byte(s), 0600))
+	}
+	writeConfig(`
+[Log]
+Level = 'info'
+
+[JobPipeline.HTTPRequest]
+DefaultTimeout = '15s'
+
+[WebServer]
+HTTPPort = 6688
+
+[WebServer.RateLimit]
+Authenticated = 1000
+`)
+
+	opts := GeneralConfigOpts{SkipEnv: true}
+	require.NoError(t, opts.Setup([]string{path}, nil))
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	// services hold on to these, and must observe reloaded values
+	rateLimit := cfg.WebServer().RateLimit()
+	jobPipeline := cfg.JobPipeline()
+
+	t.Run("no changes", func(t *testing.T) {
+		result, err := cfg.Reload()
+		require.NoError(t, err)
+		assert.Empty(t, result.Applied)
+		assert.Empty(t, result.RestartRequired)
+	})
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		writeConfig(`
+[Log]
+Level = 'debug'
+
+[AutoPprof]
+DBPoolThreshold = 2.0
+`)
+		_, err := cfg.Reload()
+		require.ErrorContains(t, err, "DBPoolThreshold")
+		assert.Equal(t, zapcore.InfoLevel, cfg.Log().Level())
+	})
+
+	t.Run("applies reloadable fields", func(t *testing.T) {
+		writeConfig(`
+[Log]
+Level = 'debug'
+
+[JobPipeline.HTTPRequest]
+DefaultTimeout = '30s'
+
+[WebServer]
+HTTPPort = 7777
+
+[WebServer.RateLimit]
+Authenticated = 5
+`)
+		result, err := cfg.Reload()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"JobPipeline.HTTPRequest.DefaultTimeout", "Log.Level", "WebServer.RateLimit.Authenticated"}, result.Applied)
+		assert.Equal(t, []string{"WebServer.HTTPPort"}, result.RestartRequired)
+		assert.True(t, result.LogLevelChanged())
+
+		assert.Equal(t, zapcore.DebugLevel, cfg.Log().Level())
+		assert.Equal(t, int64(5), rateLimit.Authenticated())
+		assert.Equal(t, 30*time.Second, jobPipeline.DefaultHTTPTimeout().Duration())
+		assert.Equal(t, uint16(6688), cfg.WebServer().HTTPPort())
+
+		_, effective := cfg.ConfigTOML()
+		assert.Contains(t, effective, "Authenticated = 5\n")
+		assert.Contains(t, effective, "HTTPPort = 6688\n")
+	})
+}
+
+func TestConfig_Reload_ConcurrentReads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("[WebServer.RateLimit]\nAuthenticated = 7\n"), 0600))
+	opts := GeneralConfigOpts{SkipEnv: true}
+	require.NoError(t, opts.Setup([]string{path}, nil))
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	// run with -race: config getters must not race with reloads
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = cfg.JobPipeline().DefaultHTTPTimeout()
+			_ = cfg.WebServer().RateLimit().Authenticated()
+			_ = cfg.FluxMonitor().DefaultTransactionQueueDepth()
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("[WebServer.RateLimit]\nAuthenticated = %d\n", i+1)), 0600))
+		_, err = cfg.Reload()
+		require.NoError(t, err)
+	}
+	wg.Wait()
+	assert.Equal(t, int64(10), cfg.WebServer().RateLimit().Authenticated())
+}
+
+func TestConfig_Reload_Unsupported(t *testing.T) {
+	cfg, err := GeneralConfigOpts{}.New()
+	require.NoError(t, err)
+	_, err = cfg.Reload()
+	require.ErrorIs(t, err, ErrReloadUnsupported)
+}
@@ -46,6 +46,10 @@ func (t *telemetryIngressConfig) UseBatchSend() bool {
 	return *t.c.UseBatchSend
 }
 
+func (t *telemetryIngressConfig) OTLPExport() bool {
+	return *t.c.OTLPExport
+}
+
 func (t *telemetryIngressConfig) Endpoints() []config.TelemetryIngressEndpoint {
 	var endpoints []config.TelemetryIngressEndpoint
 	for _, e := range t.c.Endpoints {
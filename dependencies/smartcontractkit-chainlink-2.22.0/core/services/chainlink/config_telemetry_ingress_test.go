@@ -23,6 +23,7 @@ func TestTelemetryIngressConfig(t *testing.T) {
 	assert.Equal(t, time.Minute, ticfg.SendInterval())
 	assert.Equal(t, 5*time.Second, ticfg.SendTimeout())
 	assert.True(t, ticfg.UseBatchSend())
+	assert.True(t, ticfg.OTLPExport())
 
 	tec := cfg.TelemetryIngress().Endpoints()
 
@@ -304,6 +304,9 @@ func TestConfig_Marshal(t *testing.T) {
 			Frequency:        &hour,
 			Mode:             &config.DatabaseBackupModeFull,
 			OnVersionUpgrade: ptr(true),
+			MaxBackups:       ptr[uint32](7),
+			MaxAge:           commoncfg.MustNewDuration(7 * 24 * time.Hour),
+			Compression:      ptr(config.DatabaseBackupCompressionZstd),
 		},
 	}
 	full.TelemetryIngress = toml.TelemetryIngress{
@@ -314,6 +317,7 @@ func TestConfig_Marshal(t *testing.T) {
 		SendInterval: commoncfg.MustNewDuration(time.Minute),
 		SendTimeout:  commoncfg.MustNewDuration(5 * time.Second),
 		UseBatchSend: ptr(true),
+		OTLPExport:   ptr(true),
 		Endpoints: []toml.TelemetryIngressEndpoint{{
 			Network:      ptr("EVM"),
 			ChainID:      ptr("1"),
@@ -512,6 +516,12 @@ func TestConfig_Marshal(t *testing.T) {
 			Global:   ptr(int32(200)),
 			PerOwner: ptr(int32(200)),
 		},
+		DevMode: toml.DevMode{
+			Enabled:         ptr(true),
+			Directory:       ptr("/tmp/workflows"),
+			PollInterval:    commoncfg.MustNewDuration(2 * time.Second),
+			TriggerInterval: commoncfg.MustNewDuration(time.Minute),
+		},
 	}
 	full.Keeper = toml.Keeper{
 		DefaultTransactionQueueDepth: ptr[uint32](17),
@@ -541,6 +551,12 @@ func TestConfig_Marshal(t *testing.T) {
 		MutexProfileFraction: ptr[int64](2),
 		MemThreshold:         ptr[utils.FileSize](utils.GB),
 		GoroutineThreshold:   ptr[int64](999),
+
+		DBPoolThreshold:           ptr(0.75),
+		PipelineQueueThreshold:    ptr[int64](200),
+		HeadLagThreshold:          commoncfg.MustNewDuration(2 * time.Minute),
+		ContinuousProfileInterval: commoncfg.MustNewDuration(15 * time.Minute),
+		ContinuousProfileCount:    ptr[int64](24),
 	}
 	full.Pyroscope = toml.Pyroscope{
 		ServerAddress: ptr("http://localhost:4040"),
@@ -798,12 +814,14 @@ func TestConfig_Marshal(t *testing.T) {
 			CertFile: ptr("/path/to/cert.pem"),
 		},
 		Transmitter: toml.MercuryTransmitter{
-			Protocol:             ptr(config.MercuryTransmitterProtocolGRPC),
-			TransmitQueueMaxSize: ptr(uint32(123)),
-			TransmitTimeout:      commoncfg.MustNewDuration(234 * time.Second),
-			TransmitConcurrency:  ptr(uint32(456)),
-			ReaperFrequency:      commoncfg.MustNewDuration(567 * time.Second),
-			ReaperMaxAge:         commoncfg.MustNewDuration(678 * time.Hour),
+			Protocol:                  ptr(config.MercuryTransmitterProtocolGRPC),
+			TransmitQueueMaxSize:      ptr(uint32(123)),
+			TransmitTimeout:           commoncfg.MustNewDuration(234 * time.Second),
+			TransmitConcurrency:       ptr(uint32(456)),
+			ReaperFrequency:           commoncfg.MustNewDuration(567 * time.Second),
+			ReaperMaxAge:              commoncfg.MustNewDuration(678 * time.Hour),
+			TransmitQueueSpillDir:     ptr("/path/to/spill"),
+			TransmitQueueSpillMaxSize: ptr(utils.FileSize(2 * utils.GB)),
 		},
 		VerboseLogging: ptr(true),
 	}
@@ -863,6 +881,9 @@ Dir = 'test/backup/dir'
 Frequency = '1h0m0s'
 Mode = 'full'
 OnVersionUpgrade = true
+MaxBackups = 7
+MaxAge = '168h0m0s'
+Compression = 'zstd'
 
 [Database.Listener]
 MaxReconnectDuration = '1m0s'
@@ -882,6 +903,7 @@ MaxBatchSize = 4321
 SendInterval = '1m0s'
 SendTimeout = '5s'
 UseBatchSend = true
+OTLPExport = true
 
 [[TelemetryIngress.Endpoints]]
 Network = 'EVM'
@@ -1040,6 +1062,11 @@ BlockProfileRate = 5
 MutexProfileFraction = 2
 MemThreshold = '1.00gb'
 GoroutineThreshold = 999
+DBPoolThreshold = 0.75
+PipelineQueueThreshold = 200
+HeadLagThreshold = '2m0s'
+ContinuousProfileInterval = '15m0s'
+ContinuousProfileCount = 24
 `},
 		{"Pyroscope", Config{Core: toml.Core{Pyroscope: full.Pyroscope}}, `[Pyroscope]
 ServerAddress = 'http://localhost:4040'
@@ -1283,6 +1310,8 @@ TransmitTimeout = '3m54s'
 TransmitConcurrency = 456
 ReaperFrequency = '9m27s'
 ReaperMaxAge = '678h0m0s'
+TransmitQueueSpillDir = '/path/to/spill'
+TransmitQueueSpillMaxSize = '2.00gb'
 `},
 		{"full", full, fullTOML},
 		{"multi-chain", multiChain, multiChainTOML},
@@ -1373,8 +1402,9 @@ func TestConfig_Validate(t *testing.T) {
 		toml string
 		exp  string
 	}{
-		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 9 errors:
+		{name: "invalid", toml: invalidTOML, exp: `invalid configuration: 10 errors:
 	- P2P.V2.Enabled: invalid value (false): P2P required for OCR or OCR2. Please enable P2P or disable OCR/OCR2.
+	- TelemetryIngress.OTLPExport: invalid value (true): Telemetry.Enabled required to export telemetry to the OTLP collector. Please enable Telemetry or disable OTLPExport.
 	- Database.Lock.LeaseRefreshInterval: invalid value (6s): must be less than or equal to half of LeaseDuration (10s)
 	- WebServer: 8 errors:
 		- LDAP.BaseDN: invalid value (<nil>): LDAP BaseDN can not be empty
@@ -65,23 +65,23 @@ func (t *tlsConfig) ListenIP() net.IP {
 }
 
 type rateLimitConfig struct {
-	c toml.WebServerRateLimit
+	c func() toml.WebServerRateLimit // reloadable
 }
 
 func (r *rateLimitConfig) Authenticated() int64 {
-	return *r.c.Authenticated
+	return *r.c().Authenticated
 }
 
 func (r *rateLimitConfig) AuthenticatedPeriod() time.Duration {
-	return r.c.AuthenticatedPeriod.Duration()
+	return r.c().AuthenticatedPeriod.Duration()
 }
 
 func (r *rateLimitConfig) Unauthenticated() int64 {
-	return *r.c.Unauthenticated
+	return *r.c().Unauthenticated
 }
 
 func (r *rateLimitConfig) UnauthenticatedPeriod() time.Duration {
-	return r.c.UnauthenticatedPeriod.Duration()
+	return r.c().UnauthenticatedPeriod.Duration()
 }
 
 type mfaConfig struct {
@@ -100,6 +100,8 @@ type webServerConfig struct {
 	c       toml.WebServer
 	s       toml.WebServerSecrets
 	rootDir func() string
+
+	rateLimit func() toml.WebServerRateLimit
 }
 
 func (w *webServerConfig) TLS() config.TLS {
@@ -107,7 +109,7 @@ func (w *webServerConfig) TLS() config.TLS {
 }
 
 func (w *webServerConfig) RateLimit() config.RateLimit {
-	return &rateLimitConfig{c: w.c.RateLimit}
+	return &rateLimitConfig{c: w.rateLimit}
 }
 
 func (w *webServerConfig) MFA() config.MFA {
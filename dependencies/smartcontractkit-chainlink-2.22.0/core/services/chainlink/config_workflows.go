@@ -1,6 +1,8 @@
 package chainlink
 
 import (
+	"time"
+
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 )
@@ -17,6 +19,12 @@ func (w *workflowsConfig) Limits() config.WorkflowsLimits {
 	}
 }
 
+func (w *workflowsConfig) DevMode() config.WorkflowsDevMode {
+	return &devMode{
+		d: w.c.DevMode,
+	}
+}
+
 type limits struct {
 	l toml.Limits
 }
@@ -28,3 +36,23 @@ func (l *limits) Global() int32 {
 func (l *limits) PerOwner() int32 {
 	return *l.l.PerOwner
 }
+
+type devMode struct {
+	d toml.DevMode
+}
+
+func (d *devMode) Enabled() bool {
+	return *d.d.Enabled
+}
+
+func (d *devMode) Directory() string {
+	return *d.d.Directory
+}
+
+func (d *devMode) PollInterval() time.Duration {
+	return d.d.PollInterval.Duration()
+}
+
+func (d *devMode) TriggerInterval() time.Duration {
+	return d.d.TriggerInterval.Duration()
+}
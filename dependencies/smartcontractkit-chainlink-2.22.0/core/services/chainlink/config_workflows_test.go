@@ -2,6 +2,7 @@ package chainlink
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,4 +18,8 @@ func TestWorkflowsConfig(t *testing.T) {
 	w := cfg.Workflows()
 	assert.Equal(t, int32(200), w.Limits().Global())
 	assert.Equal(t, int32(200), w.Limits().PerOwner())
+	assert.True(t, w.DevMode().Enabled())
+	assert.Equal(t, "/tmp/workflows", w.DevMode().Directory())
+	assert.Equal(t, 2*time.Second, w.DevMode().PollInterval())
+	assert.Equal(t, time.Minute, w.DevMode().TriggerInterval())
 }
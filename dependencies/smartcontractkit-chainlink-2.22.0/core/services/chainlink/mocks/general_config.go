@@ -1382,6 +1382,61 @@ func (_c *GeneralConfig_Pyroscope_Call) RunAndReturn(run func() config.Pyroscope
 	return _c
 }
 
+// Reload provides a mock function with no fields
+func (_m *GeneralConfig) Reload() (chainlink.ReloadResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 chainlink.ReloadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (chainlink.ReloadResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() chainlink.ReloadResult); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chainlink.ReloadResult)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GeneralConfig_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type GeneralConfig_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+func (_e *GeneralConfig_Expecter) Reload() *GeneralConfig_Reload_Call {
+	return &GeneralConfig_Reload_Call{Call: _e.mock.On("Reload")}
+}
+
+func (_c *GeneralConfig_Reload_Call) Run(run func()) *GeneralConfig_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GeneralConfig_Reload_Call) Return(_a0 chainlink.ReloadResult, _a1 error) *GeneralConfig_Reload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GeneralConfig_Reload_Call) RunAndReturn(run func() (chainlink.ReloadResult, error)) *GeneralConfig_Reload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with no fields
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
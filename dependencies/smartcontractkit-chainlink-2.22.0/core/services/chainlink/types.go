@@ -18,6 +18,8 @@ type GeneralConfig interface {
 	TronConfigs() RawConfigs
 	// ConfigTOML returns both the user provided and effective configuration as TOML.
 	ConfigTOML() (user, effective string)
+	// Reload re-reads the config files and applies changes to the reloadable fields.
+	Reload() (ReloadResult, error)
 	ImportedSecretConfig
 }
 
@@ -174,11 +174,14 @@ func (h *functionsConnectorHandler) handleSecretsList(ctx context.Context, gatew
 		response.Rows = make([]functions.SecretsListRow, len(snapshot))
 		for i, row := range snapshot {
 			response.Rows[i] = functions.SecretsListRow{
-				SlotID:     row.SlotId,
-				Version:    row.Version,
-				Expiration: row.Expiration,
+				SlotID:      row.SlotId,
+				Version:     row.Version,
+				Expiration:  row.Expiration,
+				PayloadSize: row.PayloadSize,
 			}
+			response.UsedBytes += row.PayloadSize
 		}
+		response.QuotaBytes = h.storage.Constraints().MaxBytesPerUser
 	} else {
 		response.ErrorMessage = fmt.Sprintf("Failed to list secrets: %v", err)
 	}
@@ -121,15 +121,16 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 
 			ctx := testutils.Context(t)
 			snapshot := []*s4.SnapshotRow{
-				{SlotId: 1, Version: 1, Expiration: 1},
-				{SlotId: 2, Version: 2, Expiration: 2},
+				{SlotId: 1, Version: 1, Expiration: 1, PayloadSize: 10},
+				{SlotId: 2, Version: 2, Expiration: 2, PayloadSize: 20},
 			}
 			storage.On("List", ctx, addr).Return(snapshot, nil).Once()
+			storage.On("Constraints").Return(s4.Constraints{MaxBytesPerUser: 100}).Once()
 			allowlist.On("Allow", addr).Return(true).Once()
 			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
 				msg, ok := args[2].(*api.Message)
 				require.True(t, ok)
-				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1},{"slot_id":2,"version":2,"expiration":2}]}`, string(msg.Body.Payload))
+				require.Equal(t, `{"success":true,"rows":[{"slot_id":1,"version":1,"expiration":1,"payload_size":10},{"slot_id":2,"version":2,"expiration":2,"payload_size":20}],"used_bytes":30,"quota_bytes":100}`, string(msg.Body.Payload))
 			}).Return(nil).Once()
 
 			handler.HandleGatewayMessage(ctx, "gw1", &msg)
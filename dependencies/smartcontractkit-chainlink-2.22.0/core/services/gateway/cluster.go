@@ -0,0 +1,391 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+const (
+	ClusterPathConnections = "/cluster/connections"
+	ClusterPathSend        = "/cluster/send"
+	ClusterPathDeliver     = "/cluster/deliver"
+	ClusterSecretHeader    = "X-Gateway-Cluster-Secret"
+
+	clusterMaxRequestBytes = 1 << 20
+)
+
+var ErrNodeNotConnected = errors.New("node is not connected to any gateway in the cluster")
+
+// clusterMessage is a node message exchanged between peers, either to be sent
+// to a node connected to the receiving peer, or delivered to its handler.
+type clusterMessage struct {
+	From        string       `json:"from"`
+	DonId       string       `json:"don_id"`
+	NodeAddress string       `json:"node_address"`
+	Message     *api.Message `json:"message"`
+}
+
+// clusterConnections maps DON IDs to the addresses of the nodes connected to a peer.
+type clusterConnections map[string][]string
+
+type forwardKey struct {
+	donId       string
+	nodeAddress string
+	messageId   string
+}
+
+type forwardOrigin struct {
+	peerId    string
+	expiresAt time.Time
+}
+
+// cluster lets gateways serve nodes connected to their peers. Each gateway
+// periodically asks its peers which nodes are connected to them, and
+//   - forwards messages for nodes it is not connected to, to the peer which is,
+//   - sends responses from its nodes to forwarded messages back to the peer
+//     which forwarded them, where they are passed to the handler.
+type cluster struct {
+	services.Service
+	eng *services.Engine
+
+	cfg      *config.ClusterConfig
+	connMgr  *connectionManager
+	clock    clockwork.Clock
+	client   *http.Client
+	server   *http.Server
+	listener net.Listener
+
+	mu        sync.RWMutex
+	peerNodes map[string]map[string]map[string]struct{} // peer ID -> DON ID -> node addresses
+	origins   map[forwardKey]forwardOrigin
+}
+
+func newCluster(cfg *config.ClusterConfig, connMgr *connectionManager, clock clockwork.Clock, lggr logger.Logger) (*cluster, error) {
+	if cfg.Id == "" {
+		return nil, errors.New("cluster Id is required")
+	}
+	if cfg.SharedSecret == "" {
+		return nil, errors.New("cluster SharedSecret is required")
+	}
+	if cfg.SyncIntervalMillis == 0 || cfg.ForwardTTLMillis == 0 || cfg.RequestTimeoutMillis == 0 {
+		return nil, errors.New("cluster SyncIntervalMillis, ForwardTTLMillis and RequestTimeoutMillis must be positive")
+	}
+	peerIds := make(map[string]struct{})
+	for _, peer := range cfg.Peers {
+		if peer.Id == "" || peer.Id == cfg.Id || peer.URL == "" {
+			return nil, fmt.Errorf("invalid cluster peer %q", peer.Id)
+		}
+		if _, ok := peerIds[peer.Id]; ok {
+			return nil, fmt.Errorf("duplicate cluster peer %s", peer.Id)
+		}
+		peerIds[peer.Id] = struct{}{}
+		peerURL, err := url.Parse(peer.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL of cluster peer %s: %w", peer.Id, err)
+		}
+		if peerURL.Scheme != "https" && (peerURL.Scheme != "http" || !cfg.AllowInsecure) {
+			return nil, fmt.Errorf("URL of cluster peer %s must use https, unless AllowInsecure is set", peer.Id)
+		}
+	}
+	var serverTLS, clientTLS *tls.Config
+	if !cfg.AllowInsecure {
+		var err error
+		if serverTLS, clientTLS, err = clusterTLSConfigs(cfg); err != nil {
+			return nil, err
+		}
+	}
+	c := &cluster{
+		cfg:     cfg,
+		connMgr: connMgr,
+		clock:   clock,
+		client: &http.Client{
+			Timeout:   time.Duration(cfg.RequestTimeoutMillis) * time.Millisecond,
+			Transport: &http.Transport{TLSClientConfig: clientTLS},
+		},
+		peerNodes: make(map[string]map[string]map[string]struct{}),
+		origins:   make(map[forwardKey]forwardOrigin),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ClusterPathConnections, c.authorized(c.handleConnections))
+	mux.HandleFunc(ClusterPathSend, c.authorized(c.handleSend))
+	mux.HandleFunc(ClusterPathDeliver, c.authorized(c.handleDeliver))
+	c.server = &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: time.Duration(cfg.RequestTimeoutMillis) * time.Millisecond,
+		TLSConfig:         serverTLS,
+	}
+	c.Service, c.eng = services.Config{
+		Name:  "GatewayCluster",
+		Start: c.start,
+		Close: c.close,
+	}.NewServiceEngine(lggr)
+	return c, nil
+}
+
+func (c *cluster) start(_ context.Context) (err error) {
+	c.listener, err = net.Listen("tcp", c.server.Addr)
+	if err != nil {
+		return err
+	}
+	c.eng.Go(func(context.Context) {
+		var err error
+		if c.server.TLSConfig != nil {
+			// certificates are already loaded into the TLS config
+			err = c.server.ServeTLS(c.listener, "", "")
+		} else {
+			err = c.server.Serve(c.listener)
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			c.eng.Errorw("cluster server closed with error", "err", err)
+		}
+	})
+	interval := time.Duration(c.cfg.SyncIntervalMillis) * time.Millisecond
+	c.eng.GoTick(timeutil.NewTicker(func() time.Duration { return interval }), c.sync)
+	return nil
+}
+
+// clusterTLSConfigs returns the mutual TLS configs of the cluster server and
+// of its client to peers, both of which present the gateway certificate and
+// only accept peer certificates issued by the cluster CAs.
+func clusterTLSConfigs(cfg *config.ClusterConfig) (server *tls.Config, client *tls.Config, err error) {
+	if cfg.TLSCertPath == "" || cfg.TLSKeyPath == "" || cfg.TLSCAPath == "" {
+		return nil, nil, errors.New("cluster TLSCertPath, TLSKeyPath and TLSCAPath are required, unless AllowInsecure is set")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load cluster certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(cfg.TLSCAPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read cluster CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, nil, fmt.Errorf("no certificates found in cluster CA file %s", cfg.TLSCAPath)
+	}
+	server = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	client = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	return server, client, nil
+}
+
+func (c *cluster) close() error {
+	return c.server.Shutdown(context.Background())
+}
+
+func (c *cluster) getPort() int {
+	return c.listener.Addr().(*net.TCPAddr).Port
+}
+
+// sync refreshes the nodes connected to each peer and drops expired forward origins.
+func (c *cluster) sync(ctx context.Context) {
+	for _, peer := range c.cfg.Peers {
+		var conns clusterConnections
+		err := c.call(ctx, peer.URL+ClusterPathConnections, http.MethodGet, nil, &conns)
+		if err != nil {
+			c.eng.Debugw("failed to sync with cluster peer", "peerId", peer.Id, "err", err)
+			// forget its nodes until it is reachable again
+			conns = nil
+		}
+		nodes := make(map[string]map[string]struct{})
+		for donId, addresses := range conns {
+			nodes[donId] = make(map[string]struct{})
+			for _, address := range addresses {
+				nodes[donId][address] = struct{}{}
+			}
+		}
+		c.mu.Lock()
+		c.peerNodes[peer.Id] = nodes
+		c.mu.Unlock()
+	}
+
+	now := c.clock.Now()
+	c.mu.Lock()
+	for key, origin := range c.origins {
+		if now.After(origin.expiresAt) {
+			delete(c.origins, key)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// forward sends msg to the peer which nodeAddress is connected to.
+func (c *cluster) forward(ctx context.Context, donId string, nodeAddress string, msg *api.Message) error {
+	peerURL, ok := c.peerFor(donId, nodeAddress)
+	if !ok {
+		return ErrNodeNotConnected
+	}
+	return c.call(ctx, peerURL+ClusterPathSend, http.MethodPost, &clusterMessage{From: c.cfg.Id, DonId: donId, NodeAddress: nodeAddress, Message: msg}, nil)
+}
+
+// deliverToOrigin sends a message from a node to the peer which forwarded the
+// message it responds to. It returns false if there is no such peer.
+func (c *cluster) deliverToOrigin(ctx context.Context, donId string, nodeAddress string, msg *api.Message) (bool, error) {
+	key := forwardKey{donId: donId, nodeAddress: nodeAddress, messageId: msg.Body.MessageId}
+	c.mu.RLock()
+	origin, ok := c.origins[key]
+	c.mu.RUnlock()
+	if !ok || c.clock.Now().After(origin.expiresAt) {
+		return false, nil
+	}
+	for _, peer := range c.cfg.Peers {
+		if peer.Id == origin.peerId {
+			return true, c.call(ctx, peer.URL+ClusterPathDeliver, http.MethodPost, &clusterMessage{From: c.cfg.Id, DonId: donId, NodeAddress: nodeAddress, Message: msg}, nil)
+		}
+	}
+	return false, nil
+}
+
+func (c *cluster) peerFor(donId string, nodeAddress string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, peer := range c.cfg.Peers {
+		if _, ok := c.peerNodes[peer.Id][donId][nodeAddress]; ok {
+			return peer.URL, true
+		}
+	}
+	return "", false
+}
+
+func (c *cluster) call(ctx context.Context, url string, method string, request any, response any) error {
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(ClusterSecretHeader, c.cfg.SharedSecret)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("cluster peer returned %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, clusterMaxRequestBytes)).Decode(response)
+}
+
+func (c *cluster) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(ClusterSecretHeader)), []byte(c.cfg.SharedSecret)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func (c *cluster) handleConnections(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(c.connMgr.localConnections()); err != nil {
+		c.eng.Debugw("failed to write cluster connections", "err", err)
+	}
+}
+
+// handleSend sends a message forwarded by a peer to a local node, and records
+// the peer so that the node's responses are delivered back to it.
+func (c *cluster) handleSend(w http.ResponseWriter, r *http.Request) {
+	cm, donConnMgr, ok := c.readMessage(w, r)
+	if !ok {
+		return
+	}
+	key := forwardKey{donId: cm.DonId, nodeAddress: cm.NodeAddress, messageId: cm.Message.Body.MessageId}
+	c.mu.Lock()
+	c.origins[key] = forwardOrigin{peerId: cm.From, expiresAt: c.clock.Now().Add(time.Duration(c.cfg.ForwardTTLMillis) * time.Millisecond)}
+	c.mu.Unlock()
+	if err := donConnMgr.sendToLocalNode(r.Context(), cm.NodeAddress, cm.Message); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	}
+}
+
+// handleDeliver passes a node message received by a peer to the local handler.
+func (c *cluster) handleDeliver(w http.ResponseWriter, r *http.Request) {
+	cm, donConnMgr, ok := c.readMessage(w, r)
+	if !ok {
+		return
+	}
+	// nodes sign their messages, so peers cannot forge them
+	if cm.Message.Body.Sender != cm.NodeAddress {
+		http.Error(w, "message sender mismatch", http.StatusBadRequest)
+		return
+	}
+	if err := donConnMgr.handler.HandleNodeMessage(r.Context(), cm.Message, cm.NodeAddress); err != nil {
+		c.eng.Errorw("error when calling HandleNodeMessage for a message delivered by a cluster peer", "peerId", cm.From, "err", err)
+	}
+}
+
+func (c *cluster) readMessage(w http.ResponseWriter, r *http.Request) (*clusterMessage, *donConnectionManager, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil, nil, false
+	}
+	var cm clusterMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, clusterMaxRequestBytes)).Decode(&cm); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+	if cm.Message == nil {
+		http.Error(w, "nil message", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	if err := cm.Message.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+	donConnMgr, ok := c.connMgr.dons[cm.DonId]
+	if !ok {
+		http.Error(w, "unsupported DON ID", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	if _, ok = donConnMgr.nodes[cm.NodeAddress]; !ok {
+		http.Error(w, "node not found", http.StatusBadRequest)
+		return nil, nil, false
+	}
+	return &cm, donConnMgr, true
+}
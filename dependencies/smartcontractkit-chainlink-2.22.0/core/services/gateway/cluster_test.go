@@ -0,0 +1,150 @@
+package gateway_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+)
+
+const clusterTestConfig = `
+[cluster]
+Id = "gateway_1"
+Host = "localhost"
+Port = %d
+SharedSecret = "secret"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 100
+RequestTimeoutMillis = 1000
+`
+
+func TestCluster_PeerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	port := freeport.GetOne(t)
+	cfg := parseTOMLConfig(t, defaultConfig+fmt.Sprintf(clusterTestConfig, port)+`
+AllowInsecure = true
+`)
+	mgr, err := gateway.NewConnectionManager(cfg, clockwork.NewFakeClock(), logger.TestLogger(t))
+	require.NoError(t, err)
+	servicetest.Run(t, mgr)
+
+	get := func(secret string) *http.Response {
+		req, err2 := http.NewRequestWithContext(testutils.Context(t), http.MethodGet, fmt.Sprintf("http://localhost:%d%s", port, gateway.ClusterPathConnections), nil)
+		require.NoError(t, err2)
+		req.Header.Set(gateway.ClusterSecretHeader, secret)
+		resp, err2 := http.DefaultClient.Do(req)
+		require.NoError(t, err2)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	require.Equal(t, http.StatusUnauthorized, get("wrong").StatusCode)
+
+	resp := get("secret")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var conns map[string][]string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&conns))
+	// no nodes are connected yet
+	require.Equal(t, map[string][]string{"my_don_1": {}, "my_don_2": {}}, conns)
+}
+
+func TestCluster_PeerEndpointsRequireMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cert := writeTestClusterCertificate(t, dir)
+	port := freeport.GetOne(t)
+	cfg := parseTOMLConfig(t, defaultConfig+fmt.Sprintf(clusterTestConfig, port)+fmt.Sprintf(`
+TLSCertPath = %q
+TLSKeyPath = %q
+TLSCAPath = %q
+`, filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), filepath.Join(dir, "cert.pem")))
+	mgr, err := gateway.NewConnectionManager(cfg, clockwork.NewFakeClock(), logger.TestLogger(t))
+	require.NoError(t, err)
+	servicetest.Run(t, mgr)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert.Leaf)
+	get := func(scheme string, tlsConfig *tls.Config) (*http.Response, error) {
+		req, err2 := http.NewRequestWithContext(testutils.Context(t), http.MethodGet, fmt.Sprintf("%s://localhost:%d%s", scheme, port, gateway.ClusterPathConnections), nil)
+		require.NoError(t, err2)
+		req.Header.Set(gateway.ClusterSecretHeader, "secret")
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		resp, err2 := client.Do(req)
+		if err2 == nil {
+			t.Cleanup(func() { resp.Body.Close() })
+		}
+		return resp, err2
+	}
+
+	// plaintext requests are not served
+	resp, err := get("http", nil)
+	if err == nil {
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+
+	// peers must present a certificate
+	_, err = get("https", &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+	require.Error(t, err)
+
+	resp, err = get("https", &tls.Config{RootCAs: pool, Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// writeTestClusterCertificate writes a self-signed certificate for localhost
+// to cert.pem and its key to key.pem in dir. It is both the CA and the
+// certificate of every peer.
+func writeTestClusterCertificate(t *testing.T, dir string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), keyPEM, 0600))
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	cert.Leaf, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
@@ -12,7 +12,54 @@ type GatewayConfig struct {
 	ConnectionManagerConfig ConnectionManagerConfig
 	// HTTPClientConfig is configuration for outbound HTTP calls to external endpoints
 	HTTPClientConfig gw_net.HTTPClientConfig
-	Dons             []DONConfig
+	// RequestJournal is optional. Without it, retried requests are executed
+	// again and requests in flight during a restart get no response.
+	RequestJournal *RequestJournalConfig
+	// Cluster is optional, and lets several gateways serve the same DONs
+	// behind a load balancer.
+	Cluster *ClusterConfig
+	Dons    []DONConfig
+}
+
+// ClusterConfig configures a cluster of gateways which forward node traffic
+// to the gateway each node is connected to.
+type ClusterConfig struct {
+	// Id of this gateway among its peers.
+	Id   string
+	Host string
+	Port uint16
+	// SharedSecret authenticates requests between peers. It must be the same
+	// on all of them.
+	SharedSecret string
+	Peers        []ClusterPeerConfig
+	// TLSCertPath and TLSKeyPath are the certificate this gateway serves to
+	// its peers and presents to them as a client. Peer certificates, on both
+	// sides, must be issued by a CA in TLSCAPath. They are all required,
+	// unless AllowInsecure is set.
+	TLSCertPath string
+	TLSKeyPath  string
+	TLSCAPath   string
+	// AllowInsecure allows plaintext connections between peers, and http://
+	// peer URLs. It exposes the shared secret and node traffic to the network,
+	// so it must only be used for local testing.
+	AllowInsecure bool
+	// SyncIntervalMillis is how often peers are asked which nodes are connected to them.
+	SyncIntervalMillis uint32
+	// ForwardTTLMillis is how long responses from nodes to a forwarded
+	// message are sent back to the gateway which forwarded it.
+	ForwardTTLMillis     uint32
+	RequestTimeoutMillis uint32
+}
+
+type ClusterPeerConfig struct {
+	Id  string
+	URL string
+}
+
+type RequestJournalConfig struct {
+	// RetentionSec is how long completed requests can be retried.
+	RetentionSec     uint32
+	PruneIntervalSec uint32
 }
 
 type ConnectionManagerConfig struct {
@@ -28,6 +75,51 @@ type DONConfig struct {
 	HandlerConfig json.RawMessage
 	Members       []NodeConfig
 	F             int
+	// UserAuth is optional. Without it, users are identified only by the
+	// signature of their messages.
+	UserAuth *UserAuthConfig
+}
+
+const (
+	AuthenticatorAPIKey    = "apiKey"
+	AuthenticatorMTLS      = "mTLS"
+	AuthenticatorSignature = "signature"
+)
+
+// UserAuthConfig configures the chain of authenticators applied to user
+// requests sent to a DON, before they reach its handler.
+type UserAuthConfig struct {
+	// Authenticators, in the order they are tried. The first one which finds
+	// its credentials in a request decides whether it is accepted. Requests
+	// without credentials for any of them are rejected.
+	Authenticators []string
+	APIKeys        []APIKeyConfig
+	ClientCerts    []ClientCertConfig
+	// UserRateLimiter is optional and limits requests per authenticated user.
+	UserRateLimiter *UserRateLimiterConfig
+}
+
+// UserRateLimiterConfig limits the requests of all users, and of each
+// authenticated user, to a DON.
+type UserRateLimiterConfig struct {
+	GlobalRPS      float64
+	GlobalBurst    int
+	PerSenderRPS   float64
+	PerSenderBurst int
+}
+
+type APIKeyConfig struct {
+	User string
+	// KeySHA256 is the hex-encoded SHA-256 hash of the key, so that keys
+	// themselves are not stored in the job spec.
+	KeySHA256 string
+}
+
+type ClientCertConfig struct {
+	User string
+	// CommonName of a client certificate, which must be signed by one of the
+	// CAs in UserServerConfig.TLSClientCAPath.
+	CommonName string
 }
 
 type NodeConfig struct {
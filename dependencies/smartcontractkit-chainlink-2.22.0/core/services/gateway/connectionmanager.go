@@ -51,6 +51,7 @@ type connectionManager struct {
 	connAttempts       map[string]*connAttempt
 	connAttemptCounter uint64
 	connAttemptsMu     sync.Mutex
+	cluster            *cluster
 	lggr               logger.Logger
 }
 
@@ -61,6 +62,9 @@ func (m *connectionManager) HealthReport() map[string]error {
 			services.CopyHealth(hr, n.conn.HealthReport())
 		}
 	}
+	if m.cluster != nil {
+		services.CopyHealth(hr, m.cluster.HealthReport())
+	}
 	return hr
 }
 
@@ -71,6 +75,7 @@ type donConnectionManager struct {
 	nodes      map[string]*nodeState
 	handler    handlers.Handler
 	codec      api.Codec
+	cluster    *cluster
 	closeWait  sync.WaitGroup
 	shutdownCh services.StopChan
 	lggr       logger.Logger
@@ -132,6 +137,16 @@ func NewConnectionManager(gwConfig *config.GatewayConfig, clock clockwork.Clock,
 		clock:        clock,
 		lggr:         lggr.Named("ConnectionManager"),
 	}
+	if gwConfig.Cluster != nil {
+		cluster, err := newCluster(gwConfig.Cluster, connMgr, clock, lggr)
+		if err != nil {
+			return nil, err
+		}
+		connMgr.cluster = cluster
+		for _, donConnMgr := range dons {
+			donConnMgr.cluster = cluster
+		}
+	}
 	wsServer := network.NewWebSocketServer(&gwConfig.NodeServerConfig, connMgr, lggr)
 	connMgr.wsServer = wsServer
 	return connMgr, nil
@@ -155,6 +170,11 @@ func (m *connectionManager) Start(ctx context.Context) error {
 			donConnMgr.closeWait.Add(1)
 			go donConnMgr.keepaliveLoop(m.config.HeartbeatIntervalSec)
 		}
+		if m.cluster != nil {
+			if err := m.cluster.Start(ctx); err != nil {
+				return err
+			}
+		}
 		return m.wsServer.Start(ctx)
 	})
 }
@@ -163,6 +183,9 @@ func (m *connectionManager) Close() error {
 	return m.StopOnce("ConnectionManager", func() (err error) {
 		m.lggr.Info("closing connection manager")
 		err = multierr.Combine(err, m.wsServer.Close())
+		if m.cluster != nil {
+			err = multierr.Combine(err, m.cluster.Close())
+		}
 		for _, donConnMgr := range m.dons {
 			close(donConnMgr.shutdownCh)
 			for _, nodeState := range donConnMgr.nodes {
@@ -256,11 +279,39 @@ func (m *connectionManager) GetPort() int {
 	return m.wsServer.GetPort()
 }
 
+// localConnections returns the nodes currently connected to this gateway, by DON ID.
+func (m *connectionManager) localConnections() clusterConnections {
+	conns := make(clusterConnections)
+	for donId, donConnMgr := range m.dons {
+		conns[donId] = []string{}
+		for nodeAddress, nodeState := range donConnMgr.nodes {
+			if nodeState.conn.IsConnected() {
+				conns[donId] = append(conns[donId], nodeAddress)
+			}
+		}
+	}
+	return conns
+}
+
 func (m *donConnectionManager) SetHandler(handler handlers.Handler) {
 	m.handler = handler
 }
 
+// SendToNode sends msg to a node. In cluster mode, messages to nodes which are
+// not connected to this gateway are forwarded to the peer they are connected to.
 func (m *donConnectionManager) SendToNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
+	if m.cluster != nil {
+		if nodeState := m.nodes[nodeAddress]; nodeState != nil && !nodeState.conn.IsConnected() {
+			err := m.cluster.forward(ctx, m.donConfig.DonId, nodeAddress, msg)
+			if !errors.Is(err, ErrNodeNotConnected) {
+				return err
+			}
+		}
+	}
+	return m.sendToLocalNode(ctx, nodeAddress, msg)
+}
+
+func (m *donConnectionManager) sendToLocalNode(ctx context.Context, nodeAddress string, msg *api.Message) error {
 	if msg == nil {
 		return errors.New("nil message")
 	}
@@ -296,6 +347,15 @@ func (m *donConnectionManager) readLoop(nodeAddress string, nodeState *nodeState
 				m.lggr.Errorw("message sender mismatch when reading from node", "nodeAddress", nodeAddress, "sender", msg.Body.Sender)
 				break
 			}
+			if m.cluster != nil {
+				delivered, err2 := m.cluster.deliverToOrigin(ctx, m.donConfig.DonId, nodeAddress, msg)
+				if err2 != nil {
+					m.lggr.Errorw("error when delivering node message to cluster peer", "nodeAddress", nodeAddress, "err", err2)
+				}
+				if delivered {
+					break
+				}
+			}
 			err = m.handler.HandleNodeMessage(ctx, msg, nodeAddress)
 			if err != nil {
 				m.lggr.Error("error when calling HandleNodeMessage ", err)
@@ -76,6 +76,49 @@ Address = "0x68902d681c28119f9b2531473a417088bf008e59"
 [[dons.members]]
 Name = "node_2"
 Address = "0x68902D681c28119f9b2531473a417088bf008E59"
+`,
+		"cluster without shared secret": `
+[cluster]
+Id = "gateway_1"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 100
+RequestTimeoutMillis = 100
+`,
+		"cluster peer with own ID": `
+[cluster]
+Id = "gateway_1"
+SharedSecret = "secret"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 100
+RequestTimeoutMillis = 100
+[[cluster.peers]]
+Id = "gateway_1"
+URL = "http://localhost:1234"
+`,
+		"cluster with plaintext peer": `
+[cluster]
+Id = "gateway_1"
+SharedSecret = "secret"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 100
+RequestTimeoutMillis = 100
+TLSCertPath = "cert.pem"
+TLSKeyPath = "key.pem"
+TLSCAPath = "ca.pem"
+[[cluster.peers]]
+Id = "gateway_2"
+URL = "http://localhost:1234"
+`,
+		"cluster without TLS": `
+[cluster]
+Id = "gateway_1"
+SharedSecret = "secret"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 100
+RequestTimeoutMillis = 100
+[[cluster.peers]]
+Id = "gateway_2"
+URL = "https://localhost:1234"
 `,
 	}
 
@@ -60,7 +60,14 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		return nil, err
 	}
 	handlerFactory := NewHandlerFactory(d.legacyChains, d.ds, httpClient, d.lggr)
-	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, d.lggr)
+	var journal RequestJournal
+	if gatewayConfig.RequestJournal != nil {
+		journal, err = NewRequestJournal(d.ds, *gatewayConfig.RequestJournal, d.lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gateway, err := NewGatewayFromConfig(&gatewayConfig, handlerFactory, journal, d.lggr)
 	if err != nil {
 		return nil, err
 	}
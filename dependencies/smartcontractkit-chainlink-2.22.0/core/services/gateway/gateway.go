@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 
@@ -28,6 +29,11 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Metric to track received requests and response codes",
 }, []string{"response_code"})
 
+const (
+	journalWriteTimeout = 5 * time.Second
+	journalReplayLabel  = "JournalReplay"
+)
+
 type Gateway interface {
 	job.ServiceCtx
 	gw_net.HTTPRequestHandler
@@ -48,11 +54,14 @@ type gateway struct {
 	codec      api.Codec
 	httpServer gw_net.HttpServer
 	handlers   map[string]handlers.Handler
+	userAuth   map[string]*UserAuthChain
+	journal    RequestJournal
 	connMgr    ConnectionManager
 	lggr       logger.Logger
 }
 
-func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, lggr logger.Logger) (Gateway, error) {
+// NewGatewayFromConfig creates a Gateway. journal is optional.
+func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFactory, journal RequestJournal, lggr logger.Logger) (Gateway, error) {
 	codec := &api.JsonRPCCodec{}
 	httpServer := gw_net.NewHttpServer(&config.UserServerConfig, lggr)
 	connMgr, err := NewConnectionManager(config, clockwork.NewRealClock(), lggr)
@@ -61,6 +70,7 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 	}
 
 	handlerMap := make(map[string]handlers.Handler)
+	userAuth := make(map[string]*UserAuthChain)
 	for _, donConfig := range config.Dons {
 		donConfig := donConfig
 		_, ok := handlerMap[donConfig.DonId]
@@ -83,15 +93,25 @@ func NewGatewayFromConfig(config *config.GatewayConfig, handlerFactory HandlerFa
 		}
 		handlerMap[donConfig.DonId] = handler
 		donConnMgr.SetHandler(handler)
+		if donConfig.UserAuth != nil {
+			chain, err := NewUserAuthChain(donConfig.UserAuth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid user auth config for DON %s: %w", donConfig.DonId, err)
+			}
+			userAuth[donConfig.DonId] = chain
+		}
 	}
-	return NewGateway(codec, httpServer, handlerMap, connMgr, lggr), nil
+	return NewGateway(codec, httpServer, handlerMap, userAuth, journal, connMgr, lggr), nil
 }
 
-func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, connMgr ConnectionManager, lggr logger.Logger) Gateway {
+// NewGateway creates a Gateway. userAuth is keyed by DON ID; it and journal may be nil.
+func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[string]handlers.Handler, userAuth map[string]*UserAuthChain, journal RequestJournal, connMgr ConnectionManager, lggr logger.Logger) Gateway {
 	gw := &gateway{
 		codec:      codec,
 		httpServer: httpServer,
 		handlers:   handlers,
+		userAuth:   userAuth,
+		journal:    journal,
 		connMgr:    connMgr,
 		lggr:       lggr.Named("Gateway"),
 	}
@@ -102,6 +122,12 @@ func NewGateway(codec api.Codec, httpServer gw_net.HttpServer, handlers map[stri
 func (g *gateway) Start(ctx context.Context) error {
 	return g.StartOnce("Gateway", func() error {
 		g.lggr.Info("starting gateway")
+		// before accepting requests, so earlier in-progress ones are interrupted first
+		if g.journal != nil {
+			if err := g.journal.Start(ctx); err != nil {
+				return err
+			}
+		}
 		for _, handler := range g.handlers {
 			if err := handler.Start(ctx); err != nil {
 				return err
@@ -122,6 +148,9 @@ func (g *gateway) Close() error {
 		for _, handler := range g.handlers {
 			err = multierr.Combine(err, handler.Close())
 		}
+		if g.journal != nil {
+			err = multierr.Combine(err, g.journal.Close())
+		}
 		return
 	})
 }
@@ -144,30 +173,84 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 	if !ok {
 		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
 	}
+	if chain, ok := g.userAuth[msg.Body.DonId]; ok {
+		user, errCode, errMsg := chain.Authenticate(ctx, msg)
+		if errCode != api.NoError {
+			return newError(g.codec, msg.Body.MessageId, errCode, errMsg)
+		}
+		g.lggr.Debugw("authenticated user request", "user", user, "donId", msg.Body.DonId, "messageId", msg.Body.MessageId)
+	}
+	if g.journal == nil {
+		rawResponse, httpStatusCode, _ = g.dispatch(ctx, msg, handler)
+		return rawResponse, httpStatusCode
+	}
+	existing, err := g.journal.Begin(ctx, msg.Body.DonId, msg.Body.Sender, msg.Body.MessageId)
+	if err != nil {
+		g.lggr.Errorw("failed to journal request", "messageId", msg.Body.MessageId, "err", err)
+		return newError(g.codec, msg.Body.MessageId, api.FatalError, "failed to journal request")
+	}
+	if existing != nil {
+		return g.replay(msg, existing)
+	}
+	rawResponse, httpStatusCode, dispatched := g.dispatch(ctx, msg, handler)
+	// the request context may have expired
+	journalCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), journalWriteTimeout)
+	defer cancel()
+	if dispatched {
+		err = g.journal.Complete(journalCtx, msg.Body.DonId, msg.Body.Sender, msg.Body.MessageId, rawResponse, httpStatusCode)
+	} else {
+		err = g.journal.Forget(journalCtx, msg.Body.DonId, msg.Body.Sender, msg.Body.MessageId)
+	}
+	if err != nil {
+		g.lggr.Errorw("failed to journal response", "messageId", msg.Body.MessageId, "err", err)
+	}
+	return rawResponse, httpStatusCode
+}
+
+// dispatch sends a message to its handler and awaits the response. dispatched
+// is false if the handler rejected the message, so it was never executed.
+func (g *gateway) dispatch(ctx context.Context, msg *api.Message, handler handlers.Handler) (rawResponse []byte, httpStatusCode int, dispatched bool) {
 	// send to the handler
 	responseCh := make(chan handlers.UserCallbackPayload, 1)
-	err = handler.HandleUserMessage(ctx, msg, responseCh)
+	err := handler.HandleUserMessage(ctx, msg, responseCh)
 	if err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
+		return rawResponse, httpStatusCode, false
 	}
 	// await response
 	var response handlers.UserCallbackPayload
 	select {
 	case <-ctx.Done():
-		return newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
+		return rawResponse, httpStatusCode, true
 	case response = <-responseCh:
 		break
 	}
 	if response.ErrCode != api.NoError {
-		return newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
+		return rawResponse, httpStatusCode, true
 	}
 	// encode
 	rawResponse, err = g.codec.EncodeResponse(response.Msg)
 	if err != nil {
-		return newError(g.codec, msg.Body.MessageId, api.NodeReponseEncodingError, "")
+		rawResponse, httpStatusCode = newError(g.codec, msg.Body.MessageId, api.NodeReponseEncodingError, "")
+		return rawResponse, httpStatusCode, true
 	}
 	promRequest.WithLabelValues(api.NoError.String()).Inc()
-	return rawResponse, api.ToHttpErrorCode(api.NoError)
+	return rawResponse, api.ToHttpErrorCode(api.NoError), true
+}
+
+// replay answers a retried request from the journal.
+func (g *gateway) replay(msg *api.Message, entry *JournalEntry) ([]byte, int) {
+	switch entry.Status {
+	case JournalCompleted:
+		promRequest.WithLabelValues(journalReplayLabel).Inc()
+		return entry.Response, int(entry.HTTPStatusCode.Int32)
+	case JournalInterrupted:
+		return newError(g.codec, msg.Body.MessageId, api.HandlerError, "request was interrupted by a gateway restart and may have been executed, retry with a new message ID")
+	default:
+		return newError(g.codec, msg.Body.MessageId, api.HandlerError, "request is already in progress")
+	}
 }
 
 func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string) ([]byte, int) {
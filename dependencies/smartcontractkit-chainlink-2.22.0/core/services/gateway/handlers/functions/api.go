@@ -30,12 +30,17 @@ type SecretsSetResponse struct {
 type SecretsListResponse struct {
 	ResponseBase
 	Rows []SecretsListRow `json:"rows,omitempty"`
+	// UsedBytes is the total payload size stored by the user.
+	UsedBytes uint64 `json:"used_bytes,omitempty"`
+	// QuotaBytes is the maximum total payload size, zero if unlimited.
+	QuotaBytes uint64 `json:"quota_bytes,omitempty"`
 }
 
 type SecretsListRow struct {
-	SlotID     uint   `json:"slot_id"`
-	Version    uint64 `json:"version"`
-	Expiration int64  `json:"expiration"`
+	SlotID      uint   `json:"slot_id"`
+	Version     uint64 `json:"version"`
+	Expiration  int64  `json:"expiration"`
+	PayloadSize uint64 `json:"payload_size"`
 }
 
 // Gateway -> User response, which combines responses from several nodes
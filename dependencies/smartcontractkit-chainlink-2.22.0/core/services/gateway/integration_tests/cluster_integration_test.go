@@ -0,0 +1,106 @@
+package integration_tests
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/sdk/freeport"
+	"github.com/jonboulle/clockwork"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+const clusterConfigTemplate = `
+[Cluster]
+Id = "%s"
+Host = "localhost"
+Port = %d
+SharedSecret = "cluster_secret"
+SyncIntervalMillis = 100
+ForwardTTLMillis = 5_000
+RequestTimeoutMillis = 1_000
+AllowInsecure = true
+
+[[Cluster.Peers]]
+Id = "%s"
+URL = "http://localhost:%d"
+`
+
+func TestIntegration_Gateway_Cluster_ForwardsToPeer(t *testing.T) {
+	t.Parallel()
+
+	testWallets := common.NewTestNodes(t, 2)
+	nodeKeys := testWallets[0]
+	userKeys := testWallets[1]
+
+	lggr := logger.TestLogger(t)
+	c, err := network.NewHTTPClient(network.HTTPClientConfig{
+		DefaultTimeout:   5 * time.Second,
+		MaxResponseBytes: 1000,
+	}, lggr)
+	require.NoError(t, err)
+
+	// Launch two clustered gateways
+	ports := freeport.GetN(t, 2)
+	newGateway := func(id string, port int, peerId string, peerPort int) gateway.Gateway {
+		cfg := fmt.Sprintf(gatewayConfigTemplate, nodeKeys.Address) + fmt.Sprintf(clusterConfigTemplate, id, port, peerId, peerPort)
+		gw, err2 := gateway.NewGatewayFromConfig(parseGatewayConfig(t, cfg), gateway.NewHandlerFactory(nil, nil, c, lggr), nil, lggr.Named(id))
+		require.NoError(t, err2)
+		servicetest.Run(t, gw)
+		return gw
+	}
+	gatewayA := newGateway("gateway_a", ports[0], "gateway_b", ports[1])
+	gatewayB := newGateway("gateway_b", ports[1], "gateway_a", ports[0])
+	userUrl := fmt.Sprintf("http://localhost:%d/user", gatewayA.GetUserPort())
+	nodeUrl := fmt.Sprintf("ws://localhost:%d/node", gatewayB.GetNodePort())
+
+	// Launch Connector, connected only to gateway B
+	client := &client{privateKey: nodeKeys.PrivateKey}
+	connector, err := connector.NewGatewayConnector(parseConnectorConfig(t, nodeConfigTemplate, nodeKeys.Address, nodeUrl), client, clockwork.NewRealClock(), lggr)
+	require.NoError(t, err)
+	require.NoError(t, connector.AddHandler([]string{"test"}, client))
+	client.connector = connector
+	servicetest.Run(t, connector)
+
+	// Send requests to gateway A until one of them reaches the node via gateway B
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		req := newHttpRequestObject(t, messageId1, userUrl, userKeys.PrivateKey)
+		httpClient := &http.Client{}
+		resp, err := httpClient.Do(req) // fails until gateway A learns about the node
+		if err == nil {
+			resp.Body.Close()
+		}
+		return client.done.Load()
+	}, testutils.WaitTimeout(t), testutils.TestInterval).Should(gomega.Equal(true))
+
+	// The node's response is delivered back to gateway A, and to the user
+	req := newHttpRequestObject(t, messageId2, userUrl, userKeys.PrivateKey)
+	httpClient := &http.Client{}
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	rawResp, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	codec := api.JsonRPCCodec{}
+	respMsg, err := codec.DecodeResponse(rawResp)
+	require.NoError(t, err)
+	require.NoError(t, respMsg.Validate())
+	require.Equal(t, strings.ToLower(nodeKeys.Address), respMsg.Body.Sender)
+	require.Equal(t, messageId2, respMsg.Body.MessageId)
+	require.Equal(t, nodeResponsePayload, string(respMsg.Body.Payload))
+}
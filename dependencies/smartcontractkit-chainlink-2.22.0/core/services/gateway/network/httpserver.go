@@ -2,10 +2,13 @@ package network
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -28,11 +31,15 @@ type HTTPRequestHandler interface {
 }
 
 type HTTPServerConfig struct {
-	Host                 string
-	Port                 uint16
-	TLSEnabled           bool
-	TLSCertPath          string
-	TLSKeyPath           string
+	Host        string
+	Port        uint16
+	TLSEnabled  bool
+	TLSCertPath string
+	TLSKeyPath  string
+	// TLSClientCAPath enables mTLS: client certificates, when presented, are
+	// verified against the CAs in this file and exposed via RequestInfo.
+	// Not used by the node-facing WebSocket server.
+	TLSClientCAPath      string
 	Path                 string
 	ContentTypeHeader    string
 	ReadTimeoutMillis    uint32
@@ -102,6 +109,7 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(s.config.RequestTimeoutMillis)*time.Millisecond)
 		defer cancel()
 	}
+	requestCtx = WithRequestInfo(requestCtx, RequestInfo{Header: r.Header, TLS: r.TLS, RemoteAddr: r.RemoteAddr})
 	rawResponse, httpStatusCode := s.handler.ProcessRequest(requestCtx, rawMessage)
 
 	w.Header().Set("Content-Type", s.config.ContentTypeHeader)
@@ -138,11 +146,16 @@ func (s *httpServer) Close() error {
 }
 
 func (s *httpServer) runServer() (err error) {
+	tlsEnabled := s.config.TLSEnabled
+	if tlsEnabled && s.config.TLSClientCAPath != "" {
+		if s.server.TLSConfig, err = clientAuthTLSConfig(s.config.TLSClientCAPath); err != nil {
+			return
+		}
+	}
 	s.listener, err = net.Listen("tcp", s.server.Addr)
 	if err != nil {
 		return
 	}
-	tlsEnabled := s.config.TLSEnabled
 
 	go func() {
 		if tlsEnabled {
@@ -160,3 +173,21 @@ func (s *httpServer) runServer() (err error) {
 	}()
 	return
 }
+
+// clientAuthTLSConfig verifies client certificates, if given, against the CAs in caPath.
+// Requests without a certificate are accepted, and left to the gateway to authenticate.
+func clientAuthTLSConfig(caPath string) (*tls.Config, error) {
+	caPEM, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in client CA file %s", caPath)
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
@@ -2,6 +2,7 @@ package network_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -69,6 +70,31 @@ func TestHTTPServer_HandleRequest_Correct(t *testing.T) {
 	require.Equal(t, []byte("response"), respBytes)
 }
 
+func TestHTTPServer_HandleRequest_RequestInfo(t *testing.T) {
+	t.Parallel()
+	server, handler, url := startNewServer(t, 100_000, 100_000)
+	defer server.Close()
+
+	var info network.RequestInfo
+	var ok bool
+	handler.On("ProcessRequest", mock.Anything, mock.Anything).Return([]byte("response"), 200).Run(func(args mock.Arguments) {
+		info, ok = network.RequestInfoFromContext(args.Get(0).(context.Context))
+	})
+
+	req, err := http.NewRequestWithContext(testutils.Context(t), "POST", url, bytes.NewBufferString("0123456789"))
+	require.NoError(t, err)
+	req.Header.Set("X-Api-Key", "secret")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.True(t, ok)
+	require.Equal(t, "secret", info.Header.Get("X-Api-Key"))
+	require.Nil(t, info.TLS)
+	require.NotEmpty(t, info.RemoteAddr)
+}
+
 func TestHTTPServer_HandleRequest_RequestBodyTooBig(t *testing.T) {
 	t.Parallel()
 	server, _, url := startNewServer(t, 5, 100_000)
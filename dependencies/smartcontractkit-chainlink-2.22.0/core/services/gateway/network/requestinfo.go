@@ -0,0 +1,27 @@
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+)
+
+// RequestInfo carries transport-level details of a user request, which are
+// not part of the message itself, to the HTTPRequestHandler.
+type RequestInfo struct {
+	Header     http.Header
+	TLS        *tls.ConnectionState
+	RemoteAddr string
+}
+
+type requestInfoKey struct{}
+
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext returns the RequestInfo attached by the HTTP server, if any.
+func RequestInfoFromContext(ctx context.Context) (RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info, ok
+}
@@ -38,6 +38,9 @@ type WSConnectionWrapper interface {
 	Write(ctx context.Context, msgType int, data []byte) error
 
 	ReadChannel() <-chan ReadItem
+
+	// IsConnected returns true if there is an underlying connection which has not failed.
+	IsConnected() bool
 }
 
 type wsConnectionWrapper struct {
@@ -138,6 +141,10 @@ func (c *wsConnectionWrapper) ReadChannel() <-chan ReadItem {
 	return c.readCh
 }
 
+func (c *wsConnectionWrapper) IsConnected() bool {
+	return c.conn.Load() != nil
+}
+
 func (c *wsConnectionWrapper) Close() error {
 	return c.StopOnce("WSConnectionWrapper", func() error {
 		close(c.shutdownCh)
@@ -169,6 +176,8 @@ func (c *wsConnectionWrapper) readPump(conn *websocket.Conn, closeCh chan<- erro
 	for {
 		msgType, data, err := conn.ReadMessage()
 		if err != nil {
+			// unless it was already replaced
+			c.conn.CompareAndSwap(conn, nil)
 			closeCh <- conn.Close()
 			close(closeCh)
 			return
@@ -45,13 +45,16 @@ func TestWSConnectionWrapper_ClientReconnect(t *testing.T) {
 	servicetest.Run(t, clientConnWrapper)
 
 	// connect, write a message, disconnect
+	require.False(t, clientConnWrapper.IsConnected())
 	conn, _, err := websocket.DefaultDialer.Dial(serverURL, nil)
 	require.NoError(t, err)
 	clientConnWrapper.Reset(conn)
+	require.True(t, clientConnWrapper.IsConnected())
 	writeErr := clientConnWrapper.Write(testutils.Context(t), websocket.TextMessage, []byte("hello"))
 	require.NoError(t, writeErr)
 	<-ssl.connWrapper.ReadChannel() // consumed by server
 	conn.Close()
+	require.Eventually(t, func() bool { return !clientConnWrapper.IsConnected() }, testutils.WaitTimeout(t), testutils.TestInterval)
 
 	// try to write without a connection
 	writeErr = clientConnWrapper.Write(testutils.Context(t), websocket.TextMessage, []byte("failed send"))
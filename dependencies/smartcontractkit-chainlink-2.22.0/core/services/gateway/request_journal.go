@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+)
+
+type JournalStatus string
+
+const (
+	JournalInProgress JournalStatus = "in_progress"
+	JournalCompleted  JournalStatus = "completed"
+	// JournalInterrupted marks requests which were in progress when the gateway
+	// stopped. They may or may not have been executed by the DON.
+	JournalInterrupted JournalStatus = "interrupted"
+)
+
+// JournalEntry is a user request recorded in a RequestJournal, keyed by
+// (DonId, Sender, MessageId).
+type JournalEntry struct {
+	DonId          string        `db:"don_id"`
+	Sender         string        `db:"sender"`
+	MessageId      string        `db:"message_id"`
+	Status         JournalStatus `db:"status"`
+	Response       []byte        `db:"response"`
+	HTTPStatusCode sql.NullInt32 `db:"http_status_code"`
+}
+
+// RequestJournal persists user requests and their responses, so that retried
+// requests are answered without being executed twice, including across
+// gateway restarts. On start, requests left in progress are marked interrupted.
+type RequestJournal interface {
+	services.Service
+
+	// Begin records a new in-progress request. If the request was already
+	// recorded, the existing entry is returned instead.
+	Begin(ctx context.Context, donId, sender, messageId string) (existing *JournalEntry, err error)
+	// Complete records the final response to a request.
+	Complete(ctx context.Context, donId, sender, messageId string, rawResponse []byte, httpStatusCode int) error
+	// Forget deletes a request which was never dispatched, so it can be retried.
+	Forget(ctx context.Context, donId, sender, messageId string) error
+}
+
+type requestJournal struct {
+	services.Service
+	eng *services.Engine
+
+	ds  sqlutil.DataSource
+	cfg config.RequestJournalConfig
+}
+
+var _ RequestJournal = (*requestJournal)(nil)
+
+func NewRequestJournal(ds sqlutil.DataSource, cfg config.RequestJournalConfig, lggr logger.Logger) (RequestJournal, error) {
+	if cfg.RetentionSec == 0 || cfg.PruneIntervalSec == 0 {
+		return nil, errors.New("request journal RetentionSec and PruneIntervalSec must be positive")
+	}
+	j := &requestJournal{ds: ds, cfg: cfg}
+	j.Service, j.eng = services.Config{
+		Name:  "GatewayRequestJournal",
+		Start: j.start,
+	}.NewServiceEngine(lggr)
+	return j, nil
+}
+
+func (j *requestJournal) start(ctx context.Context) error {
+	result, err := j.ds.ExecContext(ctx, `UPDATE gateway_request_journal SET status = $1, updated_at = NOW() WHERE status = $2;`,
+		JournalInterrupted, JournalInProgress)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err == nil && n > 0 {
+		j.eng.Warnw("Marked requests in progress before restart as interrupted", "count", n)
+	}
+	interval := time.Duration(j.cfg.PruneIntervalSec) * time.Second
+	j.eng.GoTick(timeutil.NewTicker(func() time.Duration { return interval }), j.prune)
+	return nil
+}
+
+func (j *requestJournal) prune(ctx context.Context) {
+	retention := time.Duration(j.cfg.RetentionSec) * time.Second
+	result, err := j.ds.ExecContext(ctx, `DELETE FROM gateway_request_journal WHERE status != $1 AND updated_at < $2;`,
+		JournalInProgress, time.Now().Add(-retention))
+	if err != nil {
+		j.eng.Errorw("Failed to prune request journal", "err", err)
+		return
+	}
+	if n, err := result.RowsAffected(); err == nil && n > 0 {
+		j.eng.Debugw("Pruned request journal", "count", n)
+	}
+}
+
+func (j *requestJournal) Begin(ctx context.Context, donId, sender, messageId string) (*JournalEntry, error) {
+	result, err := j.ds.ExecContext(ctx, `INSERT INTO gateway_request_journal (don_id, sender, message_id, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NOW(), NOW()) ON CONFLICT DO NOTHING;`, donId, sender, messageId, JournalInProgress)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 1 {
+		return nil, nil
+	}
+	var entry JournalEntry
+	err = j.ds.GetContext(ctx, &entry, `SELECT don_id, sender, message_id, status, response, http_status_code FROM gateway_request_journal
+WHERE don_id = $1 AND sender = $2 AND message_id = $3;`, donId, sender, messageId)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (j *requestJournal) Complete(ctx context.Context, donId, sender, messageId string, rawResponse []byte, httpStatusCode int) error {
+	_, err := j.ds.ExecContext(ctx, `UPDATE gateway_request_journal SET status = $4, response = $5, http_status_code = $6, updated_at = NOW()
+WHERE don_id = $1 AND sender = $2 AND message_id = $3;`, donId, sender, messageId, JournalCompleted, rawResponse, httpStatusCode)
+	return err
+}
+
+func (j *requestJournal) Forget(ctx context.Context, donId, sender, messageId string) error {
+	_, err := j.ds.ExecContext(ctx, `DELETE FROM gateway_request_journal WHERE don_id = $1 AND sender = $2 AND message_id = $3;`,
+		donId, sender, messageId)
+	return err
+}
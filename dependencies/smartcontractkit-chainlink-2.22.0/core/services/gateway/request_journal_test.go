@@ -0,0 +1,107 @@
+package gateway_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
+)
+
+var testJournalConfig = config.RequestJournalConfig{RetentionSec: 3600, PruneIntervalSec: 60}
+
+func TestRequestJournal_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := gateway.NewRequestJournal(nil, config.RequestJournalConfig{}, logger.TestLogger(t))
+	require.Error(t, err)
+}
+
+func TestRequestJournal_BeginCompleteForget(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	journal, err := gateway.NewRequestJournal(pgtest.NewSqlxDB(t), testJournalConfig, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	existing, err := journal.Begin(ctx, "don", "0xabcd", "1")
+	require.NoError(t, err)
+	require.Nil(t, existing)
+
+	existing, err = journal.Begin(ctx, "don", "0xabcd", "1")
+	require.NoError(t, err)
+	require.Equal(t, gateway.JournalInProgress, existing.Status)
+
+	require.NoError(t, journal.Complete(ctx, "don", "0xabcd", "1", []byte("response"), 200))
+	existing, err = journal.Begin(ctx, "don", "0xabcd", "1")
+	require.NoError(t, err)
+	require.Equal(t, gateway.JournalCompleted, existing.Status)
+	require.Equal(t, []byte("response"), existing.Response)
+	require.Equal(t, int32(200), existing.HTTPStatusCode.Int32)
+
+	// same message ID from another sender is a different request
+	existing, err = journal.Begin(ctx, "don", "0x1234", "1")
+	require.NoError(t, err)
+	require.Nil(t, existing)
+	require.NoError(t, journal.Forget(ctx, "don", "0x1234", "1"))
+	existing, err = journal.Begin(ctx, "don", "0x1234", "1")
+	require.NoError(t, err)
+	require.Nil(t, existing)
+}
+
+func TestRequestJournal_InterruptsInProgressOnStart(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	before, err := gateway.NewRequestJournal(db, testJournalConfig, logger.TestLogger(t))
+	require.NoError(t, err)
+	_, err = before.Begin(ctx, "don", "0xabcd", "1")
+	require.NoError(t, err)
+
+	after, err := gateway.NewRequestJournal(db, testJournalConfig, logger.TestLogger(t))
+	require.NoError(t, err)
+	servicetest.Run(t, after)
+
+	existing, err := after.Begin(ctx, "don", "0xabcd", "1")
+	require.NoError(t, err)
+	require.Equal(t, gateway.JournalInterrupted, existing.Status)
+}
+
+func TestGateway_ProcessRequest_JournalReplay(t *testing.T) {
+	t.Parallel()
+
+	journal, err := gateway.NewRequestJournal(pgtest.NewSqlxDB(t), testJournalConfig, logger.TestLogger(t))
+	require.NoError(t, err)
+	httpServer := net_mocks.NewHttpServer(t)
+	httpServer.On("SetHTTPRequestHandler", mock.Anything).Return(nil)
+	handler := handler_mocks.NewHandler(t)
+	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, map[string]handlers.Handler{"testDON": handler}, nil, journal, nil, logger.TestLogger(t))
+
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*api.Message)
+		callbackCh := args.Get(2).(chan<- handlers.UserCallbackPayload)
+		msg.Body.Payload = []byte(`{"result":"OK"}`)
+		msg.Signature = ""
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
+	}).Once()
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), req)
+	require.Equal(t, 200, statusCode)
+
+	// the retry is answered from the journal, without calling the handler again
+	replayed, replayedStatusCode := gw.ProcessRequest(testutils.Context(t), req)
+	require.Equal(t, 200, replayedStatusCode)
+	require.Equal(t, response, replayed)
+}
@@ -0,0 +1,161 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+)
+
+const APIKeyHeader = "X-Api-Key"
+
+// ErrNoCredentials is returned by a UserAuthenticator when a request carries
+// none of the credentials it checks, so that the next one can be tried.
+var ErrNoCredentials = errors.New("no credentials")
+
+type UserAuthenticator interface {
+	// Authenticate returns the authenticated user, ErrNoCredentials, or an
+	// error if the credentials are invalid.
+	Authenticate(ctx context.Context, msg *api.Message) (user string, err error)
+}
+
+// UserAuthChain authenticates user requests with a list of UserAuthenticators
+// and applies an optional per-user rate limit.
+type UserAuthChain struct {
+	authenticators []UserAuthenticator
+	rateLimiter    *common.RateLimiter
+}
+
+func NewUserAuthChain(cfg *config.UserAuthConfig) (*UserAuthChain, error) {
+	if len(cfg.Authenticators) == 0 {
+		return nil, errors.New("at least one authenticator is required")
+	}
+	chain := &UserAuthChain{}
+	for _, name := range cfg.Authenticators {
+		var a UserAuthenticator
+		var err error
+		switch name {
+		case config.AuthenticatorAPIKey:
+			a, err = newAPIKeyAuthenticator(cfg.APIKeys)
+		case config.AuthenticatorMTLS:
+			a, err = newMTLSAuthenticator(cfg.ClientCerts)
+		case config.AuthenticatorSignature:
+			a = signatureAuthenticator{}
+		default:
+			err = fmt.Errorf("unknown authenticator %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		chain.authenticators = append(chain.authenticators, a)
+	}
+	if cfg.UserRateLimiter != nil {
+		rateLimiter, err := common.NewRateLimiter(common.RateLimiterConfig(*cfg.UserRateLimiter))
+		if err != nil {
+			return nil, err
+		}
+		chain.rateLimiter = rateLimiter
+	}
+	return chain, nil
+}
+
+// Authenticate returns the authenticated user, or an error code and message
+// to reject the request with.
+func (c *UserAuthChain) Authenticate(ctx context.Context, msg *api.Message) (string, api.ErrorCode, string) {
+	for _, a := range c.authenticators {
+		user, err := a.Authenticate(ctx, msg)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		if err != nil {
+			return "", api.UnauthorizedError, err.Error()
+		}
+		if c.rateLimiter != nil && !c.rateLimiter.Allow(user) {
+			return "", api.LimitExceededError, "user rate limit exceeded"
+		}
+		return user, api.NoError, ""
+	}
+	return "", api.UnauthorizedError, "missing credentials"
+}
+
+type apiKeyAuthenticator struct {
+	// hash of the key -> user
+	users map[[sha256.Size]byte]string
+}
+
+func newAPIKeyAuthenticator(keys []config.APIKeyConfig) (*apiKeyAuthenticator, error) {
+	a := &apiKeyAuthenticator{users: make(map[[sha256.Size]byte]string)}
+	for _, k := range keys {
+		hash, err := hex.DecodeString(k.KeySHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid API key hash for user %q", k.User)
+		}
+		if k.User == "" {
+			return nil, errors.New("API key user is required")
+		}
+		a.users[[sha256.Size]byte(hash)] = k.User
+	}
+	return a, nil
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, _ *api.Message) (string, error) {
+	info, ok := gw_net.RequestInfoFromContext(ctx)
+	if !ok || info.Header.Get(APIKeyHeader) == "" {
+		return "", ErrNoCredentials
+	}
+	hash := sha256.Sum256([]byte(info.Header.Get(APIKeyHeader)))
+	for h, user := range a.users {
+		if subtle.ConstantTimeCompare(h[:], hash[:]) == 1 {
+			return user, nil
+		}
+	}
+	return "", errors.New("invalid API key")
+}
+
+type mTLSAuthenticator struct {
+	// certificate common name -> user
+	users map[string]string
+}
+
+func newMTLSAuthenticator(certs []config.ClientCertConfig) (*mTLSAuthenticator, error) {
+	a := &mTLSAuthenticator{users: make(map[string]string)}
+	for _, c := range certs {
+		if c.User == "" || c.CommonName == "" {
+			return nil, errors.New("client certificate user and common name are required")
+		}
+		a.users[c.CommonName] = c.User
+	}
+	return a, nil
+}
+
+// Authenticate relies on the HTTP server to have verified the certificate chain.
+func (a *mTLSAuthenticator) Authenticate(ctx context.Context, _ *api.Message) (string, error) {
+	info, ok := gw_net.RequestInfoFromContext(ctx)
+	if !ok || info.TLS == nil || len(info.TLS.VerifiedChains) == 0 {
+		return "", ErrNoCredentials
+	}
+	cn := info.TLS.VerifiedChains[0][0].Subject.CommonName
+	user, ok := a.users[cn]
+	if !ok {
+		return "", fmt.Errorf("unknown client certificate %q", cn)
+	}
+	return user, nil
+}
+
+// signatureAuthenticator accepts the message signer as the user. Signatures
+// are verified when messages are validated.
+type signatureAuthenticator struct{}
+
+func (signatureAuthenticator) Authenticate(_ context.Context, msg *api.Message) (string, error) {
+	if msg.Body.Sender == "" {
+		return "", ErrNoCredentials
+	}
+	return msg.Body.Sender, nil
+}
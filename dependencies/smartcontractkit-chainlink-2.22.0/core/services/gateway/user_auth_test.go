@@ -0,0 +1,134 @@
+package gateway_test
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	handler_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+	gw_net "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network"
+	net_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/network/mocks"
+)
+
+func keyHash(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+func signedMessage(t *testing.T) *api.Message {
+	codec := api.JsonRPCCodec{}
+	msg, err := codec.DecodeRequest(newSignedRequest(t, "abcd", "request", "testDON", []byte{}))
+	require.NoError(t, err)
+	require.NoError(t, msg.Validate())
+	return msg
+}
+
+func TestUserAuthChain_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	for name, cfg := range map[string]config.UserAuthConfig{
+		"no authenticators": {},
+		"unknown":           {Authenticators: []string{"password"}},
+		"bad key hash":      {Authenticators: []string{config.AuthenticatorAPIKey}, APIKeys: []config.APIKeyConfig{{User: "alice", KeySHA256: "abcd"}}},
+		"missing CN":        {Authenticators: []string{config.AuthenticatorMTLS}, ClientCerts: []config.ClientCertConfig{{User: "alice"}}},
+		"bad rate limit":    {Authenticators: []string{config.AuthenticatorSignature}, UserRateLimiter: &config.UserRateLimiterConfig{}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := gateway.NewUserAuthChain(&cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUserAuthChain_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	chain, err := gateway.NewUserAuthChain(&config.UserAuthConfig{
+		Authenticators: []string{config.AuthenticatorAPIKey, config.AuthenticatorMTLS},
+		APIKeys:        []config.APIKeyConfig{{User: "alice", KeySHA256: keyHash("alice-key")}},
+		ClientCerts:    []config.ClientCertConfig{{User: "bob", CommonName: "bob.example.com"}},
+	})
+	require.NoError(t, err)
+	msg := signedMessage(t)
+
+	withHeader := func(key string) gw_net.RequestInfo {
+		header := http.Header{}
+		header.Set(gateway.APIKeyHeader, key)
+		return gw_net.RequestInfo{Header: header}
+	}
+	withCert := func(cn string) gw_net.RequestInfo {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		return gw_net.RequestInfo{Header: http.Header{}, TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		info    gw_net.RequestInfo
+		user    string
+		errCode api.ErrorCode
+	}{
+		{"API key", withHeader("alice-key"), "alice", api.NoError},
+		{"invalid API key", withHeader("wrong"), "", api.UnauthorizedError},
+		{"client certificate", withCert("bob.example.com"), "bob", api.NoError},
+		{"unknown client certificate", withCert("eve.example.com"), "", api.UnauthorizedError},
+		{"no credentials", gw_net.RequestInfo{Header: http.Header{}}, "", api.UnauthorizedError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := gw_net.WithRequestInfo(testutils.Context(t), tc.info)
+			user, errCode, _ := chain.Authenticate(ctx, msg)
+			require.Equal(t, tc.errCode, errCode)
+			require.Equal(t, tc.user, user)
+		})
+	}
+}
+
+func TestUserAuthChain_SignatureAndRateLimit(t *testing.T) {
+	t.Parallel()
+
+	chain, err := gateway.NewUserAuthChain(&config.UserAuthConfig{
+		Authenticators:  []string{config.AuthenticatorAPIKey, config.AuthenticatorSignature},
+		UserRateLimiter: &config.UserRateLimiterConfig{GlobalRPS: 100, GlobalBurst: 100, PerSenderRPS: 0.01, PerSenderBurst: 1},
+	})
+	require.NoError(t, err)
+	msg := signedMessage(t)
+
+	user, errCode, _ := chain.Authenticate(testutils.Context(t), msg)
+	require.Equal(t, api.NoError, errCode)
+	require.Equal(t, msg.Body.Sender, user)
+
+	_, errCode, errMsg := chain.Authenticate(testutils.Context(t), msg)
+	require.Equal(t, api.LimitExceededError, errCode)
+	require.Equal(t, "user rate limit exceeded", errMsg)
+}
+
+func TestGateway_ProcessRequest_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	chain, err := gateway.NewUserAuthChain(&config.UserAuthConfig{
+		Authenticators: []string{config.AuthenticatorAPIKey},
+		APIKeys:        []config.APIKeyConfig{{User: "alice", KeySHA256: keyHash("alice-key")}},
+	})
+	require.NoError(t, err)
+	httpServer := net_mocks.NewHttpServer(t)
+	httpServer.On("SetHTTPRequestHandler", mock.Anything).Return(nil)
+	handler := handler_mocks.NewHandler(t)
+	gw := gateway.NewGateway(&api.JsonRPCCodec{}, httpServer, map[string]handlers.Handler{"testDON": handler}, map[string]*gateway.UserAuthChain{"testDON": chain}, nil, nil, logger.TestLogger(t))
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), req)
+	requireJsonRPCError(t, response, "abcd", -32001, "missing credentials")
+	require.Equal(t, 401, statusCode)
+}
@@ -642,6 +642,26 @@ type BlockhashStoreSpec struct {
 	// BatchBlockhashStoreBatchSize is the number of blockhashes to store in a single batch
 	TrustedBlockhashStoreBatchSize int32 `toml:"trustedBlockhashStoreBatchSize"`
 
+	// BatchBlockhashStoreAddress is the address of the BatchBlockhashStore contract used to store
+	// the blockhashes of an untrusted BlockhashStore in batches. If empty, blockhashes are stored
+	// one at a time.
+	BatchBlockhashStoreAddress *evmtypes.EIP55Address `toml:"batchBlockhashStoreAddress"`
+
+	// StoreBatchSize is the number of blockhashes accumulated before storing them in a batch.
+	// A batch has the chain's default gas limit per blockhash, and must fit the chain's maximum.
+	StoreBatchSize int32 `toml:"storeBatchSize"`
+
+	// StoreBatchDeadline is the longest a blockhash waits for a batch to fill up before the
+	// batch is stored anyway.
+	StoreBatchDeadline time.Duration `toml:"storeBatchDeadline"`
+
+	// GasBudget is the maximum total gas limit of the store transactions sent on the chain,
+	// by any BHS job, per GasBudgetPeriod. Blocks over budget are deferred. Zero means no budget.
+	GasBudget uint64 `toml:"gasBudget"`
+
+	// GasBudgetPeriod is the sliding time window the GasBudget applies to.
+	GasBudgetPeriod time.Duration `toml:"gasBudgetPeriod"`
+
 	// PollPeriod defines how often recent blocks should be scanned for blockhash storage.
 	PollPeriod time.Duration `toml:"pollPeriod"`
 
@@ -123,6 +123,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 		chain.Client(),
 		chain.HeadBroadcaster(),
 		chain.GasEstimator(),
+		chain.Config().EVM().GasEstimator().PriceMaxKey(spec.KeeperSpec.FromAddress.Address()),
 		svcLogger,
 		d.cfg.Keeper(),
 		effectiveKeeperAddress,
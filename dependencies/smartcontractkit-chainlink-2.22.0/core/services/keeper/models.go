@@ -38,6 +38,8 @@ type UpkeepRegistration struct {
 	UpkeepID            *big.Big
 	LastKeeperIndex     null.Int64
 	PositioningConstant int32
+	// LastSkipReason is why the upkeep was skipped since it was last performed, if it was.
+	LastSkipReason *string
 }
 
 func (k *KeeperIndexMap) Scan(val interface{}) error {
@@ -220,12 +220,23 @@ WHERE registry_id = $1
 	return upkeeps, errors.Wrap(err, "allUpkeepIDs failed")
 }
 
+// SetSkipReasonForUpkeepOnJob records why the upkeep was last skipped instead of performed.
+func (o *ORM) SetSkipReasonForUpkeepOnJob(ctx context.Context, jobID int32, upkeepID *big.Big, reason string) error {
+	_, err := o.ds.ExecContext(ctx, `
+	UPDATE upkeep_registrations
+	SET last_skip_reason = $1
+	WHERE upkeep_id = $2 AND
+	registry_id = (SELECT id FROM keeper_registries WHERE job_id = $3)`, reason, upkeepID, jobID)
+	return errors.Wrap(err, "SetSkipReasonForUpkeepOnJob failed")
+}
+
 // SetLastRunInfoForUpkeepOnJob sets the last run block height and the associated keeper index only if the new block height is greater than the previous.
 func (o *ORM) SetLastRunInfoForUpkeepOnJob(ctx context.Context, jobID int32, upkeepID *big.Big, height int64, fromAddress types.EIP55Address) (int64, error) {
 	res, err := o.ds.ExecContext(ctx, `
 	UPDATE upkeep_registrations
 	SET last_run_block_height = $1,
-		last_keeper_index = CAST((SELECT keeper_index_map -> $4 FROM keeper_registries WHERE job_id = $3) AS int)
+		last_keeper_index = CAST((SELECT keeper_index_map -> $4 FROM keeper_registries WHERE job_id = $3) AS int),
+		last_skip_reason = NULL
 	WHERE upkeep_id = $2 AND
 	registry_id = (SELECT id FROM keeper_registries WHERE job_id = $3) AND
 	last_run_block_height <= $1`, height, upkeepID, jobID, fromAddress.Hex())
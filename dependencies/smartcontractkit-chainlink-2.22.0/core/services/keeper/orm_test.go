@@ -440,6 +440,28 @@ func TestKeeperDB_NewSetLastRunInfoForUpkeepOnJob(t *testing.T) {
 	assertLastRunHeight(t, db, upkeep, 101, 0)
 }
 
+func TestKeeperDB_SetSkipReasonForUpkeepOnJob(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, _, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	registry, j := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, registry)
+
+	err := orm.SetSkipReasonForUpkeepOnJob(ctx, j.ID, upkeep.UpkeepID, "unprofitable")
+	require.NoError(t, err)
+	require.NoError(t, db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	require.NotNil(t, upkeep.LastSkipReason)
+	require.Equal(t, "unprofitable", *upkeep.LastSkipReason)
+
+	// performing the upkeep clears the reason
+	_, err = orm.SetLastRunInfoForUpkeepOnJob(ctx, j.ID, upkeep.UpkeepID, 100, registry.FromAddress)
+	require.NoError(t, err)
+	require.NoError(t, db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	require.Nil(t, upkeep.LastSkipReason)
+}
+
 func TestKeeperDB_LeastSignificant(t *testing.T) {
 	t.Parallel()
 	db, _, _ := setupKeeperDB(t)
@@ -25,6 +25,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 const (
@@ -45,6 +46,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promUpkeepsSkippedUnprofitable = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeeps_skipped_unprofitable",
+		Help: "The number of times an upkeep was not performed because its estimated cost exceeded the maximum reimbursement",
+	},
+		[]string{"upkeepID"},
+	)
 )
 
 type UpkeepExecuterConfig interface {
@@ -62,6 +69,7 @@ type UpkeepExecuter struct {
 	executionQueue         chan struct{}
 	headBroadcaster        heads.Broadcaster
 	gasEstimator           gas.EvmFeeEstimator
+	maxGasPrice            *assets.Wei
 	job                    job.Job
 	mailbox                *mailbox.Mailbox[*evmtypes.Head]
 	orm                    *ORM
@@ -79,6 +87,7 @@ func NewUpkeepExecuter(
 	ethClient evmclient.Client,
 	headBroadcaster heads.Broadcaster,
 	gasEstimator gas.EvmFeeEstimator,
+	maxGasPrice *assets.Wei,
 	logger logger.Logger,
 	config UpkeepExecuterConfig,
 	effectiveKeeperAddress common.Address,
@@ -89,6 +98,7 @@ func NewUpkeepExecuter(
 		executionQueue:         make(chan struct{}, executionQueueSize),
 		headBroadcaster:        headBroadcaster,
 		gasEstimator:           gasEstimator,
+		maxGasPrice:            maxGasPrice,
 		job:                    job,
 		mailbox:                mailbox.NewSingle[*evmtypes.Head](),
 		config:                 config,
@@ -222,7 +232,8 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	var gasPrice, gasTipCap, gasFeeCap *assets.Wei
 	// effectiveKeeperAddress is always fromAddress when forwarding is not enabled.
 	// when forwarding is enabled, effectiveKeeperAddress is on-chain forwarder.
-	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.config.Registry(), gasPrice, gasTipCap, gasFeeCap, evmChainID))
+	performGasPrice := ex.estimatePerformGasPrice(ctxService, upkeep, svcLogger)
+	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.config.Registry(), gasPrice, gasTipCap, gasFeeCap, performGasPrice, evmChainID))
 
 	// DotDagSource in database is empty because all the Keeper pipeline runs make use of the same observation source
 	ex.job.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
@@ -233,6 +244,15 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 		return
 	}
 
+	if reason := unprofitableReason(run); reason != "" {
+		svcLogger.Infow("skipping unprofitable upkeep", "reason", reason)
+		promUpkeepsSkippedUnprofitable.WithLabelValues(upkeep.PrettyID()).Inc()
+		if err := ex.orm.SetSkipReasonForUpkeepOnJob(ctxService, ex.job.ID, upkeep.UpkeepID, reason); err != nil {
+			svcLogger.Error(errors.Wrap(err, "failed to set skip reason for upkeep"))
+		}
+		return
+	}
+
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
 		rowsAffected, err := ex.orm.SetLastRunInfoForUpkeepOnJob(ctxService, ex.job.ID, upkeep.UpkeepID, head.Number, upkeep.Registry.FromAddress)
@@ -248,6 +268,50 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
+// estimatePerformGasPrice returns the gas price performUpkeep would currently be
+// sent at. The fee cap is used for dynamic fees. The price is zero if it can't
+// be estimated, so that the upkeep is never skipped as unprofitable.
+func (ex *UpkeepExecuter) estimatePerformGasPrice(ctx context.Context, upkeep UpkeepRegistration, lggr logger.Logger) *assets.Wei {
+	fromAddress := upkeep.Registry.FromAddress.Address()
+	contractAddress := upkeep.Registry.ContractAddress.Address()
+	fee, _, err := ex.gasEstimator.GetFee(ctx, nil, maxUpkeepPerformGas, ex.maxGasPrice, &fromAddress, &contractAddress)
+	if err != nil {
+		lggr.Warnw("unable to estimate perform gas price, not checking profitability", "err", err)
+		return assets.NewWeiI(0)
+	}
+	if fee.ValidDynamic() {
+		return fee.GasFeeCap
+	}
+	if fee.GasPrice == nil {
+		return assets.NewWeiI(0)
+	}
+	return fee.GasPrice
+}
+
+// unprofitableReason returns why the run did not perform the upkeep if the
+// estimated cost of performUpkeep exceeded the maximum reimbursement of the
+// registry, or an empty string otherwise. When the gas or the gas price can't
+// be estimated the cost is zero, which is not reported as unprofitable.
+func unprofitableReason(run *pipeline.Run) string {
+	check := run.ByDotID("check_profitable")
+	if check == nil || !check.Error.Valid {
+		return ""
+	}
+	if cost := run.ByDotID("calculate_perform_cost"); cost == nil {
+		return ""
+	} else if c, err := utils.ToDecimal(cost.Output.Val); err != nil || c.IsZero() {
+		return ""
+	}
+	output := func(dotID string) interface{} {
+		if tr := run.ByDotID(dotID); tr != nil {
+			return tr.Output.Val
+		}
+		return nil
+	}
+	return fmt.Sprintf("unprofitable: estimated cost of %v wei for %v gas exceeds the maximum reimbursement of %v wei",
+		output("calculate_perform_cost"), output("estimate_perform_upkeep_gas"), output("calculate_max_reimbursement"))
+}
+
 func (ex *UpkeepExecuter) turnBlockHashBinary(ctx context.Context, registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
 	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
 	block, err := ex.ethClient.HeadByNumber(ctx, big.NewInt(turnBlock))
@@ -267,6 +331,7 @@ func buildJobSpec(
 	gasPrice *assets.Wei,
 	gasTipCap *assets.Wei,
 	gasFeeCap *assets.Wei,
+	performGasPrice *assets.Wei,
 	chainID string,
 ) map[string]interface{} {
 	return map[string]interface{}{
@@ -285,6 +350,7 @@ func buildJobSpec(
 			"gasPrice":              gasPrice.ToInt(),
 			"gasTipCap":             gasTipCap.ToInt(),
 			"gasFeeCap":             gasFeeCap.ToInt(),
+			"performGasPrice":       performGasPrice.ToInt(),
 			"evmChainID":            chainID,
 		},
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"math/big"
 	"sync/atomic"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -79,6 +81,10 @@ func setup(t *testing.T, estimator gas.EvmFeeEstimator, overrideFn func(c *chain
 	ethClient.On("ConfiguredChainID").Return(cfg.EVMConfigs()[0].ChainID.ToInt()).Maybe()
 	ethClient.On("IsL2").Return(false).Maybe()
 	ethClient.On("HeadByNumber", mock.Anything, mock.Anything).Maybe().Return(&evmtypes.Head{Number: 1, Hash: utils.NewHash()}, nil)
+	ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything, mock.Anything).Maybe().Return(nil).
+		Run(func(args mock.Arguments) {
+			*args.Get(1).(*hexutil.Uint64) = hexutil.Uint64(performUpkeepGasEstimate)
+		})
 	txm := txmmocks.NewMockEvmTxManager(t)
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{
 		TxManager:      txm,
@@ -97,24 +103,30 @@ func setup(t *testing.T, estimator gas.EvmFeeEstimator, overrideFn func(c *chain
 	registry, jb := cltest.MustInsertKeeperRegistry(t, db, orm, keyStore.Eth(), 0, 1, 20)
 
 	lggr := logger.TestLogger(t)
-	executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.GasEstimator(), lggr, cfg.Keeper(), jb.KeeperSpec.FromAddress.Address())
+	executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethClient, ch.HeadBroadcaster(), ch.GasEstimator(), assets.GWei(100), lggr, cfg.Keeper(), jb.KeeperSpec.FromAddress.Address())
 	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, registry)
 	servicetest.Run(t, executer)
 	return db, cfg, ethClient, executer, registry, upkeep, jb, jpv2, txm, keyStore, ch, orm
 }
 
-var checkUpkeepResponse = struct {
+// At the 60 gwei of mockEstimator, performUpkeep costs 0.006 ETH.
+const performUpkeepGasEstimate = 100_000
+
+type checkUpkeepResult struct {
 	PerformData    []byte
 	MaxLinkPayment *big.Int
 	GasLimit       *big.Int
 	GasWei         *big.Int
 	LinkEth        *big.Int
-}{
+}
+
+// The registry pays at most 1 LINK, worth 0.02 ETH.
+var checkUpkeepResponse = checkUpkeepResult{
 	PerformData:    common.Hex2Bytes("1234"),
-	MaxLinkPayment: big.NewInt(0), // doesn't matter
+	MaxLinkPayment: big.NewInt(1e18),
 	GasLimit:       big.NewInt(2_000_000),
 	GasWei:         big.NewInt(0), // doesn't matter
-	LinkEth:        big.NewInt(0), // doesn't matter
+	LinkEth:        big.NewInt(2e16),
 }
 
 var checkPerformResponse = struct {
@@ -131,7 +143,7 @@ func Test_UpkeepExecuter_ErrorsIfStartedTwice(t *testing.T) {
 }
 
 func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
-	taskRuns := 11
+	taskRuns := 17
 
 	t.Parallel()
 
@@ -277,7 +289,7 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 		jb.KeeperSpec.EVMChainID = (*ubig.Big)(big.NewInt(999))
 		cltest.MustInsertUpkeepForRegistry(t, db, registry)
 		lggr := logger.TestLogger(t)
-		executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.GasEstimator(), lggr, cfg.Keeper(), jb.KeeperSpec.FromAddress.Address())
+		executer := keeper.NewUpkeepExecuter(jb, orm, jpv2.Pr, ethMock, ch.HeadBroadcaster(), ch.GasEstimator(), assets.GWei(100), lggr, cfg.Keeper(), jb.KeeperSpec.FromAddress.Address())
 		err := executer.Start(testutils.Context(t))
 		require.NoError(t, err)
 		head := newHead()
@@ -325,6 +337,80 @@ func Test_UpkeepExecuter_PerformsUpkeep_Happy(t *testing.T) {
 	})
 }
 
+func Test_UpkeepExecuter_SkipsUnprofitableUpkeep(t *testing.T) {
+	t.Parallel()
+
+	db, _, ethMock, executer, registry, upkeep, _, _, _, _, _, _ := setup(t, mockEstimator(t),
+		func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].ChainID = (*ubig.Big)(testutils.SimulatedChainID)
+		})
+
+	// The registry pays at most 0.1 LINK, worth 0.002 ETH.
+	unprofitableResponse := checkUpkeepResponse
+	unprofitableResponse.MaxLinkPayment = big.NewInt(1e17)
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", unprofitableResponse)
+	registryMock.MockMatchedResponse(
+		"performUpkeep",
+		func(callArgs ethereum.CallMsg) bool { return true },
+		checkPerformResponse,
+	)
+
+	head := newHead()
+	executer.OnNewLongestChain(testutils.Context(t), &head)
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+		return upkeep.LastSkipReason != nil
+	}, time.Second*2, time.Millisecond*100)
+	assert.Contains(t, *upkeep.LastSkipReason, "unprofitable")
+	assert.NotEqual(t, head.Number, upkeep.LastRunBlockHeight)
+	// the tx manager mock fails the test if performUpkeep is submitted
+}
+
+func Test_UpkeepExecuter_PerformsUpkeepIfGasCannotBeEstimated(t *testing.T) {
+	t.Parallel()
+
+	db, _, ethMock, executer, registry, upkeep, _, _, txm, _, _, _ := setup(t, mockEstimator(t),
+		func(c *chainlink.Config, s *chainlink.Secrets) {
+			c.EVM[0].ChainID = (*ubig.Big)(testutils.SimulatedChainID)
+		})
+	var estimateGas *mock.Call
+	for _, call := range ethMock.ExpectedCalls {
+		if call.Method == "CallContext" && call.Arguments.Get(2) == "eth_estimateGas" {
+			estimateGas = call
+		}
+	}
+	estimateGas.Unset()
+	ethMock.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything, mock.Anything).Maybe().
+		Return(errors.New("estimate failed"))
+
+	ethTxCreated := cltest.NewAwaiter()
+	txm.On("CreateTransaction", mock.Anything, mock.Anything).
+		Once().
+		Return(txmgr.Tx{ID: 1}, nil).
+		Run(func(mock.Arguments) { ethTxCreated.ItHappened() })
+
+	// The upkeep would be unprofitable at the configured gas limit the estimate used to fall back to.
+	unprofitableResponse := checkUpkeepResponse
+	unprofitableResponse.MaxLinkPayment = big.NewInt(1e17)
+	registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+	registryMock.MockResponse("checkUpkeep", unprofitableResponse)
+	registryMock.MockMatchedResponse(
+		"performUpkeep",
+		func(callArgs ethereum.CallMsg) bool { return true },
+		checkPerformResponse,
+	)
+
+	head := newHead()
+	executer.OnNewLongestChain(testutils.Context(t), &head)
+	ethTxCreated.AwaitOrFail(t)
+	waitLastRunHeight(t, db, upkeep, 20)
+
+	require.NoError(t, db.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE id = $1`, upkeep.ID))
+	assert.Nil(t, upkeep.LastSkipReason)
+}
+
 func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	t.Parallel()
 
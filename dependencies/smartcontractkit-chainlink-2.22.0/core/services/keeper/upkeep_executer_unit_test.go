@@ -47,13 +47,14 @@ func TestBuildJobSpec(t *testing.T) {
 	gasPrice := assets.NewWeiI(24)
 	gasTipCap := assets.NewWeiI(48)
 	gasFeeCap := assets.NewWeiI(72)
+	performGasPrice := assets.NewWeiI(96)
 
 	r := &registry{
 		pgo:  uint32(9),
 		mpds: uint32(1000),
 	}
 
-	spec := buildJobSpec(jb, jb.KeeperSpec.FromAddress.Address(), upkeep, r, gasPrice, gasTipCap, gasFeeCap, chainID)
+	spec := buildJobSpec(jb, jb.KeeperSpec.FromAddress.Address(), upkeep, r, gasPrice, gasTipCap, gasFeeCap, performGasPrice, chainID)
 
 	expected := map[string]interface{}{
 		"jobSpec": map[string]interface{}{
@@ -71,6 +72,7 @@ func TestBuildJobSpec(t *testing.T) {
 			"gasPrice":              gasPrice.ToInt(),
 			"gasTipCap":             gasTipCap.ToInt(),
 			"gasFeeCap":             gasFeeCap.ToInt(),
+			"performGasPrice":       performGasPrice.ToInt(),
 			"evmChainID":            "250",
 		},
 	}
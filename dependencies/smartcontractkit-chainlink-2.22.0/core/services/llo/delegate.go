@@ -105,6 +105,9 @@ func NewDelegate(cfg DelegateConfig) (job.ServiceCtx, error) {
 		codecLggr = corelogger.NullLogger
 	}
 	reportCodecs := NewReportCodecs(codecLggr, cfg.DonID)
+	if t, ok := cfg.ContractTransmitter.(reportChannelsTransmitter); ok && t.ReportChannels() != nil {
+		reportCodecs = recordReportChannels(reportCodecs, t.ReportChannels())
+	}
 
 	t := NewTelemeterService(TelemeterParams{
 		Logger:                      lggr,
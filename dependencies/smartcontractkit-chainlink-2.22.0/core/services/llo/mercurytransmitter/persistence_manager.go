@@ -120,6 +120,11 @@ func (pm *persistenceManager) AsyncDelete(hash [32]byte) {
 	pm.addToDeleteQueue(hash)
 }
 
+// Insert persists transmissions again, e.g. once replayed from disk
+func (pm *persistenceManager) Insert(ctx context.Context, transmissions []*Transmission) error {
+	return pm.orm.Insert(ctx, transmissions)
+}
+
 func (pm *persistenceManager) Load(ctx context.Context) ([]*Transmission, error) {
 	return pm.orm.Get(ctx, pm.serverURL, pm.maxTransmitQueueSize, pm.maxAge)
 }
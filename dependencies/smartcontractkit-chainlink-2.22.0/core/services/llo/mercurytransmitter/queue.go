@@ -22,15 +22,45 @@ type asyncDeleter interface {
 	DonID() uint32
 }
 
+type transmissionInserter interface {
+	Insert(ctx context.Context, transmissions []*Transmission) error
+}
+
 var _ services.Service = (*transmitQueue)(nil)
 
-var promTransmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
-	Namespace: "llo",
-	Subsystem: "mercurytransmitter",
-	Name:      "transmit_queue_load",
-	Help:      "Current count of items in the transmit queue",
-},
-	[]string{"donID", "serverURL", "capacity"},
+var (
+	promTransmitQueueLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "llo",
+		Subsystem: "mercurytransmitter",
+		Name:      "transmit_queue_load",
+		Help:      "Current count of items in the transmit queue",
+	},
+		[]string{"donID", "serverURL", "capacity"},
+	)
+	promTransmitQueueSpillLoad = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "llo",
+		Subsystem: "mercurytransmitter",
+		Name:      "transmit_queue_spill_load",
+		Help:      "Current count of items spilled to disk by the transmit queue",
+	},
+		[]string{"donID", "serverURL"},
+	)
+	promTransmitQueueDropCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "mercurytransmitter",
+		Name:      "transmit_queue_drop_count",
+		Help:      "Running count of items dropped from the transmit queue because it was full",
+	},
+		[]string{"donID", "serverURL"},
+	)
+	promTransmitQueueOldestAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "llo",
+		Subsystem: "mercurytransmitter",
+		Name:      "transmit_queue_oldest_age_seconds",
+		Help:      "Time since the oldest item in the transmit queue, including items spilled to disk, was queued",
+	},
+		[]string{"donID", "serverURL"},
+	)
 )
 
 // Prometheus' default interval is 15s, set this to under 7.5s to avoid
@@ -51,9 +81,35 @@ type transmitQueue struct {
 	maxlen int
 	closed bool
 
+	serverURL string
+	spillCfg  SpillConfig
+	// inserter persists replayed transmissions again
+	inserter transmissionInserter
+	// spillMu guards spill, so that its disk I/O doesn't block mu. It is
+	// never acquired while holding mu.
+	spillMu sync.Mutex
+	spill   *spillQueue // nil if spilling is disabled
+	stopCh  services.StopChan
+	// overloaded is whether the queue is unhealthy for being too full
+	overloaded bool
+
 	// monitor loop
-	stopMonitor       func()
-	transmitQueueLoad prometheus.Gauge
+	stopMonitor                func()
+	transmitQueueLoad          prometheus.Gauge
+	transmitQueueSpillLoad     prometheus.Gauge
+	transmitQueueDropCount     prometheus.Counter
+	transmitQueueOldestAgeSecs prometheus.Gauge
+}
+
+// SpillConfig configures the spilling to disk of the transmissions that
+// overflow a transmit queue.
+type SpillConfig struct {
+	// Dir is the directory transmissions are spilled to. Spilling is disabled
+	// if empty, and the oldest transmissions are dropped instead.
+	Dir string
+	// MaxSize of the spilled transmissions in bytes, above which the oldest
+	// ones are dropped. Zero is unlimited.
+	MaxSize int64
 }
 
 type TransmitQueue interface {
@@ -68,7 +124,13 @@ type TransmitQueue interface {
 // maxlen controls how many items will be stored in the queue
 // 0 means unlimited - be careful, this can cause memory leaks
 func NewTransmitQueue(lggr logger.Logger, serverURL string, maxlen int, asyncDeleter asyncDeleter) TransmitQueue {
+	return newTransmitQueue(lggr, serverURL, maxlen, asyncDeleter, SpillConfig{}, nil)
+}
+
+// inserter is only used if spillCfg enables spilling
+func newTransmitQueue(lggr logger.Logger, serverURL string, maxlen int, asyncDeleter asyncDeleter, spillCfg SpillConfig, inserter transmissionInserter) *transmitQueue {
 	mu := new(sync.RWMutex)
+	donIDStr := strconv.FormatUint(uint64(asyncDeleter.DonID()), 10)
 	return &transmitQueue{
 		services.StateMachine{},
 		sync.Cond{L: mu},
@@ -78,8 +140,18 @@ func NewTransmitQueue(lggr logger.Logger, serverURL string, maxlen int, asyncDel
 		nil, // pq needs to be initialized by calling tq.Init before use
 		maxlen,
 		false,
+		serverURL,
+		spillCfg,
+		inserter,
+		sync.Mutex{},
+		nil, // spill is opened on Start
+		make(services.StopChan),
+		false,
 		nil,
-		promTransmitQueueLoad.WithLabelValues(strconv.FormatUint(uint64(asyncDeleter.DonID()), 10), serverURL, strconv.FormatInt(int64(maxlen), 10)),
+		promTransmitQueueLoad.WithLabelValues(donIDStr, serverURL, strconv.FormatInt(int64(maxlen), 10)),
+		promTransmitQueueSpillLoad.WithLabelValues(donIDStr, serverURL),
+		promTransmitQueueDropCount.WithLabelValues(donIDStr, serverURL),
+		promTransmitQueueOldestAge.WithLabelValues(donIDStr, serverURL),
 	}
 }
 
@@ -91,40 +163,127 @@ func (tq *transmitQueue) Init(ts []*Transmission) error {
 	pq := priorityQueue(ts)
 	heap.Init(&pq) // ensure the heap is ordered
 	tq.pq = &pq
+	now := time.Now()
+	for _, t := range ts {
+		if t.queuedAt.IsZero() {
+			t.queuedAt = now
+		}
+	}
 	return nil
 }
 
 func (tq *transmitQueue) Push(t *Transmission) (ok bool) {
+	evicted, ok := tq.push(t)
+	// spill outside of the lock, so that disk I/O doesn't block popping
+	for _, removed := range evicted {
+		if tq.trySpill(removed) {
+			continue
+		}
+		hash := removed.Hash()
+		tq.asyncDeleter.AsyncDelete(hash)
+		tq.transmitQueueDropCount.Inc()
+		tq.lggr.Criticalw(fmt.Sprintf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen), "transmission", removed, "transmissionHash", hex.EncodeToString(hash[:]))
+	}
+	return ok
+}
+
+// push pushes t and returns the oldest transmissions it evicted to make room
+func (tq *transmitQueue) push(t *Transmission) (evicted []*Transmission, ok bool) {
 	tq.cond.L.Lock()
 	defer tq.cond.L.Unlock()
 
 	if tq.closed {
-		return false
+		return nil, false
 	}
 
 	if tq.maxlen != 0 {
 		for tq.pq.Len() >= tq.maxlen {
 			// evict oldest entries to make room
-			removed := heap.PopMax(tq.pq)
-			lggr := tq.lggr
-			if removed, ok := removed.(*Transmission); ok {
-				hash := removed.Hash()
-				lggr = lggr.With("transmissionHash", hex.EncodeToString(hash[:]))
-				tq.asyncDeleter.AsyncDelete(hash)
+			if removed, ok := heap.PopMax(tq.pq).(*Transmission); ok {
+				evicted = append(evicted, removed)
 			}
-			lggr.Criticalw(fmt.Sprintf("Transmit queue is full; dropping oldest transmission (reached max length of %d)", tq.maxlen), "transmission", removed)
 		}
 	}
 
+	if t.queuedAt.IsZero() {
+		t.queuedAt = time.Now()
+	}
 	heap.Push(tq.pq, t)
 	tq.cond.Signal()
 
+	return evicted, true
+}
+
+// trySpill moves an evicted transmission to the spill queue, if enabled. The
+// spill queue then holds it instead of the database.
+func (tq *transmitQueue) trySpill(t *Transmission) bool {
+	tq.spillMu.Lock()
+	defer tq.spillMu.Unlock()
+	if tq.spill == nil {
+		return false
+	}
+	dropped, err := tq.spill.write(t)
+	tq.transmitQueueDropCount.Add(float64(dropped))
+	if err != nil {
+		tq.lggr.Errorw("Failed to spill transmission to disk", "err", err)
+		return false
+	}
+	tq.asyncDeleter.AsyncDelete(t.Hash())
 	return true
 }
 
+// replay moves the oldest spilled transmissions back into the queue once it
+// has drained to a quarter of its capacity, which only happens while the
+// server accepts transmissions again. Replayed transmissions are persisted
+// again before their segment is removed, so that a restart picks them up like
+// any other queued transmission.
+func (tq *transmitQueue) replay() {
+	tq.spillMu.Lock()
+	defer tq.spillMu.Unlock()
+	if tq.spill == nil || tq.spill.len() == 0 {
+		return
+	}
+	tq.mu.RLock()
+	length, closed := tq.pq.Len(), tq.closed
+	tq.mu.RUnlock()
+	if closed || length > tq.maxlen/4 {
+		return
+	}
+
+	seg, ts, err := tq.spill.readOldest(tq.serverURL, tq.maxlen-length)
+	if err != nil {
+		tq.lggr.Errorw("Failed to replay spilled transmissions", "err", err)
+		return
+	} else if seg == nil {
+		return
+	}
+	ctx, cancel := tq.stopCh.NewCtx()
+	defer cancel()
+	if err = tq.inserter.Insert(ctx, ts); err != nil {
+		// the segment is replayed again on the next pop
+		tq.lggr.Errorw("Failed to persist spilled transmissions", "err", err)
+		return
+	}
+	if err = tq.spill.remove(seg); err != nil {
+		// the transmissions are persisted, so they're only replayed twice
+		tq.lggr.Errorw("Failed to remove replayed spill segment", "err", err)
+	}
+
+	tq.cond.L.Lock()
+	for _, t := range ts {
+		heap.Push(tq.pq, t)
+	}
+	tq.cond.L.Unlock()
+	if len(ts) > 0 {
+		tq.cond.Broadcast()
+		tq.lggr.Debugw("Replayed spilled transmissions", "nTransmissions", len(ts), "nSpilled", tq.spill.len())
+	}
+}
+
 // BlockingPop will block until at least one item is in the heap, and then return it
 // If the queue is closed, it will immediately return nil
 func (tq *transmitQueue) BlockingPop() (t *Transmission) {
+	tq.replay()
 	tq.cond.L.Lock()
 	defer tq.cond.L.Unlock()
 	if tq.closed {
@@ -147,6 +306,21 @@ func (tq *transmitQueue) IsEmpty() bool {
 
 func (tq *transmitQueue) Start(context.Context) error {
 	return tq.StartOnce("TransmitQueue", func() error {
+		if tq.spillCfg.Dir != "" {
+			if tq.inserter == nil {
+				return errors.New("spilling transmissions requires an inserter")
+			}
+			// only evicted transmissions are spilled, so segments never need
+			// more than the room left once the queue drained
+			spill, err := openSpillQueue(tq.lggr, tq.spillCfg.Dir, tq.spillCfg.MaxSize, tq.maxlen/2)
+			if err != nil {
+				return err
+			}
+			tq.spillMu.Lock()
+			tq.spill = spill
+			tq.spillMu.Unlock()
+		}
+
 		t := services.NewTicker(promInterval)
 		wg := new(sync.WaitGroup)
 		chStop := make(chan struct{})
@@ -167,8 +341,16 @@ func (tq *transmitQueue) Close() error {
 		tq.closed = true
 		tq.cond.L.Unlock()
 		tq.cond.Broadcast()
+		// abort a replay in progress
+		close(tq.stopCh)
+		var err error
+		tq.spillMu.Lock()
+		if tq.spill != nil {
+			err = tq.spill.close()
+		}
+		tq.spillMu.Unlock()
 		tq.stopMonitor()
-		return nil
+		return err
 	})
 }
 
@@ -186,10 +368,29 @@ func (tq *transmitQueue) monitorLoop(c <-chan time.Time, chStop <-chan struct{},
 }
 
 func (tq *transmitQueue) report() {
+	var spilled int
+	var oldest time.Time
+	tq.spillMu.Lock()
+	if tq.spill != nil {
+		spilled = tq.spill.len()
+		oldest, _ = tq.spill.oldest()
+	}
+	tq.spillMu.Unlock()
 	tq.mu.RLock()
 	length := tq.pq.Len()
+	for _, t := range *tq.pq {
+		if oldest.IsZero() || t.queuedAt.Before(oldest) {
+			oldest = t.queuedAt
+		}
+	}
 	tq.mu.RUnlock()
 	tq.transmitQueueLoad.Set(float64(length))
+	tq.transmitQueueSpillLoad.Set(float64(spilled))
+	if oldest.IsZero() {
+		tq.transmitQueueOldestAgeSecs.Set(0)
+	} else {
+		tq.transmitQueueOldestAgeSecs.Set(time.Since(oldest).Seconds())
+	}
 }
 
 func (tq *transmitQueue) Ready() error {
@@ -203,13 +404,26 @@ func (tq *transmitQueue) HealthReport() map[string]error {
 	return report
 }
 
+// status reports the queue as unhealthy once it is more than half full, and
+// only as healthy again once it drained to a quarter of its capacity, so that
+// a queue hovering around the threshold doesn't flap.
 func (tq *transmitQueue) status() (merr error) {
-	tq.mu.RLock()
+	tq.mu.Lock()
 	length := tq.pq.Len()
 	closed := tq.closed
-	tq.mu.RUnlock()
+	if tq.maxlen != 0 {
+		if length > tq.maxlen/2 {
+			tq.overloaded = true
+		} else if length <= tq.maxlen/4 {
+			tq.overloaded = false
+		}
+	}
+	overloaded := tq.overloaded
+	tq.mu.Unlock()
 	if tq.maxlen != 0 && length > (tq.maxlen/2) {
 		merr = errors.Join(merr, fmt.Errorf("transmit priority queue is greater than 50%% full (%d/%d)", length, tq.maxlen))
+	} else if overloaded {
+		merr = errors.Join(merr, fmt.Errorf("transmit priority queue has not yet drained below 25%% full (%d/%d)", length, tq.maxlen))
 	}
 	if closed {
 		merr = errors.New("transmit queue is closed")
@@ -1,6 +1,9 @@
 package mercurytransmitter
 
 import (
+	"context"
+	"errors"
+	"os"
 	"sync"
 	"testing"
 
@@ -27,6 +30,21 @@ func (m *mockAsyncDeleter) DonID() uint32 {
 	return m.donID
 }
 
+var _ transmissionInserter = &mockInserter{}
+
+type mockInserter struct {
+	err           error
+	transmissions []*Transmission
+}
+
+func (m *mockInserter) Insert(_ context.Context, transmissions []*Transmission) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.transmissions = append(m.transmissions, transmissions...)
+	return nil
+}
+
 func Test_Queue(t *testing.T) {
 	t.Parallel()
 	const maxSize = 7
@@ -153,3 +171,101 @@ func Test_Queue(t *testing.T) {
 		assert.ElementsMatch(t, testTransmissions[4:4+maxSize], queueEntriesSorted)
 	})
 }
+
+func Test_Queue_Spill(t *testing.T) {
+	t.Parallel()
+	const maxSize = 4
+
+	lggr := logger.TestLogger(t)
+	dir := t.TempDir()
+	deleter := &mockAsyncDeleter{}
+	inserter := &mockInserter{}
+	tq := newTransmitQueue(lggr, sURL, maxSize, deleter, SpillConfig{Dir: dir}, inserter)
+	require.NoError(t, tq.Init([]*Transmission{}))
+	require.NoError(t, tq.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, tq.Close()) })
+
+	testTransmissions := makeSampleTransmissions(10, sURL)
+	for _, tr := range testTransmissions {
+		require.True(t, tq.Push(tr))
+	}
+
+	// the oldest transmissions were spilled instead of dropped
+	require.Equal(t, maxSize, tq.pq.Len())
+	assert.Equal(t, 6, tq.spill.len())
+	require.Len(t, deleter.hashes, 6)
+	for i, hash := range deleter.hashes {
+		assert.Equal(t, testTransmissions[i].Hash(), hash)
+	}
+
+	t.Run("spilled transmissions are picked up after restart", func(t *testing.T) {
+		require.NoError(t, tq.Close())
+
+		tq = newTransmitQueue(lggr, sURL, maxSize, deleter, SpillConfig{Dir: dir}, inserter)
+		require.NoError(t, tq.Init(testTransmissions[6:]))
+		require.NoError(t, tq.Start(testutils.Context(t)))
+		assert.Equal(t, 6, tq.spill.len())
+	})
+
+	t.Run("spilled transmissions are not replayed until they are persisted again", func(t *testing.T) {
+		inserter.err = errors.New("db down")
+		t.Cleanup(func() { inserter.err = nil })
+		require.NoError(t, tq.Init([]*Transmission{}))
+		tq.replay()
+		assert.True(t, tq.IsEmpty())
+		assert.Equal(t, 6, tq.spill.len())
+		require.NoError(t, tq.Init(testTransmissions[6:]))
+	})
+
+	t.Run("spilled transmissions are replayed once the queue drained", func(t *testing.T) {
+		var seqNrs []uint64
+		for i := 0; i < 10; i++ {
+			tr := tq.BlockingPop()
+			seqNrs = append(seqNrs, tr.SeqNr)
+		}
+		// latest first, then the oldest spilled segments first
+		assert.Equal(t, []uint64{9, 8, 7, 6, 1, 3, 2, 5, 4, 0}, seqNrs)
+		assert.True(t, tq.IsEmpty())
+		assert.Zero(t, tq.spill.len())
+		// the replayed transmissions are persisted again, to be deleted once
+		// transmitted
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 3, 4, 5}, seqNrsOf(inserter.transmissions))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func seqNrsOf(transmissions []*Transmission) []uint64 {
+	seqNrs := make([]uint64, len(transmissions))
+	for i, t := range transmissions {
+		seqNrs[i] = t.SeqNr
+	}
+	return seqNrs
+}
+
+func Test_Queue_HealthHysteresis(t *testing.T) {
+	t.Parallel()
+	const maxSize = 8
+
+	lggr := logger.TestLogger(t)
+	tq := NewTransmitQueue(lggr, sURL, maxSize, &mockAsyncDeleter{})
+	require.NoError(t, tq.Init([]*Transmission{}))
+
+	for _, tr := range makeSampleTransmissions(5, sURL) {
+		require.True(t, tq.Push(tr))
+	}
+	report := tq.HealthReport()
+	assert.EqualError(t, report[tq.Name()], "transmit priority queue is greater than 50% full (5/8)")
+
+	// still unhealthy until drained to 25% full
+	tq.BlockingPop()
+	tq.BlockingPop()
+	report = tq.HealthReport()
+	assert.EqualError(t, report[tq.Name()], "transmit priority queue has not yet drained below 25% full (3/8)")
+
+	tq.BlockingPop()
+	report = tq.HealthReport()
+	assert.NoError(t, report[tq.Name()])
+}
@@ -2,8 +2,11 @@ package mercurytransmitter
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"maps"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"sync"
@@ -70,6 +73,16 @@ type ReportPacker interface {
 	Pack(digest types.ConfigDigest, seqNr uint64, report ocr2types.Report, sigs []ocr2types.AttributedOnchainSignature) ([]byte, error)
 }
 
+const (
+	// serverUnhealthyAfterErrors is the number of consecutive failed
+	// transmissions after which a server is reported as unhealthy
+	serverUnhealthyAfterErrors = 10
+	// serverHealthyAfterSuccesses is the number of consecutive successful
+	// transmissions after which an unhealthy server is reported as healthy
+	// again
+	serverHealthyAfterSuccesses = 3
+)
+
 // A server handles the queue for a given mercury server
 
 type server struct {
@@ -98,12 +111,32 @@ type server struct {
 	consecutiveTransmitErrorCount   int
 	consecutiveTransmitUniqueErrors map[string]struct{}
 	consecutiveTransmitErrorMu      sync.Mutex
+	// consecutiveTransmitSuccessCount and unhealthy are also guarded by
+	// consecutiveTransmitErrorMu
+	consecutiveTransmitSuccessCount int
+	unhealthy                       bool
 }
 
 type QueueConfig interface {
 	ReaperMaxAge() commonconfig.Duration
 	TransmitQueueMaxSize() uint32
 	TransmitTimeout() commonconfig.Duration
+	TransmitQueueSpillDir() string
+	TransmitQueueSpillMaxSize() utils.FileSize
+}
+
+// spillConfig returns the spill configuration of the transmit queue of a
+// server. Each server spills to its own directory under the configured one.
+func spillConfig(cfg QueueConfig, donID uint32, serverURL string) SpillConfig {
+	dir := cfg.TransmitQueueSpillDir()
+	if dir == "" {
+		return SpillConfig{}
+	}
+	h := sha256.Sum256([]byte(serverURL))
+	return SpillConfig{
+		Dir:     filepath.Join(dir, strconv.FormatUint(uint64(donID), 10), hex.EncodeToString(h[:8])),
+		MaxSize: int64(cfg.TransmitQueueSpillMaxSize()), //nolint:gosec // file sizes are far below MaxInt64
+	}
 }
 
 func newServer(lggr logger.Logger, verboseLogging bool, cfg QueueConfig, client grpc.Client, orm ORM, serverURL string) *server {
@@ -122,7 +155,7 @@ func newServer(lggr logger.Logger, verboseLogging bool, cfg QueueConfig, client
 		cfg.TransmitTimeout().Duration(),
 		client,
 		pm,
-		NewTransmitQueue(lggr, serverURL, int(cfg.TransmitQueueMaxSize()), pm),
+		newTransmitQueue(lggr, serverURL, int(cfg.TransmitQueueMaxSize()), pm, spillConfig(cfg, pm.DonID(), serverURL), pm),
 		serverURL,
 		evm.NewReportCodecPremiumLegacy(codecLggr, pm.DonID()),
 		llo.JSONReportCodec{},
@@ -136,18 +169,36 @@ func newServer(lggr logger.Logger, verboseLogging bool, cfg QueueConfig, client
 		0,
 		make(map[string]struct{}),
 		sync.Mutex{},
+		0,
+		false,
 	}
 
 	return s
 }
 
 func (s *server) HealthReport() map[string]error {
-	report := map[string]error{}
+	report := map[string]error{s.lggr.Name(): s.health()}
 	services.CopyHealth(report, s.c.HealthReport())
 	services.CopyHealth(report, s.q.HealthReport())
 	return report
 }
 
+// health reports the server as unhealthy after serverUnhealthyAfterErrors
+// consecutive failed transmissions, and only as healthy again after
+// serverHealthyAfterSuccesses consecutive successful ones, so that a server
+// failing intermittently doesn't flap.
+func (s *server) health() error {
+	s.consecutiveTransmitErrorMu.Lock()
+	defer s.consecutiveTransmitErrorMu.Unlock()
+	if !s.unhealthy {
+		return nil
+	}
+	if s.consecutiveTransmitErrorCount > 0 {
+		return fmt.Errorf("transmissions to mercury server are failing (%d consecutive errors)", s.consecutiveTransmitErrorCount)
+	}
+	return fmt.Errorf("transmissions to mercury server are recovering (%d/%d consecutive successes)", s.consecutiveTransmitSuccessCount, serverHealthyAfterSuccesses)
+}
+
 func (s *server) transmitThreadBusyCountInc() {
 	val := s.transmitThreadBusyCount.Add(1)
 	s.transmitConcurrentTransmitGauge.Set(float64(val))
@@ -276,6 +327,10 @@ func (s *server) incConsecutiveTransmitErrorCount(errStr string) (int, []string)
 	defer s.consecutiveTransmitErrorMu.Unlock()
 	s.consecutiveTransmitErrorCount++
 	s.consecutiveTransmitUniqueErrors[errStr] = struct{}{}
+	s.consecutiveTransmitSuccessCount = 0
+	if s.consecutiveTransmitErrorCount >= serverUnhealthyAfterErrors {
+		s.unhealthy = true
+	}
 	return s.consecutiveTransmitErrorCount, slices.Sorted(maps.Keys(s.consecutiveTransmitUniqueErrors))
 }
 
@@ -283,6 +338,10 @@ func (s *server) resetConsecutiveTransmitFailures() {
 	s.consecutiveTransmitErrorMu.Lock()
 	s.consecutiveTransmitErrorCount = 0
 	s.consecutiveTransmitUniqueErrors = make(map[string]struct{})
+	s.consecutiveTransmitSuccessCount++
+	if s.consecutiveTransmitSuccessCount >= serverHealthyAfterSuccesses {
+		s.unhealthy = false
+	}
 	s.consecutiveTransmitErrorMu.Unlock()
 }
 
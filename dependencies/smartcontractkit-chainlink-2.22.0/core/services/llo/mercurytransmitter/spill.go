@@ -0,0 +1,329 @@
+package mercurytransmitter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+const (
+	spillSegmentExt = ".spill"
+	// spillSegmentMaxRecords bounds the number of transmissions in a segment,
+	// which are replayed into the transmit queue all at once
+	spillSegmentMaxRecords = 10_000
+)
+
+// spillQueue stores the transmissions overflowing a transmit queue on disk,
+// instead of dropping them, so that they can be transmitted once the server is
+// reachable again. Transmissions are appended to segment files which are
+// replayed whole, oldest first.
+//
+// If maxSize is exceeded, the oldest segments are dropped.
+//
+// WARNING: Not thread-safe, the transmit queue synchronizes access with its
+// spillMu
+type spillQueue struct {
+	lggr              logger.SugaredLogger
+	dir               string
+	maxSize           int64
+	segmentMaxRecords int
+
+	// segments are ordered oldest first, transmissions are appended to the
+	// last one while w is open
+	segments []*spillSegment
+	w        *os.File
+	nextSeq  uint64
+	size     int64
+	records  int
+}
+
+type spillSegment struct {
+	seq     uint64
+	records int
+	size    int64
+	// oldest is when the first transmission of the segment was queued
+	oldest time.Time
+}
+
+// spillRecord is the encoding of a spilled transmission, one per line
+type spillRecord struct {
+	ConfigDigest   []byte   `json:"configDigest"`
+	SeqNr          uint64   `json:"seqNr"`
+	Report         []byte   `json:"report"`
+	LifecycleStage string   `json:"lifecycleStage"`
+	ReportFormat   uint32   `json:"reportFormat"`
+	Signatures     [][]byte `json:"signatures"`
+	Signers        []uint8  `json:"signers"`
+	QueuedAt       int64    `json:"queuedAt"`
+}
+
+// openSpillQueue opens the spill queue in dir, picking up the segments left
+// over by a previous run.
+func openSpillQueue(lggr logger.Logger, dir string, maxSize int64, segmentMaxRecords int) (*spillQueue, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create spill directory: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spill directory: %w", err)
+	}
+
+	sq := &spillQueue{
+		lggr:              logger.Sugared(lggr).Named("SpillQueue"),
+		dir:               dir,
+		maxSize:           maxSize,
+		segmentMaxRecords: max(1, min(segmentMaxRecords, spillSegmentMaxRecords)),
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != spillSegmentExt {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spillSegmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		seg, err := sq.scanSegment(seq)
+		if err != nil {
+			return nil, err
+		}
+		sq.segments = append(sq.segments, seg)
+		sq.size += seg.size
+		sq.records += seg.records
+		sq.nextSeq = max(sq.nextSeq, seq+1)
+	}
+	sort.Slice(sq.segments, func(i, j int) bool { return sq.segments[i].seq < sq.segments[j].seq })
+	if sq.records > 0 {
+		sq.lggr.Infow("Found spilled transmissions from a previous run", "nTransmissions", sq.records, "nSegments", len(sq.segments))
+	}
+	return sq, nil
+}
+
+func (sq *spillQueue) path(seq uint64) string {
+	return filepath.Join(sq.dir, fmt.Sprintf("%016x%s", seq, spillSegmentExt))
+}
+
+func (sq *spillQueue) scanSegment(seq uint64) (*spillSegment, error) {
+	b, err := os.ReadFile(sq.path(seq))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spill segment: %w", err)
+	}
+	seg := &spillSegment{seq: seq, size: int64(len(b))}
+	for _, line := range bytes.Split(b, []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+		if seg.records == 0 {
+			var r spillRecord
+			if err := json.Unmarshal(line, &r); err == nil {
+				seg.oldest = time.Unix(0, r.QueuedAt)
+			}
+		}
+		seg.records++
+	}
+	return seg, nil
+}
+
+// len returns the number of spilled transmissions
+func (sq *spillQueue) len() int {
+	return sq.records
+}
+
+// oldest returns when the oldest spilled transmission was queued
+func (sq *spillQueue) oldest() (time.Time, bool) {
+	if len(sq.segments) == 0 {
+		return time.Time{}, false
+	}
+	return sq.segments[0].oldest, true
+}
+
+// write appends a transmission to the newest segment. It returns the number
+// of older transmissions that were dropped to stay within maxSize.
+func (sq *spillQueue) write(t *Transmission) (dropped int, err error) {
+	signatures := make([][]byte, len(t.Sigs))
+	signers := make([]uint8, len(t.Sigs))
+	for i, sig := range t.Sigs {
+		signatures[i] = sig.Signature
+		signers[i] = uint8(sig.Signer)
+	}
+	b, err := json.Marshal(spillRecord{
+		ConfigDigest:   t.ConfigDigest[:],
+		SeqNr:          t.SeqNr,
+		Report:         t.Report.Report,
+		LifecycleStage: string(t.Report.Info.LifeCycleStage),
+		ReportFormat:   uint32(t.Report.Info.ReportFormat),
+		Signatures:     signatures,
+		Signers:        signers,
+		QueuedAt:       t.queuedAt.UnixNano(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	b = append(b, '\n')
+
+	if sq.w == nil || sq.segments[len(sq.segments)-1].records >= sq.segmentMaxRecords {
+		if err = sq.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	if _, err = sq.w.Write(b); err != nil {
+		return 0, fmt.Errorf("failed to write spill segment: %w", err)
+	}
+	seg := sq.segments[len(sq.segments)-1]
+	if seg.records == 0 {
+		seg.oldest = t.queuedAt
+	}
+	seg.records++
+	seg.size += int64(len(b))
+	sq.records++
+	sq.size += int64(len(b))
+
+	// never drop the segment being written to
+	for sq.maxSize > 0 && sq.size > sq.maxSize && len(sq.segments) > 1 {
+		n, err := sq.dropOldest()
+		dropped += n
+		if err != nil {
+			return dropped, err
+		}
+	}
+	return dropped, nil
+}
+
+// rotate closes the segment being written to and starts a new one
+func (sq *spillQueue) rotate() error {
+	if err := sq.closeWriter(); err != nil {
+		return err
+	}
+	seq := sq.nextSeq
+	f, err := os.OpenFile(sq.path(seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create spill segment: %w", err)
+	}
+	sq.nextSeq++
+	sq.w = f
+	sq.segments = append(sq.segments, &spillSegment{seq: seq})
+	return nil
+}
+
+func (sq *spillQueue) closeWriter() error {
+	if sq.w == nil {
+		return nil
+	}
+	err := sq.w.Close()
+	sq.w = nil
+	return err
+}
+
+func (sq *spillQueue) dropOldest() (int, error) {
+	seg := sq.removeOldest()
+	sq.lggr.Criticalw("Spill queue is full; dropping oldest spilled transmissions", "nDropped", seg.records, "maxSize", sq.maxSize)
+	if err := os.Remove(sq.path(seg.seq)); err != nil {
+		return seg.records, fmt.Errorf("failed to remove spill segment: %w", err)
+	}
+	return seg.records, nil
+}
+
+func (sq *spillQueue) removeOldest() *spillSegment {
+	seg := sq.segments[0]
+	sq.segments = sq.segments[1:]
+	sq.records -= seg.records
+	sq.size -= seg.size
+	return seg
+}
+
+// readOldest returns the oldest segment and its transmissions, in the order
+// they were spilled, if it has no more than limit transmissions. The segment
+// is kept until it is removed.
+func (sq *spillQueue) readOldest(serverURL string, limit int) (*spillSegment, []*Transmission, error) {
+	if len(sq.segments) == 0 || sq.segments[0].records > limit {
+		return nil, nil, nil
+	}
+	if len(sq.segments) == 1 {
+		// reading the segment being written to, later transmissions go to a
+		// new one
+		if err := sq.closeWriter(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	seg := sq.segments[0]
+	f, err := os.Open(sq.path(seg.seq))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open spill segment: %w", err)
+	}
+	defer f.Close()
+
+	transmissions := make([]*Transmission, 0, seg.records)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<24)
+	for scanner.Scan() {
+		var r spillRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			// a partial write of the last record on crash
+			sq.lggr.Errorw("Skipping invalid spilled transmission", "segment", seg.seq, "err", err)
+			continue
+		}
+		transmissions = append(transmissions, r.transmission(serverURL))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read spill segment: %w", err)
+	}
+	return seg, transmissions, nil
+}
+
+// remove removes a segment returned by readOldest
+func (sq *spillQueue) remove(seg *spillSegment) error {
+	if len(sq.segments) == 0 || sq.segments[0] != seg {
+		// already dropped for exceeding maxSize
+		return nil
+	}
+	sq.removeOldest()
+	if err := os.Remove(sq.path(seg.seq)); err != nil {
+		return fmt.Errorf("failed to remove spill segment: %w", err)
+	}
+	return nil
+}
+
+func (sq *spillQueue) close() error {
+	return sq.closeWriter()
+}
+
+func (r spillRecord) transmission(serverURL string) *Transmission {
+	sigs := make([]ocrtypes.AttributedOnchainSignature, 0, len(r.Signatures))
+	for i := 0; i < len(r.Signatures) && i < len(r.Signers); i++ {
+		sigs = append(sigs, ocrtypes.AttributedOnchainSignature{
+			Signature: r.Signatures[i],
+			Signer:    commontypes.OracleID(r.Signers[i]),
+		})
+	}
+	var cd ocrtypes.ConfigDigest
+	copy(cd[:], r.ConfigDigest)
+	return &Transmission{
+		ServerURL:    serverURL,
+		ConfigDigest: cd,
+		SeqNr:        r.SeqNr,
+		Report: ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+			Report: r.Report,
+			Info: llotypes.ReportInfo{
+				LifeCycleStage: llotypes.LifeCycleStage(r.LifecycleStage),
+				ReportFormat:   llotypes.ReportFormat(r.ReportFormat),
+			},
+		},
+		Sigs:     sigs,
+		queuedAt: time.Unix(0, r.QueuedAt),
+	}
+}
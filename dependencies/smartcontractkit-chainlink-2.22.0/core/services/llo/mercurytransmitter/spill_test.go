@@ -0,0 +1,163 @@
+package mercurytransmitter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func Test_spillQueue(t *testing.T) {
+	t.Parallel()
+	lggr := logger.TestLogger(t)
+
+	t.Run("replays spilled transmissions oldest segment first", func(t *testing.T) {
+		sq, err := openSpillQueue(lggr, t.TempDir(), 0, 2)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, sq.close()) })
+
+		transmissions := makeQueuedTransmissions(5)
+		for _, tr := range transmissions {
+			dropped, err := sq.write(tr)
+			require.NoError(t, err)
+			assert.Zero(t, dropped)
+		}
+		assert.Equal(t, 5, sq.len())
+		oldest, ok := sq.oldest()
+		require.True(t, ok)
+		assert.Equal(t, time.Unix(0, 1), oldest)
+
+		// the oldest segment is only read if it fits
+		seg, ts, err := sq.readOldest(sURL, 1)
+		require.NoError(t, err)
+		assert.Nil(t, seg)
+		assert.Empty(t, ts)
+
+		// and kept until removed
+		seg, ts, err = sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, transmissions[0:2], ts)
+		assert.Equal(t, 5, sq.len())
+		_, ts, err = sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, transmissions[0:2], ts)
+		require.NoError(t, sq.remove(seg))
+		assert.Equal(t, 3, sq.len())
+
+		seg, ts, err = sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, transmissions[2:4], ts)
+		require.NoError(t, sq.remove(seg))
+		seg, ts, err = sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, transmissions[4:], ts)
+		require.NoError(t, sq.remove(seg))
+
+		assert.Zero(t, sq.len())
+		_, ok = sq.oldest()
+		assert.False(t, ok)
+		entries, err := os.ReadDir(sq.dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("picks up the segments of a previous run", func(t *testing.T) {
+		dir := t.TempDir()
+		sq, err := openSpillQueue(lggr, dir, 0, 2)
+		require.NoError(t, err)
+		transmissions := makeQueuedTransmissions(3)
+		for _, tr := range transmissions {
+			_, err = sq.write(tr)
+			require.NoError(t, err)
+		}
+		require.NoError(t, sq.close())
+
+		sq, err = openSpillQueue(lggr, dir, 0, 2)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, sq.close()) })
+		assert.Equal(t, 3, sq.len())
+		oldest, ok := sq.oldest()
+		require.True(t, ok)
+		assert.Equal(t, time.Unix(0, 1), oldest)
+
+		// new transmissions go to a new segment
+		tr := makeQueuedTransmissions(4)[3]
+		_, err = sq.write(tr)
+		require.NoError(t, err)
+		assert.Len(t, sq.segments, 3)
+
+		var replayed []*Transmission
+		for sq.len() > 0 {
+			seg, ts, err := sq.readOldest(sURL, 2)
+			require.NoError(t, err)
+			require.NoError(t, sq.remove(seg))
+			replayed = append(replayed, ts...)
+		}
+		assert.Equal(t, append(transmissions, tr), replayed)
+	})
+
+	t.Run("skips a partially written transmission", func(t *testing.T) {
+		dir := t.TempDir()
+		sq, err := openSpillQueue(lggr, dir, 0, 2)
+		require.NoError(t, err)
+		tr := makeQueuedTransmissions(1)[0]
+		_, err = sq.write(tr)
+		require.NoError(t, err)
+		_, err = sq.w.Write([]byte(`{"configDigest":`))
+		require.NoError(t, err)
+		require.NoError(t, sq.close())
+
+		sq, err = openSpillQueue(lggr, dir, 0, 2)
+		require.NoError(t, err)
+		_, ts, err := sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []*Transmission{tr}, ts)
+	})
+
+	t.Run("drops the oldest segments when exceeding maxSize", func(t *testing.T) {
+		dir := t.TempDir()
+		transmissions := makeQueuedTransmissions(6)
+
+		// size a segment of 2 transmissions
+		sq, err := openSpillQueue(lggr, filepath.Join(dir, "sizing"), 0, 2)
+		require.NoError(t, err)
+		for _, tr := range transmissions[:2] {
+			_, err = sq.write(tr)
+			require.NoError(t, err)
+		}
+		segmentSize := sq.size
+		require.NoError(t, sq.close())
+
+		sq, err = openSpillQueue(lggr, dir, 2*segmentSize, 2)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, sq.close()) })
+		var dropped int
+		for _, tr := range transmissions {
+			n, err := sq.write(tr)
+			require.NoError(t, err)
+			dropped += n
+		}
+		assert.Equal(t, 2, dropped)
+		assert.Equal(t, 4, sq.len())
+		assert.LessOrEqual(t, sq.size, 2*segmentSize)
+
+		_, ts, err := sq.readOldest(sURL, 2)
+		require.NoError(t, err)
+		assert.Equal(t, transmissions[2:4], ts)
+	})
+}
+
+// makeQueuedTransmissions makes sample transmissions queued one nanosecond
+// apart, the first one at 1ns.
+func makeQueuedTransmissions(n int) []*Transmission {
+	transmissions := makeSampleTransmissions(n, sURL)
+	for i, tr := range transmissions {
+		tr.queuedAt = time.Unix(0, int64(i)+1)
+	}
+	return transmissions
+}
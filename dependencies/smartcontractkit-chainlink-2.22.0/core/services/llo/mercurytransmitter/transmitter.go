@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -66,9 +67,13 @@ type Transmission struct {
 	SeqNr        uint64
 	Report       ocr3types.ReportWithInfo[llotypes.ReportInfo]
 	Sigs         []types.AttributedOnchainSignature
+
+	// queuedAt is when the transmission was first pushed onto the transmit
+	// queue; it is not persisted
+	queuedAt time.Time
 }
 
-// Hash takes sha256 hash of all fields
+// Hash takes sha256 hash of all persisted fields
 func (t Transmission) Hash() [32]byte {
 	h := sha256.New()
 	h.Write([]byte(t.ServerURL))
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/grpc"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 type mockCfg struct{}
@@ -46,6 +47,14 @@ func (m mockCfg) TransmitConcurrency() uint32 {
 	return 5
 }
 
+func (m mockCfg) TransmitQueueSpillDir() string {
+	return ""
+}
+
+func (m mockCfg) TransmitQueueSpillMaxSize() utils.FileSize {
+	return 0
+}
+
 type MockGRPCClient struct {
 	TransmitF func(ctx context.Context, in *rpc.TransmitRequest) (*rpc.TransmitResponse, error)
 }
@@ -125,6 +134,12 @@ func Test_Transmitter_Transmit(t *testing.T) {
 			require.NoError(t, err)
 
 			// ensure it was added to the queue
+			pop := func(serverURL string) *Transmission {
+				tr := mt.servers[serverURL].q.(*transmitQueue).pq.Pop().(*Transmission)
+				assert.False(t, tr.queuedAt.IsZero())
+				tr.queuedAt = time.Time{}
+				return tr
+			}
 			require.Equal(t, 1, mt.servers[sURL].q.(*transmitQueue).pq.Len())
 			assert.Equal(t, &Transmission{
 				ServerURL:    sURL,
@@ -132,7 +147,7 @@ func Test_Transmitter_Transmit(t *testing.T) {
 				SeqNr:        seqNr,
 				Report:       report,
 				Sigs:         sigs,
-			}, mt.servers[sURL].q.(*transmitQueue).pq.Pop().(*Transmission))
+			}, pop(sURL))
 			require.Equal(t, 1, mt.servers[sURL2].q.(*transmitQueue).pq.Len())
 			assert.Equal(t, &Transmission{
 				ServerURL:    sURL2,
@@ -140,7 +155,7 @@ func Test_Transmitter_Transmit(t *testing.T) {
 				SeqNr:        seqNr,
 				Report:       report,
 				Sigs:         sigs,
-			}, mt.servers[sURL2].q.(*transmitQueue).pq.Pop().(*Transmission))
+			}, pop(sURL2))
 			require.Equal(t, 1, mt.servers[sURL3].q.(*transmitQueue).pq.Len())
 			assert.Equal(t, &Transmission{
 				ServerURL:    sURL3,
@@ -148,7 +163,7 @@ func Test_Transmitter_Transmit(t *testing.T) {
 				SeqNr:        seqNr,
 				Report:       report,
 				Sigs:         sigs,
-			}, mt.servers[sURL3].q.(*transmitQueue).pq.Pop().(*Transmission))
+			}, pop(sURL3))
 		})
 	})
 }
@@ -310,3 +325,32 @@ func Test_Transmitter_runQueueLoop(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func Test_server_health(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(db, uint32(123456))
+
+	s := newServer(lggr, true, mockCfg{}, &MockGRPCClient{}, orm, sURL)
+	require.NoError(t, s.q.Init([]*Transmission{}))
+	health := func() error { return s.HealthReport()[s.lggr.Name()] }
+	require.NoError(t, health())
+
+	for i := 1; i < serverUnhealthyAfterErrors; i++ {
+		s.rateLimitedLogError(lggr, "Transmit report failed", "connection refused")
+	}
+	require.NoError(t, health())
+	s.rateLimitedLogError(lggr, "Transmit report failed", "connection refused")
+	require.EqualError(t, health(), "transmissions to mercury server are failing (10 consecutive errors)")
+
+	// a single success is not enough to be healthy again
+	s.resetConsecutiveTransmitFailures()
+	require.EqualError(t, health(), "transmissions to mercury server are recovering (1/3 consecutive successes)")
+	s.rateLimitedLogError(lggr, "Transmit report failed", "connection refused")
+	require.EqualError(t, health(), "transmissions to mercury server are failing (1 consecutive errors)")
+
+	for i := 0; i < serverHealthyAfterSuccesses; i++ {
+		s.resetConsecutiveTransmitFailures()
+	}
+	require.NoError(t, health())
+}
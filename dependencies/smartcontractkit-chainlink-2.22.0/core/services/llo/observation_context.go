@@ -54,7 +54,7 @@ func newObservationContext(l logger.Logger, r Registry, t Telemeter) *observatio
 }
 
 func (oc *observationContext) Observe(ctx context.Context, streamID streams.StreamID, opts llo.DSOpts) (val llo.StreamValue, err error) {
-	run, trrs, err := oc.run(ctx, streamID)
+	run, trrs, err := oc.run(ctx, streamID, opts)
 	observationFinishedAt := time.Now()
 	if err != nil {
 		// FIXME: This is a hack specific for V3 telemetry, future schemas should
@@ -203,7 +203,7 @@ func (e MissingStreamError) Error() string {
 	return fmt.Sprintf("no pipeline for stream: %d", e.StreamID)
 }
 
-func (oc *observationContext) run(ctx context.Context, streamID streams.StreamID) (*pipeline.Run, pipeline.TaskRunResults, error) {
+func (oc *observationContext) run(ctx context.Context, streamID streams.StreamID, opts llo.DSOpts) (*pipeline.Run, pipeline.TaskRunResults, error) {
 	p, exists := oc.r.Get(streamID)
 	if !exists {
 		return nil, nil, MissingStreamError{StreamID: streamID}
@@ -230,7 +230,19 @@ func (oc *observationContext) run(ctx context.Context, streamID streams.StreamID
 	oc.executions[p] = ex
 	oc.executionsMu.Unlock()
 
-	run, trrs, err := p.Run(ctx)
+	var run *pipeline.Run
+	var trrs pipeline.TaskRunResults
+	var err error
+	if cp, ok := p.(streams.CachingPipeline); ok {
+		// share runs with the observations of other rounds and jobs
+		var observationTimestamp time.Time
+		if opts != nil {
+			observationTimestamp = opts.ObservationTimestamp()
+		}
+		run, trrs, err = cp.RunCached(ctx, streamID, observationTimestamp)
+	} else {
+		run, trrs, err = p.Run(ctx)
+	}
 	ex.run = run
 	ex.trrs = trrs
 	ex.err = err
@@ -0,0 +1,3 @@
+package offchain
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative report.proto
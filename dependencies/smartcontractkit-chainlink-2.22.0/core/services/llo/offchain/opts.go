@@ -0,0 +1,164 @@
+package offchain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+// The report formats of the codecs in this package. chainlink-common does not
+// define them, so they are numbered well above the formats it does define, to
+// not collide with the formats added there later.
+const (
+	ReportFormatProtobuf llotypes.ReportFormat = 1<<16 + iota
+	ReportFormatCBOR
+)
+
+// The report fields that can be selected in ReportFormatOffchainOpts.
+const (
+	FieldConfigDigest                    = "configDigest"
+	FieldSeqNr                           = "seqNr"
+	FieldChannelID                       = "channelID"
+	FieldValidAfterNanoseconds           = "validAfterNanoseconds"
+	FieldObservationTimestampNanoseconds = "observationTimestampNanoseconds"
+	FieldValues                          = "values"
+)
+
+var allFields = []string{
+	FieldConfigDigest,
+	FieldSeqNr,
+	FieldChannelID,
+	FieldValidAfterNanoseconds,
+	FieldObservationTimestampNanoseconds,
+	FieldValues,
+}
+
+// ReportFormatOffchainOpts are the channel definition options of the
+// protobuf and CBOR report formats.
+type ReportFormatOffchainOpts struct {
+	// Fields selects the report fields to encode. All of them are encoded if
+	// empty. The specimen flag can't be deselected: CBOR reports always
+	// include it, and protobuf reports include it when set, since proto3
+	// leaves false values out.
+	//
+	// EXAMPLE
+	//
+	// ["observationTimestampNanoseconds", "values"]
+	Fields []string `json:"fields,omitempty"`
+	// Scale, if set, encodes decimal stream values as integers: they are
+	// multiplied by 10^Scale and truncated. Otherwise decimal values are
+	// encoded exactly.
+	Scale *int32 `json:"scale,omitempty"`
+	// StreamScales overrides Scale for individual streams.
+	//
+	// EXAMPLE
+	//
+	// {"1": 8, "2": 18}
+	StreamScales map[llotypes.StreamID]int32 `json:"streamScales,omitempty"`
+}
+
+func (r *ReportFormatOffchainOpts) Decode(opts []byte) error {
+	if len(opts) == 0 {
+		// Opts are optional
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(opts))
+	decoder.DisallowUnknownFields() // Error on unrecognized fields
+	return decoder.Decode(r)
+}
+
+func (r *ReportFormatOffchainOpts) Encode() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func (r *ReportFormatOffchainOpts) verify(cd llotypes.ChannelDefinition) error {
+	for _, field := range r.Fields {
+		if !isField(field) {
+			return fmt.Errorf("unknown field %q, expected one of %v", field, allFields)
+		}
+	}
+	for streamID := range r.StreamScales {
+		if !hasStream(cd, streamID) {
+			return fmt.Errorf("streamScales has stream %d that is not in the channel", streamID)
+		}
+	}
+	return nil
+}
+
+// selected reports whether a report field is encoded.
+func (r *ReportFormatOffchainOpts) selected(field string) bool {
+	if len(r.Fields) == 0 {
+		return true
+	}
+	for _, f := range r.Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// scale returns the scale of the decimal values of a stream, if any.
+func (r *ReportFormatOffchainOpts) scale(streamID llotypes.StreamID) (int32, bool) {
+	if s, ok := r.StreamScales[streamID]; ok {
+		return s, true
+	}
+	if r.Scale != nil {
+		return *r.Scale, true
+	}
+	return 0, false
+}
+
+func isField(field string) bool {
+	for _, f := range allFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func hasStream(cd llotypes.ChannelDefinition, streamID llotypes.StreamID) bool {
+	for _, s := range cd.Streams {
+		if s.StreamID == streamID {
+			return true
+		}
+	}
+	return false
+}
+
+// scaleDecimal returns d as an integer multiplied by 10^scale and truncated,
+// or d unchanged if there is no scale.
+func scaleDecimal(d decimal.Decimal, scale int32, scaled bool) decimal.Decimal {
+	if !scaled {
+		return d
+	}
+	return d.Shift(scale).Truncate(0)
+}
+
+// streamValueDecimals returns the decimals of a stream value, to encode them
+// in the same order as their fields.
+func streamValueDecimals(sv llo.StreamValue) (dec *decimal.Decimal, quote *[3]decimal.Decimal, err error) {
+	switch sv := sv.(type) {
+	case nil:
+		return nil, nil, nil
+	case *llo.Decimal:
+		if sv == nil {
+			return nil, nil, nil
+		}
+		d := sv.Decimal()
+		return &d, nil, nil
+	case *llo.Quote:
+		if sv == nil {
+			return nil, nil, nil
+		}
+		return nil, &[3]decimal.Decimal{sv.Bid, sv.Benchmark, sv.Ask}, nil
+	default:
+		return nil, nil, fmt.Errorf("unhandled type; supported types are: *llo.Decimal, *llo.Quote; got: %T", sv)
+	}
+}
@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.0
+// source: report.proto
+
+package offchain
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Report is an LLO report encoded for off-chain consumers. The fields that are
+// not selected in the options of the channel are left unset.
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigDigest                    []byte         `protobuf:"bytes,1,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	SeqNr                           uint64         `protobuf:"varint,2,opt,name=seqNr,proto3" json:"seqNr,omitempty"`
+	ChannelID                       uint32         `protobuf:"varint,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	ValidAfterNanoseconds           uint64         `protobuf:"varint,4,opt,name=validAfterNanoseconds,proto3" json:"validAfterNanoseconds,omitempty"`
+	ObservationTimestampNanoseconds uint64         `protobuf:"varint,5,opt,name=observationTimestampNanoseconds,proto3" json:"observationTimestampNanoseconds,omitempty"`
+	Values                          []*StreamValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	Specimen                        bool           `protobuf:"varint,7,opt,name=specimen,proto3" json:"specimen,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Report) GetConfigDigest() []byte {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return nil
+}
+
+func (x *Report) GetSeqNr() uint64 {
+	if x != nil {
+		return x.SeqNr
+	}
+	return 0
+}
+
+func (x *Report) GetChannelID() uint32 {
+	if x != nil {
+		return x.ChannelID
+	}
+	return 0
+}
+
+func (x *Report) GetValidAfterNanoseconds() uint64 {
+	if x != nil {
+		return x.ValidAfterNanoseconds
+	}
+	return 0
+}
+
+func (x *Report) GetObservationTimestampNanoseconds() uint64 {
+	if x != nil {
+		return x.ObservationTimestampNanoseconds
+	}
+	return 0
+}
+
+func (x *Report) GetValues() []*StreamValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Report) GetSpecimen() bool {
+	if x != nil {
+		return x.Specimen
+	}
+	return false
+}
+
+// StreamValue is the value of a stream in a report. No value is set if there
+// was no observation for the stream.
+type StreamValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID uint32 `protobuf:"varint,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	// Types that are assignable to Value:
+	//	*StreamValue_Decimal
+	//	*StreamValue_Quote
+	Value isStreamValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *StreamValue) Reset() {
+	*x = StreamValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamValue) ProtoMessage() {}
+
+func (x *StreamValue) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamValue.ProtoReflect.Descriptor instead.
+func (*StreamValue) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamValue) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (m *StreamValue) GetValue() isStreamValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *StreamValue) GetDecimal() *Decimal {
+	if x, ok := x.GetValue().(*StreamValue_Decimal); ok {
+		return x.Decimal
+	}
+	return nil
+}
+
+func (x *StreamValue) GetQuote() *Quote {
+	if x, ok := x.GetValue().(*StreamValue_Quote); ok {
+		return x.Quote
+	}
+	return nil
+}
+
+type isStreamValue_Value interface {
+	isStreamValue_Value()
+}
+
+type StreamValue_Decimal struct {
+	Decimal *Decimal `protobuf:"bytes,2,opt,name=decimal,proto3,oneof"`
+}
+
+type StreamValue_Quote struct {
+	Quote *Quote `protobuf:"bytes,3,opt,name=quote,proto3,oneof"`
+}
+
+func (*StreamValue_Decimal) isStreamValue_Value() {}
+
+func (*StreamValue_Quote) isStreamValue_Value() {}
+
+// Decimal is (-1)^negative * coefficient * 10^exponent, where coefficient is
+// a big-endian unsigned integer.
+type Decimal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coefficient []byte `protobuf:"bytes,1,opt,name=coefficient,proto3" json:"coefficient,omitempty"`
+	Exponent    int32  `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Negative    bool   `protobuf:"varint,3,opt,name=negative,proto3" json:"negative,omitempty"`
+}
+
+func (x *Decimal) Reset() {
+	*x = Decimal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Decimal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Decimal) ProtoMessage() {}
+
+func (x *Decimal) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Decimal.ProtoReflect.Descriptor instead.
+func (*Decimal) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Decimal) GetCoefficient() []byte {
+	if x != nil {
+		return x.Coefficient
+	}
+	return nil
+}
+
+func (x *Decimal) GetExponent() int32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *Decimal) GetNegative() bool {
+	if x != nil {
+		return x.Negative
+	}
+	return false
+}
+
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bid       *Decimal `protobuf:"bytes,1,opt,name=bid,proto3" json:"bid,omitempty"`
+	Benchmark *Decimal `protobuf:"bytes,2,opt,name=benchmark,proto3" json:"benchmark,omitempty"`
+	Ask       *Decimal `protobuf:"bytes,3,opt,name=ask,proto3" json:"ask,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Quote) GetBid() *Decimal {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *Quote) GetBenchmark() *Decimal {
+	if x != nil {
+		return x.Benchmark
+	}
+	return nil
+}
+
+func (x *Quote) GetAsk() *Decimal {
+	if x != nil {
+		return x.Ask
+	}
+	return nil
+}
+
+var File_report_proto protoreflect.FileDescriptor
+
+var file_report_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xab, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x15, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x48, 0x0a, 0x1f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1f, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x66,
+	0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x6d, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x6d, 0x65, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x63, 0x0a, 0x07, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x66, 0x66,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x09, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72,
+	0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x6c, 0x6f, 0x2f, 0x6f, 0x66, 0x66, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_report_proto_rawDescOnce sync.Once
+	file_report_proto_rawDescData = file_report_proto_rawDesc
+)
+
+func file_report_proto_rawDescGZIP() []byte {
+	file_report_proto_rawDescOnce.Do(func() {
+		file_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_report_proto_rawDescData)
+	})
+	return file_report_proto_rawDescData
+}
+
+var file_report_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_report_proto_goTypes = []any{
+	(*Report)(nil),      // 0: offchain.Report
+	(*StreamValue)(nil), // 1: offchain.StreamValue
+	(*Decimal)(nil),     // 2: offchain.Decimal
+	(*Quote)(nil),       // 3: offchain.Quote
+}
+var file_report_proto_depIdxs = []int32{
+	1, // 0: offchain.Report.values:type_name -> offchain.StreamValue
+	2, // 1: offchain.StreamValue.decimal:type_name -> offchain.Decimal
+	3, // 2: offchain.StreamValue.quote:type_name -> offchain.Quote
+	2, // 3: offchain.Quote.bid:type_name -> offchain.Decimal
+	2, // 4: offchain.Quote.benchmark:type_name -> offchain.Decimal
+	2, // 5: offchain.Quote.ask:type_name -> offchain.Decimal
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_report_proto_init() }
+func file_report_proto_init() {
+	if File_report_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_report_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Report); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Decimal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Quote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_report_proto_msgTypes[1].OneofWrappers = []any{
+		(*StreamValue_Decimal)(nil),
+		(*StreamValue_Quote)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_report_proto_goTypes,
+		DependencyIndexes: file_report_proto_depIdxs,
+		MessageInfos:      file_report_proto_msgTypes,
+	}.Build()
+	File_report_proto = out.File
+	file_report_proto_rawDesc = nil
+	file_report_proto_goTypes = nil
+	file_report_proto_depIdxs = nil
+}
@@ -0,0 +1,200 @@
+package offchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+var _ llo.ReportCodec = ReportCodecCBOR{}
+
+// cborDecimalFractionTag is the CBOR tag of decimal fractions (RFC 8949
+// section 3.4.4).
+const cborDecimalFractionTag = 4
+
+var cborEncMode, cborDecMode = mustCBORModes()
+
+func mustCBORModes() (cbor.EncMode, cbor.DecMode) {
+	tags := cbor.NewTagSet()
+	err := tags.Add(cbor.TagOptions{EncTag: cbor.EncTagRequired, DecTag: cbor.DecTagRequired}, reflect.TypeOf(cborDecimal{}), cborDecimalFractionTag)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register CBOR decimal fraction tag: %s", err))
+	}
+	// Core Deterministic Encoding (RFC 8949 section 4.2.1), so that a report is
+	// always encoded to the same bytes
+	em, err := cbor.CoreDetEncOptions().EncModeWithTags(tags)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create CBOR encoding mode: %s", err))
+	}
+	dm, err := cbor.DecOptions{}.DecModeWithTags(tags)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create CBOR decoding mode: %s", err))
+	}
+	return em, dm
+}
+
+// cborReport is the CBOR encoding of a report. Its map keys are the field
+// numbers of the Report protobuf message.
+type cborReport struct {
+	ConfigDigest                    []byte            `cbor:"1,keyasint,omitempty"`
+	SeqNr                           uint64            `cbor:"2,keyasint,omitempty"`
+	ChannelID                       uint32            `cbor:"3,keyasint,omitempty"`
+	ValidAfterNanoseconds           uint64            `cbor:"4,keyasint,omitempty"`
+	ObservationTimestampNanoseconds uint64            `cbor:"5,keyasint,omitempty"`
+	Values                          []cborStreamValue `cbor:"6,keyasint,omitempty"`
+	Specimen                        bool              `cbor:"7,keyasint"`
+}
+
+// cborStreamValue has neither Decimal nor Quote set if there was no
+// observation for the stream.
+type cborStreamValue struct {
+	StreamID uint32       `cbor:"1,keyasint"`
+	Decimal  *cborDecimal `cbor:"2,keyasint,omitempty"`
+	Quote    *cborQuote   `cbor:"3,keyasint,omitempty"`
+}
+
+type cborQuote struct {
+	Bid       cborDecimal `cbor:"1,keyasint"`
+	Benchmark cborDecimal `cbor:"2,keyasint"`
+	Ask       cborDecimal `cbor:"3,keyasint"`
+}
+
+// cborDecimal is a decimal fraction, mantissa * 10^exponent, encoded as the
+// array [exponent, mantissa].
+type cborDecimal struct {
+	_        struct{} `cbor:",toarray"`
+	Exponent int64
+	Mantissa big.Int
+}
+
+// ReportCodecCBOR encodes reports in deterministic CBOR, for off-chain
+// consumers that want compact reports without a schema compiler.
+type ReportCodecCBOR struct {
+	logger.Logger
+}
+
+func NewReportCodecCBOR(lggr logger.Logger) ReportCodecCBOR {
+	return ReportCodecCBOR{logger.Sugared(lggr).Named("ReportCodecCBOR")}
+}
+
+func (r ReportCodecCBOR) Encode(_ context.Context, report llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	opts := ReportFormatOffchainOpts{}
+	if err := (&opts).Decode(cd.Opts); err != nil {
+		return nil, fmt.Errorf("failed to decode opts; got: '%s'; %w", cd.Opts, err)
+	}
+
+	c := cborReport{Specimen: report.Specimen}
+	if opts.selected(FieldConfigDigest) {
+		c.ConfigDigest = report.ConfigDigest[:]
+	}
+	if opts.selected(FieldSeqNr) {
+		c.SeqNr = report.SeqNr
+	}
+	if opts.selected(FieldChannelID) {
+		c.ChannelID = report.ChannelID
+	}
+	if opts.selected(FieldValidAfterNanoseconds) {
+		c.ValidAfterNanoseconds = report.ValidAfterNanoseconds
+	}
+	if opts.selected(FieldObservationTimestampNanoseconds) {
+		c.ObservationTimestampNanoseconds = report.ObservationTimestampNanoseconds
+	}
+	if opts.selected(FieldValues) {
+		if len(cd.Streams) != len(report.Values) {
+			// Invariant violation
+			return nil, fmt.Errorf("CBOR report expected %d streams, got %d", len(cd.Streams), len(report.Values))
+		}
+		c.Values = make([]cborStreamValue, len(report.Values))
+		for i, sv := range report.Values {
+			streamID := cd.Streams[i].StreamID
+			dec, quote, err := streamValueDecimals(sv)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode stream value at index %d; %w", i, err)
+			}
+			scale, scaled := opts.scale(streamID)
+			v := cborStreamValue{StreamID: streamID}
+			switch {
+			case dec != nil:
+				d := toCBORDecimal(scaleDecimal(*dec, scale, scaled))
+				v.Decimal = &d
+			case quote != nil:
+				v.Quote = &cborQuote{
+					Bid:       toCBORDecimal(scaleDecimal(quote[0], scale, scaled)),
+					Benchmark: toCBORDecimal(scaleDecimal(quote[1], scale, scaled)),
+					Ask:       toCBORDecimal(scaleDecimal(quote[2], scale, scaled)),
+				}
+			}
+			c.Values[i] = v
+		}
+	}
+
+	b, err := cborEncMode.Marshal(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CBOR report: %w", err)
+	}
+	return b, nil
+}
+
+func (r ReportCodecCBOR) Verify(_ context.Context, cd llotypes.ChannelDefinition) error {
+	opts := new(ReportFormatOffchainOpts)
+	if err := opts.Decode(cd.Opts); err != nil {
+		return fmt.Errorf("invalid Opts, got: %q; %w", cd.Opts, err)
+	}
+	return opts.verify(cd)
+}
+
+// Decode decodes a report encoded by Encode. The fields that were not selected
+// are left zero, and decimal values that were scaled are returned scaled.
+func (r ReportCodecCBOR) Decode(b []byte) (llo.Report, error) {
+	var c cborReport
+	if err := cborDecMode.Unmarshal(b, &c); err != nil {
+		return llo.Report{}, fmt.Errorf("failed to unmarshal CBOR report: %w", err)
+	}
+
+	report := llo.Report{
+		SeqNr:                           c.SeqNr,
+		ChannelID:                       c.ChannelID,
+		ValidAfterNanoseconds:           c.ValidAfterNanoseconds,
+		ObservationTimestampNanoseconds: c.ObservationTimestampNanoseconds,
+		Specimen:                        c.Specimen,
+	}
+	if len(c.ConfigDigest) > 0 {
+		configDigest, err := ocr2types.BytesToConfigDigest(c.ConfigDigest)
+		if err != nil {
+			return llo.Report{}, fmt.Errorf("invalid config digest: %w", err)
+		}
+		report.ConfigDigest = configDigest
+	}
+	for _, v := range c.Values {
+		switch {
+		case v.Decimal != nil:
+			report.Values = append(report.Values, llo.ToDecimal(fromCBORDecimal(*v.Decimal)))
+		case v.Quote != nil:
+			report.Values = append(report.Values, &llo.Quote{
+				Bid:       fromCBORDecimal(v.Quote.Bid),
+				Benchmark: fromCBORDecimal(v.Quote.Benchmark),
+				Ask:       fromCBORDecimal(v.Quote.Ask),
+			})
+		default:
+			report.Values = append(report.Values, nil)
+		}
+	}
+	return report, nil
+}
+
+func toCBORDecimal(d decimal.Decimal) cborDecimal {
+	return cborDecimal{Exponent: int64(d.Exponent()), Mantissa: *d.Coefficient()}
+}
+
+func fromCBORDecimal(d cborDecimal) decimal.Decimal {
+	return decimal.NewFromBigInt(&d.Mantissa, int32(d.Exponent)) //nolint:gosec // exponents are encoded from int32
+}
@@ -0,0 +1,102 @@
+package offchain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+func TestReportCodecCBOR_Encode(t *testing.T) {
+	ctx := tests.Context(t)
+	rc := NewReportCodecCBOR(logger.TestLogger(t))
+
+	t.Run("round-trips all fields", func(t *testing.T) {
+		report := newValidOffchainReport()
+
+		encoded, err := rc.Encode(ctx, report, newOffchainChannelDefinition(""))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assertReportsEqual(t, report, decoded)
+	})
+
+	t.Run("golden vector", func(t *testing.T) {
+		encoded, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(""))
+		require.NoError(t, err)
+
+		assert.Equal(t, "a7015820010203000000000000000000000000000000000000000000000000000000000002182003181f041b0000000684ee1800051b00000007ea8ed4000683a2010102c482231a0165ec15a2010203a301c482200f02c4822118af03c4820021a1010307f4", hex.EncodeToString(encoded))
+	})
+
+	t.Run("encodes large decimals as bignums", func(t *testing.T) {
+		report := llo.Report{Values: []llo.StreamValue{llo.ToDecimal(decimal.RequireFromString("123456789012345678901234567890.5")), nil, nil}}
+
+		encoded, err := rc.Encode(ctx, report, newOffchainChannelDefinition(`{"fields":["values"]}`))
+		require.NoError(t, err)
+		assert.Equal(t, "a20683a2010102c48220c24d0f951a9fa3a286c94f0e766c39a10102a1010307f4", hex.EncodeToString(encoded))
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assertReportsEqual(t, report, decoded)
+	})
+
+	t.Run("encodes only the selected fields and scales decimals", func(t *testing.T) {
+		encoded, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(`{"fields":["seqNr","values"],"scale":2,"streamScales":{"2":0}}`))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assertReportsEqual(t, llo.Report{SeqNr: 32, Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.NewFromInt(234567)),
+			&llo.Quote{Bid: decimal.NewFromInt(1), Benchmark: decimal.NewFromInt(1), Ask: decimal.NewFromInt(-2)},
+			nil,
+		}}, decoded)
+	})
+
+	t.Run("always encodes the specimen flag", func(t *testing.T) {
+		report := newValidOffchainReport()
+
+		encoded, err := rc.Encode(ctx, report, newOffchainChannelDefinition(`{"fields":["channelID"]}`))
+		require.NoError(t, err)
+		assert.Equal(t, "a203181f07f4", hex.EncodeToString(encoded))
+
+		report.Specimen = true
+		encoded, err = rc.Encode(ctx, report, newOffchainChannelDefinition(`{"fields":["channelID"]}`))
+		require.NoError(t, err)
+		assert.Equal(t, "a203181f07f5", hex.EncodeToString(encoded))
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, llo.Report{ChannelID: 31, Specimen: true}, decoded)
+	})
+
+	t.Run("errors on stream count mismatch", func(t *testing.T) {
+		report := newValidOffchainReport()
+		report.Values = report.Values[:2]
+
+		_, err := rc.Encode(ctx, report, newOffchainChannelDefinition(""))
+		require.EqualError(t, err, "CBOR report expected 3 streams, got 2")
+	})
+
+	t.Run("Decode errors on invalid report", func(t *testing.T) {
+		_, err := rc.Decode([]byte{0xa1, 0x06})
+		require.Error(t, err)
+	})
+}
+
+func TestReportCodecCBOR_Verify(t *testing.T) {
+	ctx := tests.Context(t)
+	rc := NewReportCodecCBOR(logger.TestLogger(t))
+
+	require.NoError(t, rc.Verify(ctx, newOffchainChannelDefinition(`{"fields":["values"],"scale":8}`)))
+	require.EqualError(t, rc.Verify(ctx, newOffchainChannelDefinition(`{"fields":["foo"]}`)),
+		`unknown field "foo", expected one of [configDigest seqNr channelID validAfterNanoseconds observationTimestampNanoseconds values]`)
+}
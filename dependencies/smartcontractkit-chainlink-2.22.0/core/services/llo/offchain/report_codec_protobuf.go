@@ -0,0 +1,149 @@
+package offchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+var _ llo.ReportCodec = ReportCodecProtobuf{}
+
+// ReportCodecProtobuf encodes reports as the Report protobuf message, for
+// off-chain consumers that want compact reports with a schema that can evolve.
+type ReportCodecProtobuf struct {
+	logger.Logger
+}
+
+func NewReportCodecProtobuf(lggr logger.Logger) ReportCodecProtobuf {
+	return ReportCodecProtobuf{logger.Sugared(lggr).Named("ReportCodecProtobuf")}
+}
+
+func (r ReportCodecProtobuf) Encode(_ context.Context, report llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	opts := ReportFormatOffchainOpts{}
+	if err := (&opts).Decode(cd.Opts); err != nil {
+		return nil, fmt.Errorf("failed to decode opts; got: '%s'; %w", cd.Opts, err)
+	}
+
+	p := &Report{Specimen: report.Specimen}
+	if opts.selected(FieldConfigDigest) {
+		p.ConfigDigest = report.ConfigDigest[:]
+	}
+	if opts.selected(FieldSeqNr) {
+		p.SeqNr = report.SeqNr
+	}
+	if opts.selected(FieldChannelID) {
+		p.ChannelID = report.ChannelID
+	}
+	if opts.selected(FieldValidAfterNanoseconds) {
+		p.ValidAfterNanoseconds = report.ValidAfterNanoseconds
+	}
+	if opts.selected(FieldObservationTimestampNanoseconds) {
+		p.ObservationTimestampNanoseconds = report.ObservationTimestampNanoseconds
+	}
+	if opts.selected(FieldValues) {
+		if len(cd.Streams) != len(report.Values) {
+			// Invariant violation
+			return nil, fmt.Errorf("protobuf report expected %d streams, got %d", len(cd.Streams), len(report.Values))
+		}
+		p.Values = make([]*StreamValue, len(report.Values))
+		for i, sv := range report.Values {
+			streamID := cd.Streams[i].StreamID
+			dec, quote, err := streamValueDecimals(sv)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode stream value at index %d; %w", i, err)
+			}
+			scale, scaled := opts.scale(streamID)
+			v := &StreamValue{StreamID: streamID}
+			switch {
+			case dec != nil:
+				v.Value = &StreamValue_Decimal{Decimal: toProtoDecimal(scaleDecimal(*dec, scale, scaled))}
+			case quote != nil:
+				v.Value = &StreamValue_Quote{Quote: &Quote{
+					Bid:       toProtoDecimal(scaleDecimal(quote[0], scale, scaled)),
+					Benchmark: toProtoDecimal(scaleDecimal(quote[1], scale, scaled)),
+					Ask:       toProtoDecimal(scaleDecimal(quote[2], scale, scaled)),
+				}}
+			}
+			p.Values[i] = v
+		}
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protobuf report: %w", err)
+	}
+	return b, nil
+}
+
+func (r ReportCodecProtobuf) Verify(_ context.Context, cd llotypes.ChannelDefinition) error {
+	opts := new(ReportFormatOffchainOpts)
+	if err := opts.Decode(cd.Opts); err != nil {
+		return fmt.Errorf("invalid Opts, got: %q; %w", cd.Opts, err)
+	}
+	return opts.verify(cd)
+}
+
+// Decode decodes a report encoded by Encode. The fields that were not selected
+// are left zero, and decimal values that were scaled are returned scaled.
+func (r ReportCodecProtobuf) Decode(b []byte) (llo.Report, error) {
+	p := &Report{}
+	if err := proto.Unmarshal(b, p); err != nil {
+		return llo.Report{}, fmt.Errorf("failed to unmarshal protobuf report: %w", err)
+	}
+
+	report := llo.Report{
+		SeqNr:                           p.SeqNr,
+		ChannelID:                       p.ChannelID,
+		ValidAfterNanoseconds:           p.ValidAfterNanoseconds,
+		ObservationTimestampNanoseconds: p.ObservationTimestampNanoseconds,
+		Specimen:                        p.Specimen,
+	}
+	if len(p.ConfigDigest) > 0 {
+		configDigest, err := ocr2types.BytesToConfigDigest(p.ConfigDigest)
+		if err != nil {
+			return llo.Report{}, fmt.Errorf("invalid config digest: %w", err)
+		}
+		report.ConfigDigest = configDigest
+	}
+	for _, v := range p.Values {
+		switch {
+		case v.GetDecimal() != nil:
+			report.Values = append(report.Values, llo.ToDecimal(fromProtoDecimal(v.GetDecimal())))
+		case v.GetQuote() != nil:
+			q := v.GetQuote()
+			report.Values = append(report.Values, &llo.Quote{
+				Bid:       fromProtoDecimal(q.GetBid()),
+				Benchmark: fromProtoDecimal(q.GetBenchmark()),
+				Ask:       fromProtoDecimal(q.GetAsk()),
+			})
+		default:
+			report.Values = append(report.Values, nil)
+		}
+	}
+	return report, nil
+}
+
+func toProtoDecimal(d decimal.Decimal) *Decimal {
+	coefficient := d.Coefficient()
+	return &Decimal{
+		Coefficient: new(big.Int).Abs(coefficient).Bytes(),
+		Exponent:    d.Exponent(),
+		Negative:    coefficient.Sign() < 0,
+	}
+}
+
+func fromProtoDecimal(d *Decimal) decimal.Decimal {
+	coefficient := new(big.Int).SetBytes(d.GetCoefficient())
+	if d.GetNegative() {
+		coefficient.Neg(coefficient)
+	}
+	return decimal.NewFromBigInt(coefficient, d.GetExponent())
+}
@@ -0,0 +1,163 @@
+package offchain
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+)
+
+func newValidOffchainReport() llo.Report {
+	return llo.Report{
+		ConfigDigest:                    types.ConfigDigest{1, 2, 3},
+		SeqNr:                           32,
+		ChannelID:                       llotypes.ChannelID(31),
+		ValidAfterNanoseconds:           28 * 1e9,
+		ObservationTimestampNanoseconds: 34 * 1e9,
+		Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.RequireFromString("2345.6789")),
+			&llo.Quote{Bid: decimal.RequireFromString("1.5"), Benchmark: decimal.RequireFromString("1.75"), Ask: decimal.RequireFromString("-2")},
+			nil,
+		},
+	}
+}
+
+func newOffchainChannelDefinition(opts string) llotypes.ChannelDefinition {
+	return llotypes.ChannelDefinition{
+		Streams: []llotypes.Stream{{StreamID: 1}, {StreamID: 2}, {StreamID: 3}},
+		Opts:    llotypes.ChannelOpts(opts),
+	}
+}
+
+// assertReportsEqual compares reports by the text of their values, since
+// equal decimals may have different representations
+func assertReportsEqual(t *testing.T, expected, actual llo.Report) {
+	t.Helper()
+	expectedValues, actualValues := expected.Values, actual.Values
+	expected.Values, actual.Values = nil, nil
+	assert.Equal(t, expected, actual)
+
+	require.Len(t, actualValues, len(expectedValues))
+	for i := range expectedValues {
+		if expectedValues[i] == nil {
+			assert.Nil(t, actualValues[i])
+			continue
+		}
+		require.NotNil(t, actualValues[i])
+		e, err := expectedValues[i].MarshalText()
+		require.NoError(t, err)
+		a, err := actualValues[i].MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, string(e), string(a), "value %d", i)
+	}
+}
+
+func TestReportCodecProtobuf_Encode(t *testing.T) {
+	ctx := tests.Context(t)
+	rc := NewReportCodecProtobuf(logger.TestLogger(t))
+
+	t.Run("round-trips all fields", func(t *testing.T) {
+		report := newValidOffchainReport()
+
+		encoded, err := rc.Encode(ctx, report, newOffchainChannelDefinition(""))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assertReportsEqual(t, report, decoded)
+	})
+
+	t.Run("golden vector", func(t *testing.T) {
+		encoded, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(""))
+		require.NoError(t, err)
+
+		assert.Equal(t, "0a2001020300000000000000000000000000000000000000000000000000000000001020181f2080b0b8a7682880a8bbd47e3215080112110a040165ec1510fcffffffffffffffff01322b08021a270a0e0a010f10ffffffffffffffffff01120e0a01af10feffffffffffffffff011a050a0102180132020803", hex.EncodeToString(encoded))
+	})
+
+	t.Run("encodes only the selected fields", func(t *testing.T) {
+		encoded, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(`{"fields":["seqNr","observationTimestampNanoseconds"]}`))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, llo.Report{SeqNr: 32, ObservationTimestampNanoseconds: 34 * 1e9}, decoded)
+	})
+
+	t.Run("scales decimals", func(t *testing.T) {
+		encoded, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(`{"fields":["values"],"scale":2,"streamScales":{"2":0}}`))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assertReportsEqual(t, llo.Report{Values: []llo.StreamValue{
+			llo.ToDecimal(decimal.NewFromInt(234567)),
+			&llo.Quote{Bid: decimal.NewFromInt(1), Benchmark: decimal.NewFromInt(1), Ask: decimal.NewFromInt(-2)},
+			nil,
+		}}, decoded)
+	})
+
+	t.Run("encodes specimen reports", func(t *testing.T) {
+		report := newValidOffchainReport()
+		report.Specimen = true
+
+		encoded, err := rc.Encode(ctx, report, newOffchainChannelDefinition(`{"fields":["channelID"]}`))
+		require.NoError(t, err)
+
+		decoded, err := rc.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, llo.Report{ChannelID: 31, Specimen: true}, decoded)
+	})
+
+	t.Run("errors on stream count mismatch", func(t *testing.T) {
+		report := newValidOffchainReport()
+		report.Values = report.Values[:2]
+
+		_, err := rc.Encode(ctx, report, newOffchainChannelDefinition(""))
+		require.EqualError(t, err, "protobuf report expected 3 streams, got 2")
+	})
+
+	t.Run("errors on invalid opts", func(t *testing.T) {
+		_, err := rc.Encode(ctx, newValidOffchainReport(), newOffchainChannelDefinition(`{"foo":"bar"}`))
+		require.EqualError(t, err, `failed to decode opts; got: '{"foo":"bar"}'; json: unknown field "foo"`)
+	})
+
+	t.Run("Decode errors on invalid report", func(t *testing.T) {
+		_, err := rc.Decode([]byte{0x0a, 0x02, 0x08})
+		require.Error(t, err)
+	})
+}
+
+func TestReportCodecProtobuf_Verify(t *testing.T) {
+	ctx := tests.Context(t)
+	rc := NewReportCodecProtobuf(logger.TestLogger(t))
+
+	t.Run("without opts", func(t *testing.T) {
+		require.NoError(t, rc.Verify(ctx, newOffchainChannelDefinition("")))
+	})
+	t.Run("unrecognized fields in opts", func(t *testing.T) {
+		err := rc.Verify(ctx, newOffchainChannelDefinition(`{"foo":"bar"}`))
+		require.EqualError(t, err, `invalid Opts, got: "{\"foo\":\"bar\"}"; json: unknown field "foo"`)
+	})
+	t.Run("unknown report field", func(t *testing.T) {
+		err := rc.Verify(ctx, newOffchainChannelDefinition(`{"fields":["seqNr","foo"]}`))
+		require.EqualError(t, err, `unknown field "foo", expected one of [configDigest seqNr channelID validAfterNanoseconds observationTimestampNanoseconds values]`)
+	})
+	t.Run("scale of a stream that is not in the channel", func(t *testing.T) {
+		err := rc.Verify(ctx, newOffchainChannelDefinition(`{"streamScales":{"4":8}}`))
+		require.EqualError(t, err, "streamScales has stream 4 that is not in the channel")
+	})
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, rc.Verify(ctx, newOffchainChannelDefinition(`{"fields":["values"],"scale":8,"streamScales":{"1":18}}`)))
+	})
+}
@@ -1,12 +1,16 @@
 package llo
 
 import (
+	"context"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
 	"github.com/smartcontractkit/chainlink-data-streams/llo"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/cre"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/offchain"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/sink"
 )
 
 // NOTE: All supported codecs must be specified here
@@ -17,6 +21,36 @@ func NewReportCodecs(lggr logger.Logger, donID uint32) map[llotypes.ReportFormat
 	codecs[llotypes.ReportFormatEVMPremiumLegacy] = evm.NewReportCodecPremiumLegacy(lggr, donID)
 	codecs[llotypes.ReportFormatEVMABIEncodeUnpacked] = evm.NewReportCodecEVMABIEncodeUnpacked(lggr, donID)
 	codecs[llotypes.ReportFormatCapabilityTrigger] = cre.NewReportCodecCapabilityTrigger(lggr, donID)
+	codecs[offchain.ReportFormatProtobuf] = offchain.NewReportCodecProtobuf(lggr)
+	codecs[offchain.ReportFormatCBOR] = offchain.NewReportCodecCBOR(lggr)
+
+	return codecs
+}
+
+// reportChannelsTransmitter is implemented by transmitters whose sinks need the
+// channel of the reports they transmit.
+type reportChannelsTransmitter interface {
+	ReportChannels() *sink.ReportChannels
+}
 
+// recordReportChannels wraps codecs to record the channel of each report they
+// encode in channels.
+func recordReportChannels(codecs map[llotypes.ReportFormat]llo.ReportCodec, channels *sink.ReportChannels) map[llotypes.ReportFormat]llo.ReportCodec {
+	for format, codec := range codecs {
+		codecs[format] = channelRecordingCodec{codec, channels}
+	}
 	return codecs
 }
+
+type channelRecordingCodec struct {
+	llo.ReportCodec
+	channels *sink.ReportChannels
+}
+
+func (c channelRecordingCodec) Encode(ctx context.Context, r llo.Report, cd llotypes.ChannelDefinition) ([]byte, error) {
+	b, err := c.ReportCodec.Encode(ctx, r, cd)
+	if err == nil {
+		c.channels.Record(b, r.ChannelID)
+	}
+	return b, err
+}
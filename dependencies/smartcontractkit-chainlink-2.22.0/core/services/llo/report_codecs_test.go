@@ -4,9 +4,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/offchain"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/sink"
 )
 
 func Test_NewReportCodecs(t *testing.T) {
@@ -14,4 +20,18 @@ func Test_NewReportCodecs(t *testing.T) {
 
 	assert.Contains(t, c, llotypes.ReportFormatJSON, "expected JSON to be supported")
 	assert.Contains(t, c, llotypes.ReportFormatEVMPremiumLegacy, "expected EVMPremiumLegacy to be supported")
+	assert.Contains(t, c, offchain.ReportFormatProtobuf, "expected Protobuf to be supported")
+	assert.Contains(t, c, offchain.ReportFormatCBOR, "expected CBOR to be supported")
+}
+
+func Test_recordReportChannels(t *testing.T) {
+	channels := sink.NewReportChannels()
+	c := recordReportChannels(NewReportCodecs(logger.TestLogger(t), 1), channels)
+
+	b, err := c[llotypes.ReportFormatJSON].Encode(testutils.Context(t), llo.Report{ChannelID: 7}, llotypes.ChannelDefinition{})
+	require.NoError(t, err)
+
+	channelID, ok := channels.ChannelID(b)
+	require.True(t, ok)
+	assert.Equal(t, llotypes.ChannelID(7), channelID)
 }
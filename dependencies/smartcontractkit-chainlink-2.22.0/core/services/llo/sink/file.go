@@ -0,0 +1,112 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+const defaultFileMaxSizeMB = 100
+
+// FileTransmitterConfig configures a transmitter archiving the transmitted
+// reports to rolling files, one protojson encoded AttestedReport per line.
+type FileTransmitterConfig struct {
+	Logger         logger.Logger   `json:"-"`
+	DonID          uint32          `json:"-"`
+	ReportChannels *ReportChannels `json:"-"`
+
+	// Filename is the file reports are written to. Rolled files are kept
+	// next to it, with a timestamp appended to their name.
+	Filename string `json:"filename"`
+	// MaxSizeMB is the size at which the file is rolled. Defaults to 100.
+	MaxSizeMB int `json:"maxSizeMB"`
+	// MaxAgeDays is the number of days rolled files are kept for. Zero keeps
+	// them regardless of their age.
+	MaxAgeDays int `json:"maxAgeDays"`
+	// MaxBackups is the number of rolled files kept. Zero keeps all of them.
+	MaxBackups int `json:"maxBackups"`
+	// Compress gzips the rolled files.
+	Compress bool `json:"compress"`
+}
+
+func (c FileTransmitterConfig) NewTransmitter() (Transmitter, error) {
+	if c.Filename == "" {
+		return nil, errors.New("file transmitter: filename is required")
+	}
+	return c.newTransmitter(c.Logger), nil
+}
+
+func (c FileTransmitterConfig) newTransmitter(lggr logger.Logger) *fileTransmitter {
+	if c.MaxSizeMB == 0 {
+		c.MaxSizeMB = defaultFileMaxSizeMB
+	}
+	t := &fileTransmitter{
+		config: c,
+		out: &lumberjack.Logger{
+			Filename:   c.Filename,
+			MaxSize:    c.MaxSizeMB,
+			MaxAge:     c.MaxAgeDays,
+			MaxBackups: c.MaxBackups,
+			Compress:   c.Compress,
+		},
+		fromAccount: ocr2types.Account(lggr.Name() + strconv.FormatUint(uint64(c.DonID), 10)),
+	}
+
+	t.Service, t.eng = services.Config{
+		Name:  "FileTransmitter",
+		Close: t.close,
+	}.NewServiceEngine(lggr)
+
+	return t
+}
+
+var _ Transmitter = &fileTransmitter{}
+
+type fileTransmitter struct {
+	services.Service
+	eng *services.Engine
+
+	config      FileTransmitterConfig
+	fromAccount ocr2types.Account
+
+	// out is safe for concurrent use
+	out *lumberjack.Logger
+}
+
+func (t *fileTransmitter) close() error {
+	return t.out.Close()
+}
+
+func (t *fileTransmitter) FromAccount(context.Context) (ocr2types.Account, error) {
+	return t.fromAccount, nil
+}
+
+func (t *fileTransmitter) Transmit(
+	ctx context.Context,
+	digest ocr2types.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo],
+	sigs []ocr2types.AttributedOnchainSignature,
+) error {
+	r := newAttestedReport(t.config.DonID, digest, seqNr, report, sigs, t.config.ReportChannels)
+	b, err := protojson.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	// a single write, so that lines of concurrent transmits don't interleave
+	if _, err = t.out.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write report to %s: %w", t.config.Filename, err)
+	}
+	return nil
+}
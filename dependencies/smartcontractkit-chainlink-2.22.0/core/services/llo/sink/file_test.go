@@ -0,0 +1,57 @@
+package sink
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func Test_FileTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	filename := filepath.Join(t.TempDir(), "reports.jsonl")
+	channels := NewReportChannels()
+	tr := FileTransmitterConfig{DonID: 1, ReportChannels: channels, Filename: filename}.newTransmitter(logger.TestLogger(t))
+	servicetest.Run(t, tr)
+
+	sigs := []types.AttributedOnchainSignature{{Signature: []byte{1, 2}, Signer: 3}}
+	require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 42, newJSONReport(t, channels, 1, 42), sigs))
+	require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 43, newJSONReport(t, channels, 2, 43), sigs))
+
+	f, err := os.Open(filename)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	var reports []*AttestedReport
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		r := new(AttestedReport)
+		require.NoError(t, protojson.Unmarshal(scanner.Bytes(), r))
+		reports = append(reports, r)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, reports, 2)
+	assert.Equal(t, uint64(42), reports[0].SeqNr)
+	assert.Equal(t, uint32(1), reports[0].GetChannelID())
+	assert.Equal(t, types.ConfigDigest{1}, types.ConfigDigest(reports[0].ConfigDigest))
+	assert.Equal(t, []byte(newJSONReport(t, channels, 1, 42).Report), reports[0].Report)
+	assert.Equal(t, uint64(43), reports[1].SeqNr)
+	assert.Equal(t, uint32(2), reports[1].GetChannelID())
+}
+
+func Test_FileTransmitterConfig(t *testing.T) {
+	_, err := FileTransmitterConfig{Logger: logger.TestLogger(t)}.NewTransmitter()
+	require.EqualError(t, err, "file transmitter: filename is required")
+}
@@ -0,0 +1,109 @@
+package sink
+
+import (
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+// reportChannelsTTL is how long the channel of an encoded report is kept for
+// at least. Reports are transmitted shortly after they are encoded.
+const reportChannelsTTL = time.Minute
+
+// ReportChannels remembers the channel of the reports encoded by this node.
+//
+// The report info handed to transmitters does not carry the channel of the
+// report, and most report formats don't either. The report codecs record the
+// channel of each report they encode, and the sinks look it up when the report
+// is transmitted. Entries are kept for between reportChannelsTTL and twice
+// that. A nil *ReportChannels records nothing.
+type ReportChannels struct {
+	mu        sync.Mutex
+	current   map[[sha256.Size]byte]reportChannel
+	previous  map[[sha256.Size]byte]reportChannel
+	rotatedAt time.Time
+}
+
+type reportChannel struct {
+	channelID llotypes.ChannelID
+	// ambiguous is set if channels encoded identical reports
+	ambiguous bool
+}
+
+func NewReportChannels() *ReportChannels {
+	return &ReportChannels{
+		current:   make(map[[sha256.Size]byte]reportChannel),
+		rotatedAt: time.Now(),
+	}
+}
+
+// Record remembers that report was encoded for channelID.
+func (c *ReportChannels) Record(report []byte, channelID llotypes.ChannelID) {
+	if c == nil {
+		return
+	}
+	key := sha256.Sum256(report)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.rotatedAt) > reportChannelsTTL {
+		c.previous, c.current = c.current, make(map[[sha256.Size]byte]reportChannel, len(c.current))
+		c.rotatedAt = time.Now()
+	}
+	rc, ok := c.current[key]
+	if !ok {
+		rc, ok = c.previous[key]
+	}
+	if ok && rc.channelID != channelID {
+		rc.ambiguous = true
+	} else {
+		rc.channelID = channelID
+	}
+	c.current[key] = rc
+}
+
+// ChannelID returns the channel report was encoded for, if it is known and
+// unambiguous.
+func (c *ReportChannels) ChannelID(report []byte) (llotypes.ChannelID, bool) {
+	if c == nil {
+		return 0, false
+	}
+	key := sha256.Sum256(report)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	rc, ok := c.current[key]
+	if !ok {
+		rc, ok = c.previous[key]
+	}
+	if !ok || rc.ambiguous {
+		return 0, false
+	}
+	return rc.channelID, true
+}
+
+func newAttestedReport(donID uint32, digest ocr2types.ConfigDigest, seqNr uint64, report ocr3types.ReportWithInfo[llotypes.ReportInfo], sigs []ocr2types.AttributedOnchainSignature, channels *ReportChannels) *AttestedReport {
+	pbSigs := make([]*AttributedOnchainSignature, len(sigs))
+	for i, sig := range sigs {
+		pbSigs[i] = &AttributedOnchainSignature{
+			Signature: sig.Signature,
+			Signer:    uint32(sig.Signer),
+		}
+	}
+	r := &AttestedReport{
+		DonID:          donID,
+		ConfigDigest:   digest[:],
+		SeqNr:          seqNr,
+		ReportFormat:   report.Info.ReportFormat.String(),
+		LifeCycleStage: string(report.Info.LifeCycleStage),
+		Report:         report.Report,
+		Sigs:           pbSigs,
+	}
+	if channelID, ok := channels.ChannelID(report.Report); ok {
+		r.ChannelID = &channelID
+	}
+	return r
+}
@@ -0,0 +1,60 @@
+package sink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReportChannels(t *testing.T) {
+	t.Run("returns the channel a report was encoded for", func(t *testing.T) {
+		c := NewReportChannels()
+		c.Record([]byte("a"), 1)
+		c.Record([]byte("b"), 2)
+
+		id, ok := c.ChannelID([]byte("a"))
+		assert.True(t, ok)
+		assert.Equal(t, uint32(1), id)
+		id, ok = c.ChannelID([]byte("b"))
+		assert.True(t, ok)
+		assert.Equal(t, uint32(2), id)
+		_, ok = c.ChannelID([]byte("c"))
+		assert.False(t, ok)
+	})
+
+	t.Run("reports encoded identically for several channels have no channel", func(t *testing.T) {
+		c := NewReportChannels()
+		c.Record([]byte("a"), 1)
+		c.Record([]byte("a"), 1)
+		_, ok := c.ChannelID([]byte("a"))
+		assert.True(t, ok)
+
+		c.Record([]byte("a"), 2)
+		_, ok = c.ChannelID([]byte("a"))
+		assert.False(t, ok)
+	})
+
+	t.Run("forgets reports after twice the TTL", func(t *testing.T) {
+		c := NewReportChannels()
+		c.Record([]byte("a"), 1)
+
+		c.rotatedAt = c.rotatedAt.Add(-2 * reportChannelsTTL)
+		c.Record([]byte("b"), 2)
+		_, ok := c.ChannelID([]byte("a"))
+		assert.True(t, ok)
+
+		c.rotatedAt = c.rotatedAt.Add(-2 * reportChannelsTTL)
+		c.Record([]byte("c"), 3)
+		_, ok = c.ChannelID([]byte("a"))
+		assert.False(t, ok)
+		_, ok = c.ChannelID([]byte("b"))
+		assert.True(t, ok)
+	})
+
+	t.Run("nil records nothing", func(t *testing.T) {
+		var c *ReportChannels
+		c.Record([]byte("a"), 1)
+		_, ok := c.ChannelID([]byte("a"))
+		assert.False(t, ok)
+	})
+}
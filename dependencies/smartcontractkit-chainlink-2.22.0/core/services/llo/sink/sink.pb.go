@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: sink.proto
+
+package sink
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelIDs    []uint32               `protobuf:"varint,1,rep,packed,name=channelIDs,proto3" json:"channelIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_sink_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sink_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_sink_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeRequest) GetChannelIDs() []uint32 {
+	if x != nil {
+		return x.ChannelIDs
+	}
+	return nil
+}
+
+type AttestedReport struct {
+	state          protoimpl.MessageState        `protogen:"open.v1"`
+	DonID          uint32                        `protobuf:"varint,1,opt,name=donID,proto3" json:"donID,omitempty"`
+	ConfigDigest   []byte                        `protobuf:"bytes,2,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	SeqNr          uint64                        `protobuf:"varint,3,opt,name=seqNr,proto3" json:"seqNr,omitempty"`
+	ChannelID      *uint32                       `protobuf:"varint,4,opt,name=channelID,proto3,oneof" json:"channelID,omitempty"`
+	ReportFormat   string                        `protobuf:"bytes,5,opt,name=reportFormat,proto3" json:"reportFormat,omitempty"`
+	LifeCycleStage string                        `protobuf:"bytes,6,opt,name=lifeCycleStage,proto3" json:"lifeCycleStage,omitempty"`
+	Report         []byte                        `protobuf:"bytes,7,opt,name=report,proto3" json:"report,omitempty"`
+	Sigs           []*AttributedOnchainSignature `protobuf:"bytes,8,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttestedReport) Reset() {
+	*x = AttestedReport{}
+	mi := &file_sink_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestedReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestedReport) ProtoMessage() {}
+
+func (x *AttestedReport) ProtoReflect() protoreflect.Message {
+	mi := &file_sink_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestedReport.ProtoReflect.Descriptor instead.
+func (*AttestedReport) Descriptor() ([]byte, []int) {
+	return file_sink_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttestedReport) GetDonID() uint32 {
+	if x != nil {
+		return x.DonID
+	}
+	return 0
+}
+
+func (x *AttestedReport) GetConfigDigest() []byte {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return nil
+}
+
+func (x *AttestedReport) GetSeqNr() uint64 {
+	if x != nil {
+		return x.SeqNr
+	}
+	return 0
+}
+
+func (x *AttestedReport) GetChannelID() uint32 {
+	if x != nil && x.ChannelID != nil {
+		return *x.ChannelID
+	}
+	return 0
+}
+
+func (x *AttestedReport) GetReportFormat() string {
+	if x != nil {
+		return x.ReportFormat
+	}
+	return ""
+}
+
+func (x *AttestedReport) GetLifeCycleStage() string {
+	if x != nil {
+		return x.LifeCycleStage
+	}
+	return ""
+}
+
+func (x *AttestedReport) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *AttestedReport) GetSigs() []*AttributedOnchainSignature {
+	if x != nil {
+		return x.Sigs
+	}
+	return nil
+}
+
+type AttributedOnchainSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signer        uint32                 `protobuf:"varint,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributedOnchainSignature) Reset() {
+	*x = AttributedOnchainSignature{}
+	mi := &file_sink_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributedOnchainSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributedOnchainSignature) ProtoMessage() {}
+
+func (x *AttributedOnchainSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_sink_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributedOnchainSignature.ProtoReflect.Descriptor instead.
+func (*AttributedOnchainSignature) Descriptor() ([]byte, []int) {
+	return file_sink_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttributedOnchainSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AttributedOnchainSignature) GetSigner() uint32 {
+	if x != nil {
+		return x.Signer
+	}
+	return 0
+}
+
+var File_sink_proto protoreflect.FileDescriptor
+
+var file_sink_proto_rawDesc = string([]byte{
+	0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x73, 0x69,
+	0x6e, 0x6b, 0x22, 0x32, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x44, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x71, 0x4e, 0x72, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x34, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x1a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x32, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x73, 0x69, 0x6e, 0x6b, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x30, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x76,
+	0x32, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x6c, 0x6c, 0x6f, 0x2f, 0x73, 0x69, 0x6e, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_sink_proto_rawDescOnce sync.Once
+	file_sink_proto_rawDescData []byte
+)
+
+func file_sink_proto_rawDescGZIP() []byte {
+	file_sink_proto_rawDescOnce.Do(func() {
+		file_sink_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sink_proto_rawDesc), len(file_sink_proto_rawDesc)))
+	})
+	return file_sink_proto_rawDescData
+}
+
+var file_sink_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sink_proto_goTypes = []any{
+	(*SubscribeRequest)(nil),           // 0: sink.SubscribeRequest
+	(*AttestedReport)(nil),             // 1: sink.AttestedReport
+	(*AttributedOnchainSignature)(nil), // 2: sink.AttributedOnchainSignature
+}
+var file_sink_proto_depIdxs = []int32{
+	2, // 0: sink.AttestedReport.sigs:type_name -> sink.AttributedOnchainSignature
+	0, // 1: sink.ReportStream.Subscribe:input_type -> sink.SubscribeRequest
+	1, // 2: sink.ReportStream.Subscribe:output_type -> sink.AttestedReport
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_sink_proto_init() }
+func file_sink_proto_init() {
+	if File_sink_proto != nil {
+		return
+	}
+	file_sink_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sink_proto_rawDesc), len(file_sink_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sink_proto_goTypes,
+		DependencyIndexes: file_sink_proto_depIdxs,
+		MessageInfos:      file_sink_proto_msgTypes,
+	}.Build()
+	File_sink_proto = out.File
+	file_sink_proto_goTypes = nil
+	file_sink_proto_depIdxs = nil
+}
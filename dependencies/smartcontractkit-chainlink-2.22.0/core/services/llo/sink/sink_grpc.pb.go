@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: sink.proto
+
+package sink
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReportStream_Subscribe_FullMethodName = "/sink.ReportStream/Subscribe"
+)
+
+// ReportStreamClient is the client API for ReportStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportStreamClient interface {
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttestedReport], error)
+}
+
+type reportStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportStreamClient(cc grpc.ClientConnInterface) ReportStreamClient {
+	return &reportStreamClient{cc}
+}
+
+func (c *reportStreamClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttestedReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReportStream_ServiceDesc.Streams[0], ReportStream_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, AttestedReport]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReportStream_SubscribeClient = grpc.ServerStreamingClient[AttestedReport]
+
+// ReportStreamServer is the server API for ReportStream service.
+// All implementations must embed UnimplementedReportStreamServer
+// for forward compatibility.
+type ReportStreamServer interface {
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[AttestedReport]) error
+	mustEmbedUnimplementedReportStreamServer()
+}
+
+// UnimplementedReportStreamServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportStreamServer struct{}
+
+func (UnimplementedReportStreamServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[AttestedReport]) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedReportStreamServer) mustEmbedUnimplementedReportStreamServer() {}
+func (UnimplementedReportStreamServer) testEmbeddedByValue()                      {}
+
+// UnsafeReportStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportStreamServer will
+// result in compilation errors.
+type UnsafeReportStreamServer interface {
+	mustEmbedUnimplementedReportStreamServer()
+}
+
+func RegisterReportStreamServer(s grpc.ServiceRegistrar, srv ReportStreamServer) {
+	// If the following call pancis, it indicates UnimplementedReportStreamServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportStream_ServiceDesc, srv)
+}
+
+func _ReportStream_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReportStreamServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, AttestedReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReportStream_SubscribeServer = grpc.ServerStreamingServer[AttestedReport]
+
+// ReportStream_ServiceDesc is the grpc.ServiceDesc for ReportStream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportStream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sink.ReportStream",
+	HandlerType: (*ReportStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ReportStream_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sink.proto",
+}
@@ -0,0 +1,292 @@
+package sink
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+)
+
+const defaultSubscriberBufferSize = 1000
+
+var (
+	promStreamSubscribers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "llo",
+		Subsystem: "sink",
+		Name:      "stream_subscribers",
+		Help:      "Number of clients subscribed to the report stream",
+	},
+		[]string{"donID"},
+	)
+	promStreamDroppedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "llo",
+		Subsystem: "sink",
+		Name:      "stream_dropped_count",
+		Help:      "Running count of reports dropped because a subscriber was too slow to receive them",
+	},
+		[]string{"donID"},
+	)
+)
+
+type Transmitter interface {
+	llotypes.Transmitter
+	services.Service
+}
+
+// StreamTransmitterConfig configures a transmitter serving the transmitted
+// reports to the clients subscribed to its gRPC ReportStream.
+//
+// The stream is served over TLS if a certificate is configured, and clients
+// must present a certificate signed by TLSClientCAPath if it is set. Without
+// TLS the server may only listen on a loopback address, unless AllowInsecure
+// is set.
+type StreamTransmitterConfig struct {
+	Logger         logger.Logger   `json:"-"`
+	DonID          uint32          `json:"-"`
+	ReportChannels *ReportChannels `json:"-"`
+
+	// ListenAddress is the host:port the gRPC server listens on, e.g.
+	// "127.0.0.1:7070".
+	ListenAddress string `json:"listenAddress"`
+	// TLSCertPath and TLSKeyPath are the PEM encoded certificate and key the
+	// stream is served with.
+	TLSCertPath string `json:"tlsCertPath"`
+	TLSKeyPath  string `json:"tlsKeyPath"`
+	// TLSClientCAPath is the PEM encoded CA bundle client certificates are
+	// verified against. Clients are not authenticated if it is empty.
+	TLSClientCAPath string `json:"tlsClientCAPath"`
+	// AllowInsecure serves the stream without TLS on a non-loopback address.
+	AllowInsecure bool `json:"allowInsecure"`
+	// SubscriberBufferSize is the number of reports buffered for each
+	// subscriber. Reports are dropped for subscribers whose buffer is full.
+	SubscriberBufferSize int `json:"subscriberBufferSize"`
+}
+
+func (c StreamTransmitterConfig) NewTransmitter() (Transmitter, error) {
+	if c.ListenAddress == "" {
+		return nil, errors.New("stream transmitter: listenAddress is required")
+	}
+	if (c.TLSCertPath == "") != (c.TLSKeyPath == "") {
+		return nil, errors.New("stream transmitter: tlsCertPath and tlsKeyPath must be set together")
+	}
+	if c.TLSCertPath == "" {
+		if c.TLSClientCAPath != "" {
+			return nil, errors.New("stream transmitter: tlsClientCAPath requires tlsCertPath and tlsKeyPath")
+		}
+		if !c.AllowInsecure && !isLoopback(c.ListenAddress) {
+			return nil, fmt.Errorf("stream transmitter: listenAddress %s is not a loopback address; configure TLS or set allowInsecure", c.ListenAddress)
+		}
+	}
+	return c.newTransmitter(c.Logger), nil
+}
+
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// serverOptions returns the TLS credentials of the server, if configured.
+func (c StreamTransmitterConfig) serverOptions() ([]grpc.ServerOption, error) {
+	if c.TLSCertPath == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.TLSCertPath, c.TLSKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if c.TLSClientCAPath != "" {
+		pem, err := os.ReadFile(c.TLSClientCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read TLS client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", c.TLSClientCAPath)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+func (c StreamTransmitterConfig) newTransmitter(lggr logger.Logger) *streamTransmitter {
+	t := &streamTransmitter{
+		config:      c,
+		donIDStr:    strconv.FormatUint(uint64(c.DonID), 10),
+		subscribers: make(map[*subscriber]struct{}),
+	}
+	if t.config.SubscriberBufferSize == 0 {
+		t.config.SubscriberBufferSize = defaultSubscriberBufferSize
+	}
+	t.fromAccount = ocr2types.Account(lggr.Name() + t.donIDStr)
+
+	t.Service, t.eng = services.Config{
+		Name:  "StreamTransmitter",
+		Start: t.start,
+		Close: t.close,
+	}.NewServiceEngine(lggr)
+
+	return t
+}
+
+var _ Transmitter = &streamTransmitter{}
+var _ ReportStreamServer = &streamTransmitter{}
+
+type streamTransmitter struct {
+	services.Service
+	eng *services.Engine
+	UnimplementedReportStreamServer
+
+	config      StreamTransmitterConfig
+	donIDStr    string
+	fromAccount ocr2types.Account
+
+	server   *grpc.Server
+	listener net.Listener
+
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	// channelIDs is empty to receive all reports
+	channelIDs map[llotypes.ChannelID]struct{}
+	ch         chan *AttestedReport
+}
+
+func (s *subscriber) wants(r *AttestedReport) bool {
+	if len(s.channelIDs) == 0 {
+		return true
+	}
+	if r.ChannelID == nil {
+		return false
+	}
+	_, ok := s.channelIDs[*r.ChannelID]
+	return ok
+}
+
+func (t *streamTransmitter) start(context.Context) error {
+	opts, err := t.config.serverOptions()
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen("tcp", t.config.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", t.config.ListenAddress, err)
+	}
+	t.listener = lis
+	t.server = grpc.NewServer(opts...)
+	RegisterReportStreamServer(t.server, t)
+
+	t.eng.Infow("Serving report stream", "listenAddress", lis.Addr().String())
+	t.eng.Go(func(context.Context) {
+		if err := t.server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.eng.Errorw("Report stream server stopped", "err", err)
+		}
+	})
+	return nil
+}
+
+func (t *streamTransmitter) close() error {
+	// Stop rather than GracefulStop, since subscriptions never end on their own
+	t.server.Stop()
+	return nil
+}
+
+// Addr returns the address the gRPC server listens on, once started.
+func (t *streamTransmitter) Addr() net.Addr {
+	return t.listener.Addr()
+}
+
+func (t *streamTransmitter) Subscribe(req *SubscribeRequest, stream grpc.ServerStreamingServer[AttestedReport]) error {
+	s := &subscriber{
+		channelIDs: make(map[llotypes.ChannelID]struct{}, len(req.ChannelIDs)),
+		ch:         make(chan *AttestedReport, t.config.SubscriberBufferSize),
+	}
+	for _, channelID := range req.ChannelIDs {
+		s.channelIDs[channelID] = struct{}{}
+	}
+
+	t.mu.Lock()
+	t.subscribers[s] = struct{}{}
+	t.mu.Unlock()
+	promStreamSubscribers.WithLabelValues(t.donIDStr).Inc()
+	defer func() {
+		t.mu.Lock()
+		delete(t.subscribers, s)
+		t.mu.Unlock()
+		promStreamSubscribers.WithLabelValues(t.donIDStr).Dec()
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case r := <-s.ch:
+			if err := stream.Send(r); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (t *streamTransmitter) FromAccount(context.Context) (ocr2types.Account, error) {
+	return t.fromAccount, nil
+}
+
+func (t *streamTransmitter) Transmit(
+	ctx context.Context,
+	digest ocr2types.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[llotypes.ReportInfo],
+	sigs []ocr2types.AttributedOnchainSignature,
+) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(t.subscribers) == 0 {
+		return nil
+	}
+
+	r := newAttestedReport(t.config.DonID, digest, seqNr, report, sigs, t.config.ReportChannels)
+	for s := range t.subscribers {
+		if !s.wants(r) {
+			continue
+		}
+		select {
+		case s.ch <- r:
+		default:
+			// NOTE: Never block transmission on a slow subscriber
+			promStreamDroppedCount.WithLabelValues(t.donIDStr).Inc()
+			t.eng.Warnw("Subscriber buffer full, dropping report", "digest", digest, "seqNr", seqNr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package sink
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	llotypes "github.com/smartcontractkit/chainlink-common/pkg/types/llo"
+	"github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// newJSONReport encodes a report for channelID and records its channel in
+// channels, as the report codecs do.
+func newJSONReport(t *testing.T, channels *ReportChannels, channelID llotypes.ChannelID, seqNr uint64) ocr3types.ReportWithInfo[llotypes.ReportInfo] {
+	r, err := (llo.JSONReportCodec{}).Encode(testutils.Context(t), llo.Report{
+		ConfigDigest: types.ConfigDigest{1, 2, 3},
+		SeqNr:        seqNr,
+		ChannelID:    channelID,
+	}, llotypes.ChannelDefinition{})
+	require.NoError(t, err)
+	channels.Record(r, channelID)
+	return ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+		Report: r,
+		Info: llotypes.ReportInfo{
+			LifeCycleStage: llo.LifeCycleStageProduction,
+			ReportFormat:   llotypes.ReportFormatJSON,
+		},
+	}
+}
+
+func Test_StreamTransmitter(t *testing.T) {
+	ctx := testutils.Context(t)
+	channels := NewReportChannels()
+	tr := StreamTransmitterConfig{DonID: 1, ReportChannels: channels, ListenAddress: "127.0.0.1:0"}.newTransmitter(logger.TestLogger(t))
+	servicetest.Run(t, tr)
+
+	conn, err := grpc.NewClient(tr.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+	client := NewReportStreamClient(conn)
+
+	all, err := client.Subscribe(ctx, &SubscribeRequest{})
+	require.NoError(t, err)
+	channel2, err := client.Subscribe(ctx, &SubscribeRequest{ChannelIDs: []uint32{2}})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		tr.mu.RLock()
+		defer tr.mu.RUnlock()
+		return len(tr.subscribers) == 2
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	sigs := []types.AttributedOnchainSignature{{Signature: []byte{1, 2}, Signer: 3}}
+	require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 42, newJSONReport(t, channels, 1, 42), sigs))
+	require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 43, newJSONReport(t, channels, 2, 43), sigs))
+
+	t.Run("subscriber to all channels receives all reports", func(t *testing.T) {
+		r, err := all.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), r.SeqNr)
+		assert.Equal(t, uint32(1), r.GetChannelID())
+		assert.Equal(t, uint32(1), r.DonID)
+		assert.Equal(t, "json", r.ReportFormat)
+		assert.Equal(t, "production", r.LifeCycleStage)
+		require.Len(t, r.Sigs, 1)
+		assert.Equal(t, []byte{1, 2}, r.Sigs[0].Signature)
+		assert.Equal(t, uint32(3), r.Sigs[0].Signer)
+
+		r, err = all.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(43), r.SeqNr)
+		assert.Equal(t, uint32(2), r.GetChannelID())
+	})
+
+	t.Run("subscriber to a channel only receives its reports", func(t *testing.T) {
+		r, err := channel2.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(43), r.SeqNr)
+		assert.Equal(t, uint32(2), r.GetChannelID())
+	})
+
+	t.Run("reports without a channel are only sent to subscribers to all channels", func(t *testing.T) {
+		report := ocr3types.ReportWithInfo[llotypes.ReportInfo]{
+			Report: []byte("unknown"),
+			Info:   llotypes.ReportInfo{ReportFormat: llotypes.ReportFormatJSON},
+		}
+		require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 44, report, sigs))
+		require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 45, newJSONReport(t, channels, 2, 45), sigs))
+
+		r, err := all.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(44), r.SeqNr)
+		assert.Nil(t, r.ChannelID)
+
+		r, err = channel2.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(45), r.SeqNr)
+	})
+}
+
+func Test_StreamTransmitterTLS(t *testing.T) {
+	ctx := testutils.Context(t)
+	dir := t.TempDir()
+	cert := writeTestCertificate(t, dir)
+	certPath, keyPath := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	tr := StreamTransmitterConfig{
+		DonID:           1,
+		ListenAddress:   "127.0.0.1:0",
+		TLSCertPath:     certPath,
+		TLSKeyPath:      keyPath,
+		TLSClientCAPath: certPath,
+	}.newTransmitter(logger.TestLogger(t))
+	servicetest.Run(t, tr)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert.Leaf)
+	subscribe := func(t *testing.T, creds credentials.TransportCredentials) (ReportStream_SubscribeClient, error) {
+		conn, err := grpc.NewClient(tr.Addr().String(), grpc.WithTransportCredentials(creds))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+		return NewReportStreamClient(conn).Subscribe(ctx, &SubscribeRequest{})
+	}
+	// rejected streams fail on Subscribe or, with TLS 1.3, on the first Recv
+	requireRejected := func(t *testing.T, stream ReportStream_SubscribeClient, err error) {
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		require.Error(t, err)
+	}
+
+	t.Run("rejects plaintext clients", func(t *testing.T) {
+		stream, err := subscribe(t, insecure.NewCredentials())
+		requireRejected(t, stream, err)
+	})
+	t.Run("rejects clients without a certificate", func(t *testing.T) {
+		stream, err := subscribe(t, credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}))
+		requireRejected(t, stream, err)
+	})
+	t.Run("serves clients with a certificate", func(t *testing.T) {
+		stream, err := subscribe(t, credentials.NewTLS(&tls.Config{RootCAs: pool, Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}))
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			tr.mu.RLock()
+			defer tr.mu.RUnlock()
+			return len(tr.subscribers) == 1
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		require.NoError(t, tr.Transmit(ctx, types.ConfigDigest{1}, 42, newJSONReport(t, nil, 1, 42), nil))
+		r, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), r.SeqNr)
+	})
+}
+
+func Test_StreamTransmitterConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  StreamTransmitterConfig
+		err  string
+	}{
+		{"no listen address", StreamTransmitterConfig{}, "stream transmitter: listenAddress is required"},
+		{"plaintext on all interfaces", StreamTransmitterConfig{ListenAddress: ":7070"}, "stream transmitter: listenAddress :7070 is not a loopback address; configure TLS or set allowInsecure"},
+		{"plaintext on a public address", StreamTransmitterConfig{ListenAddress: "10.0.0.1:7070"}, "stream transmitter: listenAddress 10.0.0.1:7070 is not a loopback address; configure TLS or set allowInsecure"},
+		{"certificate without key", StreamTransmitterConfig{ListenAddress: ":7070", TLSCertPath: "cert.pem"}, "stream transmitter: tlsCertPath and tlsKeyPath must be set together"},
+		{"client CA without certificate", StreamTransmitterConfig{ListenAddress: "127.0.0.1:7070", TLSClientCAPath: "ca.pem"}, "stream transmitter: tlsClientCAPath requires tlsCertPath and tlsKeyPath"},
+		{"plaintext on loopback", StreamTransmitterConfig{ListenAddress: "127.0.0.1:7070"}, ""},
+		{"plaintext on localhost", StreamTransmitterConfig{ListenAddress: "localhost:7070"}, ""},
+		{"insecure on all interfaces", StreamTransmitterConfig{ListenAddress: ":7070", AllowInsecure: true}, ""},
+		{"TLS on all interfaces", StreamTransmitterConfig{ListenAddress: ":7070", TLSCertPath: "cert.pem", TLSKeyPath: "key.pem"}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.cfg.Logger = logger.TestLogger(t)
+			_, err := tc.cfg.NewTransmitter()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+// writeTestCertificate writes a self-signed certificate for localhost to
+// cert.pem and its key to key.pem in dir. It is both the CA and the
+// certificate of the server and its clients.
+func writeTestCertificate(t *testing.T, dir string) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), keyPEM, 0600))
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	cert.Leaf, err = x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
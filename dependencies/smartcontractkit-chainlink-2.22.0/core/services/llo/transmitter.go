@@ -13,6 +13,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/cre"
 	"github.com/smartcontractkit/chainlink/v2/core/services/llo/mercurytransmitter"
+	"github.com/smartcontractkit/chainlink/v2/core/services/llo/sink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/llo/config"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -48,6 +49,8 @@ type transmitter struct {
 
 	subTransmitters       []Transmitter
 	retirementReportCache TransmitterRetirementReportCacheWriter
+	// reportChannels is nil unless a sink needs the channel of its reports
+	reportChannels *sink.ReportChannels
 }
 
 type TransmitterOpts struct {
@@ -65,6 +68,7 @@ func NewTransmitter(opts TransmitterOpts) (Transmitter, error) {
 	subTransmitters := []Transmitter{
 		mercurytransmitter.New(opts.MercuryTransmitterOpts),
 	}
+	var reportChannels *sink.ReportChannels
 	for _, cfg := range opts.Subtransmitters {
 		switch cfg.Type {
 		case config.TransmitterTypeCRE:
@@ -77,6 +81,40 @@ func NewTransmitter(opts TransmitterOpts) (Transmitter, error) {
 			creTransmitterCfg.CapabilitiesRegistry = opts.MercuryTransmitterOpts.CapabilitiesRegistry
 			creTransmitterCfg.DonID = opts.DonID
 			subTransmitters = append(subTransmitters, creTransmitterCfg.NewTransmitter())
+		case config.TransmitterTypeFile:
+			var fileTransmitterCfg sink.FileTransmitterConfig
+			err := json.Unmarshal(cfg.Opts, &fileTransmitterCfg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal file transmitter config: %w", err)
+			}
+			fileTransmitterCfg.Logger = opts.Lggr
+			fileTransmitterCfg.DonID = opts.DonID
+			if reportChannels == nil {
+				reportChannels = sink.NewReportChannels()
+			}
+			fileTransmitterCfg.ReportChannels = reportChannels
+			st, err := fileTransmitterCfg.NewTransmitter()
+			if err != nil {
+				return nil, err
+			}
+			subTransmitters = append(subTransmitters, st)
+		case config.TransmitterTypeStream:
+			var streamTransmitterCfg sink.StreamTransmitterConfig
+			err := json.Unmarshal(cfg.Opts, &streamTransmitterCfg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal stream transmitter config: %w", err)
+			}
+			streamTransmitterCfg.Logger = opts.Lggr
+			streamTransmitterCfg.DonID = opts.DonID
+			if reportChannels == nil {
+				reportChannels = sink.NewReportChannels()
+			}
+			streamTransmitterCfg.ReportChannels = reportChannels
+			st, err := streamTransmitterCfg.NewTransmitter()
+			if err != nil {
+				return nil, err
+			}
+			subTransmitters = append(subTransmitters, st)
 		default:
 			return nil, fmt.Errorf("unknown transmitter type: %s", cfg.Type)
 		}
@@ -88,9 +126,16 @@ func NewTransmitter(opts TransmitterOpts) (Transmitter, error) {
 		opts.FromAccount,
 		subTransmitters,
 		opts.RetirementReportCache,
+		reportChannels,
 	}, nil
 }
 
+// ReportChannels returns the channels of encoded reports needed by the sinks,
+// or nil if no sink needs them.
+func (t *transmitter) ReportChannels() *sink.ReportChannels {
+	return t.reportChannels
+}
+
 func (t *transmitter) Start(ctx context.Context) error {
 	return t.StartOnce("llo.Transmitter", func() error {
 		for _, st := range t.subTransmitters {
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -49,6 +51,8 @@ type Config interface {
 	MutexProfileFraction() int
 	PollInterval() commonconfig.Duration
 	ProfileRoot() string
+	ContinuousProfileInterval() commonconfig.Duration
+	ContinuousProfileCount() int
 }
 
 type CheckFunc func() (unwell bool, meta Meta)
@@ -63,8 +67,14 @@ type Meta map[string]interface{}
 const (
 	cpuProfName   = "cpu"
 	traceProfName = "trace"
+
+	// continuousDir holds the ring buffer of continuous profiles, separately
+	// from the alert-driven profiles so it does not count towards MaxProfileSize.
+	continuousDir = "continuous"
 )
 
+var continuousProfiles = []string{"heap", "goroutine"}
+
 func NewNurse(cfg Config, log logger.Logger) *Nurse {
 	n := &Nurse{
 		cfg:      cfg,
@@ -110,6 +120,14 @@ func (n *Nurse) start(_ context.Context) error {
 		}
 	})
 
+	// Continuous low-rate profiling
+	if n.cfg.ContinuousProfileInterval().Duration() > 0 {
+		if err = utils.EnsureDirAndMaxPerms(n.continuousRoot(), 0744); err != nil {
+			return err
+		}
+		n.eng.GoTick(timeutil.NewTicker(n.cfg.ContinuousProfileInterval().Duration), n.gatherContinuous)
+	}
+
 	// Responder
 	n.eng.Go(func(ctx context.Context) {
 		for {
@@ -407,6 +425,95 @@ func collectProfile(p *pprof.Profile) (*profile.Profile, error) {
 	return p0, nil
 }
 
+func (n *Nurse) continuousRoot() string {
+	return filepath.Join(n.cfg.ProfileRoot(), continuousDir)
+}
+
+// gatherContinuous writes a snapshot of the cpu, heap and goroutine profiles to
+// the ring buffer, independently of any check, and drops the oldest snapshots
+// beyond ContinuousProfileCount.
+func (n *Nurse) gatherContinuous(ctx context.Context) {
+	now := time.Now()
+	dir := n.continuousRoot()
+	for _, typ := range continuousProfiles {
+		p := pprof.Lookup(typ)
+		if p == nil {
+			continue
+		}
+		path := filepath.Join(dir, fmt.Sprintf("%v.%v.pprof", now.UnixMicro(), typ))
+		if err := writeProfileFile(path, func(w io.Writer) error { return p.WriteTo(w, 0) }); err != nil {
+			n.eng.Errorw(fmt.Sprintf("could not write continuous %v profile", typ), "err", err)
+		}
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%v.%v.pprof", now.UnixMicro(), cpuProfName))
+	err := writeProfileFile(path, func(w io.Writer) error {
+		if err := pprof.StartCPUProfile(w); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(n.cfg.GatherDuration().Duration()):
+		}
+		pprof.StopCPUProfile()
+		return nil
+	})
+	if err != nil {
+		// cpu profiling is exclusive, so this fails while the nurse is gathering vitals
+		n.eng.Debugw("skipping continuous cpu profile", "err", err)
+		_ = os.Remove(path)
+	}
+
+	if err := pruneContinuous(dir, n.cfg.ContinuousProfileCount()); err != nil {
+		n.eng.Errorw("could not prune continuous profiles", "err", err)
+	}
+}
+
+func writeProfileFile(path string, write func(io.Writer) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	wc := utils.NewDeferableWriteCloser(file)
+	defer wc.Close()
+	if err = write(wc); err != nil {
+		return err
+	}
+	return wc.Close()
+}
+
+// pruneContinuous removes all but the newest keep snapshots from dir.
+func pruneContinuous(dir string, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	snapshots := make(map[string][]string)
+	for _, entry := range entries {
+		ts, _, ok := strings.Cut(entry.Name(), ".")
+		if entry.IsDir() || !ok {
+			continue
+		}
+		snapshots[ts] = append(snapshots[ts], entry.Name())
+	}
+	timestamps := make([]string, 0, len(snapshots))
+	for ts := range snapshots {
+		timestamps = append(timestamps, ts)
+	}
+	// unix micro timestamps have the same width for the foreseeable future
+	sort.Sort(sort.Reverse(sort.StringSlice(timestamps)))
+	var errs error
+	for i, ts := range timestamps {
+		if i < keep {
+			continue
+		}
+		for _, name := range snapshots[ts] {
+			errs = errors.Join(errs, os.Remove(filepath.Join(dir, name)))
+		}
+	}
+	return errs
+}
+
 func (n *Nurse) createFile(now time.Time, typ string, shouldGzip bool) (*utils.DeferableWriteCloser, error) {
 	filename := fmt.Sprintf("%v.%v.pprof", now.UnixMicro(), typ)
 	if shouldGzip {
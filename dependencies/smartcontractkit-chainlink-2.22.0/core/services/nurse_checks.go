@@ -0,0 +1,69 @@
+package services
+
+import (
+	"database/sql"
+	"time"
+)
+
+// NewDBPoolCheck reports unwell when the fraction of open connections in use
+// reaches threshold, or when callers are queueing for a connection.
+func NewDBPoolCheck(stats func() sql.DBStats, threshold float64) CheckFunc {
+	var lastWaitCount int64
+	return func() (bool, Meta) {
+		s := stats()
+		newWaits := s.WaitCount - lastWaitCount
+		lastWaitCount = s.WaitCount
+		if s.MaxOpenConnections <= 0 {
+			return false, nil
+		}
+		utilization := float64(s.InUse) / float64(s.MaxOpenConnections)
+		if utilization < threshold || (utilization < 1 && newWaits == 0) {
+			return false, nil
+		}
+		return true, Meta{
+			"in_use":          s.InUse,
+			"max_open":        s.MaxOpenConnections,
+			"utilization":     utilization,
+			"new_waits":       newWaits,
+			"threshold":       threshold,
+			"wait_duration_s": s.WaitDuration.Seconds(),
+		}
+	}
+}
+
+// NewPipelineQueueCheck reports unwell when the number of in-flight pipeline
+// runs reaches threshold.
+func NewPipelineQueueCheck(depth func() int64, threshold int64) CheckFunc {
+	return func() (bool, Meta) {
+		d := depth()
+		if d < threshold {
+			return false, nil
+		}
+		return true, Meta{
+			"queue_depth": d,
+			"threshold":   threshold,
+		}
+	}
+}
+
+// NewHeadLagCheck reports unwell when the latest head of any chain is older
+// than threshold. latest returns the timestamp of the latest head by chain ID;
+// chains without a head yet are omitted.
+func NewHeadLagCheck(latest func() map[string]time.Time, threshold time.Duration) CheckFunc {
+	return func() (bool, Meta) {
+		now := time.Now()
+		lagging := make(map[string]string)
+		for chainID, ts := range latest() {
+			if lag := now.Sub(ts); lag >= threshold {
+				lagging[chainID] = lag.Round(time.Second).String()
+			}
+		}
+		if len(lagging) == 0 {
+			return false, nil
+		}
+		return true, Meta{
+			"lagging_chains": lagging,
+			"threshold":      threshold,
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/pprof/profile"
+)
+
+// ErrProfileNotFound is returned when a named profile does not exist in the profile root.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ProfileFile describes a profile collected by the Nurse.
+type ProfileFile struct {
+	Name       string
+	Type       string
+	Size       int64
+	CreatedAt  time.Time
+	Continuous bool
+}
+
+// ListProfileFiles returns the profiles collected under root, both alert-driven
+// and continuous, newest first. A missing root yields no profiles.
+func ListProfileFiles(root string) ([]ProfileFile, error) {
+	out, err := listProfileDir(root, false)
+	if err != nil {
+		return nil, err
+	}
+	continuous, err := listProfileDir(filepath.Join(root, continuousDir), true)
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, continuous...)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
+
+func listProfileDir(dir string, continuous bool) ([]ProfileFile, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var out []ProfileFile
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		createdAt, typ, ok := parseProfileName(entry.Name())
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ProfileFile{
+			Name:       entry.Name(),
+			Type:       typ,
+			Size:       info.Size(),
+			CreatedAt:  createdAt,
+			Continuous: continuous,
+		})
+	}
+	return out, nil
+}
+
+// parseProfileName splits names of the form <unix micro>.<type>.pprof[.gz].
+func parseProfileName(name string) (createdAt time.Time, typ string, ok bool) {
+	trimmed := strings.TrimSuffix(name, ".gz")
+	trimmed, ok = strings.CutSuffix(trimmed, ".pprof")
+	if !ok {
+		return
+	}
+	ts, typ, ok := strings.Cut(trimmed, ".")
+	if !ok || typ == "" {
+		return time.Time{}, "", false
+	}
+	micros, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return time.UnixMicro(micros), typ, true
+}
+
+// ProfileFilePath resolves the named profile to a path under root, looking in
+// the alert-driven profiles first and then the continuous ring buffer.
+func ProfileFilePath(root, name string) (string, error) {
+	if name != filepath.Base(name) {
+		return "", fmt.Errorf("invalid profile name %q", name)
+	}
+	if _, _, ok := parseProfileName(name); !ok {
+		return "", fmt.Errorf("invalid profile name %q", name)
+	}
+	for _, dir := range []string{root, filepath.Join(root, continuousDir)} {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+			return path, nil
+		}
+	}
+	return "", ErrProfileNotFound
+}
+
+// DiffProfiles writes the difference between the named base and target
+// profiles to w, in pprof format. Positive values grew between base and target.
+func DiffProfiles(w io.Writer, root, base, target string) error {
+	baseProf, baseTyp, err := readProfileFile(root, base)
+	if err != nil {
+		return err
+	}
+	targetProf, targetTyp, err := readProfileFile(root, target)
+	if err != nil {
+		return err
+	}
+	if baseTyp != targetTyp {
+		return fmt.Errorf("cannot diff %s profile against %s profile", baseTyp, targetTyp)
+	}
+	baseProf.Scale(-1)
+	diff, err := profile.Merge([]*profile.Profile{baseProf, targetProf})
+	if err != nil {
+		return fmt.Errorf("failed to diff profiles: %w", err)
+	}
+	return diff.Write(w)
+}
+
+func readProfileFile(root, name string) (*profile.Profile, string, error) {
+	_, typ, _ := parseProfileName(name)
+	if typ == traceProfName {
+		return nil, "", fmt.Errorf("cannot diff %s: execution traces are not pprof profiles", name)
+	}
+	path, err := ProfileFilePath(root, name)
+	if err != nil {
+		return nil, "", err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+	p, err := profile.Parse(f)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse profile %s: %w", name, err)
+	}
+	return p, typ, nil
+}
@@ -1,11 +1,18 @@
 package services
 
 import (
+	"bytes"
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
 	"runtime"
+	"runtime/pprof"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/pprof/profile"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -29,6 +36,8 @@ type mockConfig struct {
 	mutexProfileFraction int
 	memThreshold         utils.FileSize
 	goroutineThreshold   int
+	continuousInterval   *commonconfig.Duration
+	continuousCount      int
 }
 
 var (
@@ -50,6 +59,8 @@ func newMockConfig(t *testing.T) *mockConfig {
 		mutexProfileFraction: testRate,
 		memThreshold:         utils.FileSize(testSize),
 		goroutineThreshold:   testRate,
+		continuousInterval:   commonconfig.MustNewDuration(0),
+		continuousCount:      2,
 		t:                    t,
 	}
 }
@@ -98,6 +109,14 @@ func (c mockConfig) GoroutineThreshold() int {
 	return c.goroutineThreshold
 }
 
+func (c mockConfig) ContinuousProfileInterval() commonconfig.Duration {
+	return *c.continuousInterval
+}
+
+func (c mockConfig) ContinuousProfileCount() int {
+	return c.continuousCount
+}
+
 func TestNurse(t *testing.T) {
 	l := logger.TestLogger(t)
 	nrse := NewNurse(newMockConfig(t), l)
@@ -140,3 +159,127 @@ func profileExists(t *testing.T, nrse *Nurse, typ string) bool {
 	}
 	return false
 }
+
+func TestNurse_Continuous(t *testing.T) {
+	cfg := newMockConfig(t)
+	cfg.pollInterval = commonconfig.MustNewDuration(time.Hour)
+	cfg.continuousInterval = commonconfig.MustNewDuration(testInterval)
+	nrse := NewNurse(cfg, logger.TestLogger(t))
+
+	require.NoError(t, nrse.Start(tests.Context(t)))
+	defer func() { require.NoError(t, nrse.Close()) }()
+
+	countSnapshots := func() int {
+		profiles, err := ListProfileFiles(cfg.root)
+		require.NoError(t, err)
+		snapshots := make(map[time.Time]struct{})
+		for _, p := range profiles {
+			assert.True(t, p.Continuous)
+			snapshots[p.CreatedAt] = struct{}{}
+		}
+		return len(snapshots)
+	}
+	// the ring buffer fills up and is then capped at ContinuousProfileCount
+	testutils.AssertEventually(t, func() bool { return countSnapshots() == cfg.continuousCount })
+	time.Sleep(3 * testInterval)
+	assert.LessOrEqual(t, countSnapshots(), cfg.continuousCount)
+}
+
+func TestProfileFiles(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, continuousDir), 0744))
+
+	writeHeap := func(dir, name string) {
+		require.NoError(t, writeProfileFile(filepath.Join(dir, name), func(w io.Writer) error {
+			return pprof.Lookup("heap").WriteTo(w, 0)
+		}))
+	}
+	writeHeap(root, "1700000000000000.heap.pprof")
+	writeHeap(filepath.Join(root, continuousDir), "1700000060000000.heap.pprof")
+	require.NoError(t, os.WriteFile(filepath.Join(root, "1700000120000000.cpu.pprof"), []byte("junk"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "nurse.log"), []byte("log"), 0600))
+
+	profiles, err := ListProfileFiles(root)
+	require.NoError(t, err)
+	require.Len(t, profiles, 3)
+	assert.Equal(t, "1700000120000000.cpu.pprof", profiles[0].Name)
+	assert.Equal(t, "cpu", profiles[0].Type)
+	assert.True(t, profiles[1].Continuous)
+	assert.False(t, profiles[2].Continuous)
+
+	t.Run("path", func(t *testing.T) {
+		path, err := ProfileFilePath(root, "1700000060000000.heap.pprof")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(root, continuousDir, "1700000060000000.heap.pprof"), path)
+
+		_, err = ProfileFilePath(root, "1700000000000001.heap.pprof")
+		assert.ErrorIs(t, err, ErrProfileNotFound)
+		_, err = ProfileFilePath(root, "../1700000000000000.heap.pprof")
+		assert.ErrorContains(t, err, "invalid profile name")
+		_, err = ProfileFilePath(root, "nurse.log")
+		assert.ErrorContains(t, err, "invalid profile name")
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, DiffProfiles(&buf, root, "1700000000000000.heap.pprof", "1700000060000000.heap.pprof"))
+		_, err := profile.Parse(&buf)
+		require.NoError(t, err)
+
+		err = DiffProfiles(&buf, root, "1700000000000000.heap.pprof", "1700000120000000.cpu.pprof")
+		assert.ErrorContains(t, err, "cannot diff heap profile against cpu profile")
+	})
+
+	t.Run("missing root", func(t *testing.T) {
+		profiles, err := ListProfileFiles(filepath.Join(root, "missing"))
+		require.NoError(t, err)
+		assert.Empty(t, profiles)
+	})
+}
+
+func TestNurseChecks(t *testing.T) {
+	t.Run("db pool", func(t *testing.T) {
+		stats := sql.DBStats{MaxOpenConnections: 10, InUse: 5}
+		check := NewDBPoolCheck(func() sql.DBStats { return stats }, 0.8)
+		unwell, _ := check()
+		assert.False(t, unwell)
+
+		stats.InUse = 9
+		unwell, _ = check()
+		assert.False(t, unwell, "no callers are waiting for a connection")
+
+		stats.WaitCount = 3
+		unwell, meta := check()
+		assert.True(t, unwell)
+		assert.Equal(t, int64(3), meta["new_waits"])
+
+		stats.InUse = 10
+		unwell, _ = check()
+		assert.True(t, unwell, "pool is exhausted")
+	})
+
+	t.Run("pipeline queue", func(t *testing.T) {
+		var depth int64 = 10
+		check := NewPipelineQueueCheck(func() int64 { return depth }, 100)
+		unwell, _ := check()
+		assert.False(t, unwell)
+
+		depth = 100
+		unwell, meta := check()
+		assert.True(t, unwell)
+		assert.Equal(t, int64(100), meta["queue_depth"])
+	})
+
+	t.Run("head lag", func(t *testing.T) {
+		heads := map[string]time.Time{"1": time.Now(), "2": time.Now().Add(-time.Minute)}
+		check := NewHeadLagCheck(func() map[string]time.Time { return heads }, 5*time.Minute)
+		unwell, _ := check()
+		assert.False(t, unwell)
+
+		heads["2"] = time.Now().Add(-10 * time.Minute)
+		unwell, meta := check()
+		assert.True(t, unwell)
+		assert.Contains(t, meta["lagging_chains"], "2")
+		assert.NotContains(t, meta["lagging_chains"], "1")
+	})
+}
@@ -48,6 +48,7 @@ type PluginConfig struct {
 	OnchainSubscriptions                     *subscriptions.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
 	RateLimiter                              *common.RateLimiterConfig                 `json:"rateLimiter"`
 	S4Constraints                            *s4.Constraints                           `json:"s4Constraints"`
+	S4BlobStorePath                          string                                    `json:"s4BlobStorePath"`
 	DecryptionQueueConfig                    *DecryptionQueueConfig                    `json:"decryptionQueueConfig"`
 	ExternalAdapterMaxRetries                *uint32                                   `json:"externalAdapterMaxRetries"`
 	ExternalAdapterExponentialBackoffBaseSec *uint32                                   `json:"externalAdapterExponentialBackoffBaseSec"`
@@ -58,12 +58,14 @@ const (
 	DefaultOffchainTransmitterChannelSize uint32 = 1000
 	DefaultMaxAdapterRetry                int    = 3
 	DefaultExponentialBackoffBase                = 5 * time.Second
+	DefaultS4CompactionInterval                  = 10 * time.Minute
+	DefaultS4CompactionGracePeriod               = 10 * time.Minute
+	DefaultS4CompactionMaxDeleteExpired   uint   = 1000
 )
 
 // Create all OCR2 plugin Oracles and all extra services needed to run a Functions job.
 func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOracleArgs, s4OracleArgs *libocr2.OCR2OracleArgs, conf *FunctionsServicesConfig) ([]job.ServiceCtx, error) {
 	pluginORM := functions.NewORM(conf.DS, common.HexToAddress(conf.ContractID))
-	s4ORM := s4.NewCachedORMWrapper(s4.NewPostgresORM(conf.DS, s4.SharedTableName, FunctionsS4Namespace), conf.Logger)
 
 	var pluginConfig config.PluginConfig
 	if err := json.Unmarshal(conf.Job.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig); err != nil {
@@ -75,6 +77,24 @@ func NewFunctionsServices(ctx context.Context, functionsOracleArgs, thresholdOra
 
 	allServices := []job.ServiceCtx{}
 
+	var s4ORM s4.ORM
+	if pluginConfig.S4BlobStorePath != "" {
+		blobs, err := s4.NewBlobStore(pluginConfig.S4BlobStorePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open S4 blob store")
+		}
+		blobORM := s4.NewBlobORM(conf.DS, blobs, s4.BlobMetadataTableName, FunctionsS4Namespace)
+		compactor := s4.NewCompactor(conf.Logger, blobORM, blobs, s4.CompactorConfig{
+			Interval:         DefaultS4CompactionInterval,
+			MaxDeleteExpired: DefaultS4CompactionMaxDeleteExpired,
+			GracePeriod:      DefaultS4CompactionGracePeriod,
+		}, clockwork.NewRealClock())
+		allServices = append(allServices, compactor)
+		s4ORM = s4.NewCachedORMWrapper(blobORM, conf.Logger)
+	} else {
+		s4ORM = s4.NewCachedORMWrapper(s4.NewPostgresORM(conf.DS, s4.SharedTableName, FunctionsS4Namespace), conf.Logger)
+	}
+
 	var decryptor threshold.Decryptor
 	// thresholdOracleArgs nil check will be removed once the Threshold plugin is fully integrated w/ Functions
 	if len(conf.ThresholdKeyShare) > 0 && thresholdOracleArgs != nil && pluginConfig.DecryptionQueueConfig != nil {
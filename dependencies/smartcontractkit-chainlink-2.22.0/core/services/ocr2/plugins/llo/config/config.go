@@ -51,12 +51,18 @@ type TransmitterType int
 
 const (
 	TransmitterTypeCRE TransmitterType = iota
+	TransmitterTypeFile
+	TransmitterTypeStream
 )
 
 func (t TransmitterType) String() string {
 	switch t {
 	case TransmitterTypeCRE:
 		return "cre"
+	case TransmitterTypeFile:
+		return "file"
+	case TransmitterTypeStream:
+		return "stream"
 	default:
 		return fmt.Sprintf("unknown transmitter type: %d", t)
 	}
@@ -66,6 +72,10 @@ func (t *TransmitterType) UnmarshalText(text []byte) error {
 	switch string(text) {
 	case "cre":
 		*t = TransmitterTypeCRE
+	case "file":
+		*t = TransmitterTypeFile
+	case "stream":
+		*t = TransmitterTypeStream
 	default:
 		return fmt.Errorf("unknown transmitter type: %s", text)
 	}
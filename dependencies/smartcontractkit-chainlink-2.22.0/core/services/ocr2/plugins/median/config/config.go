@@ -24,6 +24,8 @@ type PluginConfig struct {
 	// JuelsPerFeeCoinCache is disabled when nil
 	JuelsPerFeeCoinCache        *JuelsPerFeeCoinCache       `json:"juelsPerFeeCoinCache"`
 	DeviationFunctionDefinition DeviationFunctionDefinition `json:"deviationFunc"`
+	// SourceHealth is disabled when nil
+	SourceHealth *SourceHealth `json:"sourceHealth"`
 }
 
 type JuelsPerFeeCoinCache struct {
@@ -32,6 +34,21 @@ type JuelsPerFeeCoinCache struct {
 	StalenessAlertThreshold models.Interval `json:"stalenessAlertThreshold"`
 }
 
+// SourceHealth configures the scoring of the inputs of the median tasks of the
+// answer pipeline by how often they agree with their median.
+type SourceHealth struct {
+	// MaxDeviation is the relative deviation from the median beyond which an
+	// observation of a source counts against it, e.g. 0.01 for 1%
+	MaxDeviation float64 `json:"maxDeviation"`
+	// Window is the number of recent rounds a score is computed over
+	Window uint32 `json:"window"`
+	// MinScore is the score below which a source is reported unhealthy
+	MinScore float64 `json:"minScore"`
+	// ExcludeOutliers excludes unhealthy sources from their median, as long
+	// as a majority of its sources remain
+	ExcludeOutliers bool `json:"excludeOutliers"`
+}
+
 // ValidatePluginConfig validates the arguments for the Median plugin.
 func (config *PluginConfig) ValidatePluginConfig() error {
 	if _, err := pipeline.Parse(config.JuelsPerFeeCoinPipeline); err != nil {
@@ -48,6 +65,15 @@ func (config *PluginConfig) ValidatePluginConfig() error {
 		}
 	}
 
+	// unset values have a default set late
+	if config.SourceHealth != nil {
+		if config.SourceHealth.MaxDeviation < 0 {
+			return errors.Errorf("sourceHealth max deviation: %v must not be negative", config.SourceHealth.MaxDeviation)
+		} else if config.SourceHealth.MinScore < 0 || config.SourceHealth.MinScore > 1 {
+			return errors.Errorf("sourceHealth min score: %v must be between 0 and 1", config.SourceHealth.MinScore)
+		}
+	}
+
 	// Gas price pipeline is optional
 	if !config.HasGasPriceSubunitsPipeline() {
 		return nil
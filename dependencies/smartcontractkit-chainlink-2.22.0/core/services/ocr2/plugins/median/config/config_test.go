@@ -43,6 +43,25 @@ func TestValidatePluginConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("source health validation", func(t *testing.T) {
+		for _, tc := range []struct {
+			name          string
+			sourceHealth  SourceHealth
+			expectedError string
+		}{
+			{"negative max deviation", SourceHealth{MaxDeviation: -0.01}, "sourceHealth max deviation: -0.01 must not be negative"},
+			{"min score above 1", SourceHealth{MinScore: 1.5}, "sourceHealth min score: 1.5 must be between 0 and 1"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				pc := PluginConfig{JuelsPerFeeCoinPipeline: `ds1 [type=bridge name=voter_turnout];`, SourceHealth: &tc.sourceHealth}
+				assert.EqualError(t, pc.ValidatePluginConfig(), tc.expectedError)
+			})
+		}
+
+		pc := PluginConfig{JuelsPerFeeCoinPipeline: `ds1 [type=bridge name=voter_turnout];`, SourceHealth: &SourceHealth{MaxDeviation: 0.01, Window: 10, MinScore: 0.5, ExcludeOutliers: true}}
+		assert.NoError(t, pc.ValidatePluginConfig())
+	})
+
 	t.Run("valid values", func(t *testing.T) {
 		for _, s := range []testCase{
 			{"valid 0 cache duration and valid pipeline", `ds1 [type=bridge name=voter_turnout];`, 0, nil},
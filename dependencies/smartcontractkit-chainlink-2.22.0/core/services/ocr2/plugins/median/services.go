@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/smartcontractkit/libocr/commontypes"
 	libocr_median "github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus"
 
@@ -63,6 +64,9 @@ func NewMedianServices(ctx context.Context,
 	cfg MedianConfig,
 	chEnhancedTelem chan ocrcommon.EnhancedTelemetryData,
 	errorLog loop.ErrorLog,
+	sourceHealthRegistry *ocrcommon.SourceHealthRegistry,
+	// genSourceHealthEndpoint is only called if source health tracking is enabled
+	genSourceHealthEndpoint func() commontypes.MonitoringEndpoint,
 ) (srvs []job.ServiceCtx, err error) {
 	var pluginConfig config.PluginConfig
 	err = json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
@@ -114,12 +118,25 @@ func NewMedianServices(ctx context.Context,
 		}
 	}
 
+	var sourceHealth *ocrcommon.SourceHealthTracker
+	if pluginConfig.SourceHealth != nil {
+		lggr.Infof("median source health tracking is enabled")
+		sourceHealth, err = ocrcommon.NewSourceHealthTracker(lggr, jb, *pluginConfig.SourceHealth, errorLog, sourceHealthRegistry, genSourceHealthEndpoint())
+		if err != nil {
+			abort()
+			return
+		}
+		runSaver.AddObserver(sourceHealth)
+		srvs = append(srvs, sourceHealth)
+	}
+
 	dataSource := ocrcommon.NewDataSourceV2(pipelineRunner,
 		jb,
 		*jb.PipelineSpec,
 		lggr,
 		runSaver,
-		chEnhancedTelem)
+		chEnhancedTelem,
+		sourceHealth)
 
 	juelsPerFeeCoinSource := ocrcommon.NewInMemoryDataSource(pipelineRunner, jb, pipeline.Spec{
 		ID:           jb.ID,
@@ -36,6 +36,9 @@ type inMemoryDataSource struct {
 	mu      sync.RWMutex
 
 	chEnhancedTelemetry chan<- EnhancedTelemetryData
+
+	// sourceHealth is optional, it excludes unhealthy sources from medians
+	sourceHealth *SourceHealthTracker
 }
 
 type Saver interface {
@@ -79,7 +82,9 @@ func NewDataSourceV1(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr lo
 	}
 }
 
-func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr logger.Logger, s Saver, enhancedTelemChan chan EnhancedTelemetryData) median.DataSource {
+// NewDataSourceV2 returns a data source for ocr2. The sourceHealth tracker is
+// optional.
+func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr logger.Logger, s Saver, enhancedTelemChan chan EnhancedTelemetryData, sourceHealth *SourceHealthTracker) median.DataSource {
 	return &dataSourceV2{
 		dataSourceBase: dataSourceBase{
 			inMemoryDataSource: inMemoryDataSource{
@@ -88,6 +93,7 @@ func NewDataSourceV2(pr pipeline.Runner, jb job.Job, spec pipeline.Spec, lggr lo
 				spec:                spec,
 				lggr:                lggr,
 				chEnhancedTelemetry: enhancedTelemChan,
+				sourceHealth:        sourceHealth,
 			},
 			saver: s,
 		},
@@ -193,6 +199,14 @@ func (ds *inMemoryDataSource) executeRun(ctx context.Context) (*pipeline.Run, pi
 		},
 	})
 
+	if ds.sourceHealth != nil {
+		if excluded := ds.sourceHealth.ExcludedInputs(); excluded != nil {
+			if err = vars.Set(pipeline.MedianExcludedInputsVar, excluded); err != nil {
+				ds.lggr.Warnf("unable to exclude unhealthy median sources from run, err: %v", err)
+			}
+		}
+	}
+
 	run, trrs, err := ds.pipelineRunner.ExecuteRun(ctx, ds.spec, vars)
 	if err != nil {
 		return nil, pipeline.TaskRunResults{}, errors.Wrapf(err, "error executing run for spec ID %v", ds.spec.ID)
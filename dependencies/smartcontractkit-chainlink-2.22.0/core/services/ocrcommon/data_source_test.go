@@ -214,7 +214,7 @@ func Test_NewDataSourceV2(t *testing.T) {
 			},
 		}, nil)
 
-	ds := ocrcommon.NewDataSourceV2(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), ms, nil)
+	ds := ocrcommon.NewDataSourceV2(runner, job.Job{}, pipeline.Spec{}, logger.TestLogger(t), ms, nil, nil)
 	val, err := ds.Observe(testutils.Context(t), types.ReportTimestamp{})
 	require.NoError(t, err)
 	assert.Equal(t, mockValue, val.String()) // returns expected value after pipeline run
@@ -0,0 +1,124 @@
+package ocrcommon
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+const (
+	// EASourceHistoryMaxAge is how long the observations of external adapters are kept
+	EASourceHistoryMaxAge = 24 * time.Hour
+	// EASourceHistoryMaxRows is the maximum number of observations kept per job
+	EASourceHistoryMaxRows = 10_000
+	// eaSourceHistoryPruneInterval is how often the enhanced telemetry service prunes the history of its job
+	eaSourceHistoryPruneInterval = 10 * time.Minute
+)
+
+// EASourceObservation is a value returned by an external adapter to a job,
+// along with the duration of the bridge request and the timestamps reported by
+// the data provider, so that provider drift can be diagnosed locally.
+type EASourceObservation struct {
+	ID                     int64     `db:"id"`
+	JobID                  int32     `db:"job_id"`
+	BridgeName             string    `db:"bridge_name"`
+	DataSource             string    `db:"data_source"`
+	Value                  float64   `db:"value"`
+	BridgeTaskRunStartedAt time.Time `db:"bridge_task_run_started_at"`
+	BridgeTaskRunEndedAt   time.Time `db:"bridge_task_run_ended_at"`
+	// Provider timestamps are in unix milliseconds, and are 0 when the
+	// external adapter does not report them.
+	ProviderRequestedTimestamp    int64     `db:"provider_requested_timestamp"`
+	ProviderReceivedTimestamp     int64     `db:"provider_received_timestamp"`
+	ProviderDataStreamEstablished int64     `db:"provider_data_stream_established"`
+	ProviderIndicatedTime         int64     `db:"provider_indicated_time"`
+	CreatedAt                     time.Time `db:"created_at"`
+}
+
+// Latency returns how long the bridge request took.
+func (o EASourceObservation) Latency() time.Duration {
+	return o.BridgeTaskRunEndedAt.Sub(o.BridgeTaskRunStartedAt)
+}
+
+func newEASourceObservation(jobID int32, bridgeName string, value float64, eaTelem EATelemetry) EASourceObservation {
+	return EASourceObservation{
+		JobID:                         jobID,
+		BridgeName:                    bridgeName,
+		DataSource:                    eaTelem.DataSource,
+		Value:                         value,
+		BridgeTaskRunStartedAt:        time.UnixMilli(eaTelem.BridgeTaskRunStartedTimestamp),
+		BridgeTaskRunEndedAt:          time.UnixMilli(eaTelem.BridgeTaskRunEndedTimestamp),
+		ProviderRequestedTimestamp:    eaTelem.ProviderRequestedTimestamp,
+		ProviderReceivedTimestamp:     eaTelem.ProviderReceivedTimestamp,
+		ProviderDataStreamEstablished: eaTelem.ProviderDataStreamEstablished,
+		ProviderIndicatedTime:         eaTelem.ProviderIndicatedTime,
+		CreatedAt:                     time.Now(),
+	}
+}
+
+// EASourceHistory stores the observations of the external adapters of jobs
+// collecting enhanced EA telemetry.
+type EASourceHistory interface {
+	InsertObservations(ctx context.Context, observations []EASourceObservation) error
+	FindObservations(ctx context.Context, jobID int32, since time.Time, offset, limit int) ([]EASourceObservation, int, error)
+	PruneObservations(ctx context.Context, jobID int32, before time.Time, maxRows int) (int64, error)
+}
+
+type eaSourceHistory struct {
+	ds sqlutil.DataSource
+}
+
+var _ EASourceHistory = (*eaSourceHistory)(nil)
+
+// NewEASourceHistory creates an EASourceHistory backed by the database.
+func NewEASourceHistory(ds sqlutil.DataSource) EASourceHistory {
+	return &eaSourceHistory{ds: ds}
+}
+
+// InsertObservations stores the given observations.
+func (h *eaSourceHistory) InsertObservations(ctx context.Context, observations []EASourceObservation) error {
+	if len(observations) == 0 {
+		return nil
+	}
+	_, err := h.ds.NamedExecContext(ctx, `
+INSERT INTO ea_source_observations (job_id, bridge_name, data_source, value, bridge_task_run_started_at, bridge_task_run_ended_at,
+	provider_requested_timestamp, provider_received_timestamp, provider_data_stream_established, provider_indicated_time, created_at)
+VALUES (:job_id, :bridge_name, :data_source, :value, :bridge_task_run_started_at, :bridge_task_run_ended_at,
+	:provider_requested_timestamp, :provider_received_timestamp, :provider_data_stream_established, :provider_indicated_time, :created_at)`, observations)
+	return errors.Wrap(err, "EASourceHistory failed to InsertObservations")
+}
+
+// FindObservations returns a page of the observations of a job recorded since
+// the given time, ordered by bridge and then chronologically, along with the
+// total number of observations recorded since then.
+func (h *eaSourceHistory) FindObservations(ctx context.Context, jobID int32, since time.Time, offset, limit int) (observations []EASourceObservation, count int, err error) {
+	err = sqlutil.TransactDataSource(ctx, h.ds, nil, func(tx sqlutil.DataSource) error {
+		if err := tx.GetContext(ctx, &count, `
+SELECT count(*) FROM ea_source_observations WHERE job_id = $1 AND created_at >= $2`, jobID, since); err != nil {
+			return errors.Wrap(err, "failed to count observations")
+		}
+		return tx.SelectContext(ctx, &observations, `
+SELECT * FROM ea_source_observations
+WHERE job_id = $1 AND created_at >= $2
+ORDER BY bridge_name, data_source, created_at, id
+OFFSET $3 LIMIT $4`, jobID, since, offset, limit)
+	})
+	return observations, count, errors.Wrap(err, "EASourceHistory failed to FindObservations")
+}
+
+// PruneObservations deletes the observations of a job recorded before the
+// given time, as well as the oldest ones beyond the latest maxRows.
+func (h *eaSourceHistory) PruneObservations(ctx context.Context, jobID int32, before time.Time, maxRows int) (int64, error) {
+	res, err := h.ds.ExecContext(ctx, `
+DELETE FROM ea_source_observations
+WHERE job_id = $1 AND (created_at < $2 OR id <= (
+	SELECT id FROM ea_source_observations WHERE job_id = $1 ORDER BY id DESC OFFSET $3 LIMIT 1
+))`, jobID, before, maxRows)
+	if err != nil {
+		return 0, errors.Wrap(err, "EASourceHistory failed to PruneObservations")
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,89 @@
+package ocrcommon_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+func Test_EASourceHistory(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	history := ocrcommon.NewEASourceHistory(db)
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+	otherJob, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	observation := func(jobID int32, bridge string, value float64, createdAt time.Time) ocrcommon.EASourceObservation {
+		return ocrcommon.EASourceObservation{
+			JobID:                      jobID,
+			BridgeName:                 bridge,
+			DataSource:                 bridge + "-adapter",
+			Value:                      value,
+			BridgeTaskRunStartedAt:     createdAt.Add(-250 * time.Millisecond),
+			BridgeTaskRunEndedAt:       createdAt,
+			ProviderRequestedTimestamp: createdAt.Add(-200 * time.Millisecond).UnixMilli(),
+			ProviderReceivedTimestamp:  createdAt.Add(-50 * time.Millisecond).UnixMilli(),
+			CreatedAt:                  createdAt,
+		}
+	}
+
+	require.NoError(t, history.InsertObservations(ctx, nil))
+	require.NoError(t, history.InsertObservations(ctx, []ocrcommon.EASourceObservation{
+		observation(jb.ID, "b", 3, now.Add(-2*time.Hour)),
+		observation(jb.ID, "b", 2, now.Add(-time.Minute)),
+		observation(jb.ID, "a", 1, now),
+		observation(otherJob.ID, "a", 4, now),
+	}))
+
+	t.Run("finds the observations of a job since the given time", func(t *testing.T) {
+		observations, count, err := history.FindObservations(ctx, jb.ID, now.Add(-time.Hour), 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, observations, 2)
+		assert.Equal(t, "a", observations[0].BridgeName)
+		assert.Equal(t, "a-adapter", observations[0].DataSource)
+		assert.InDelta(t, 1, observations[0].Value, 0)
+		assert.Equal(t, 250*time.Millisecond, observations[0].Latency())
+		assert.Equal(t, now.Add(-200*time.Millisecond).UnixMilli(), observations[0].ProviderRequestedTimestamp)
+		assert.Equal(t, "b", observations[1].BridgeName)
+		assert.InDelta(t, 2, observations[1].Value, 0)
+	})
+
+	t.Run("paginates the observations of a job", func(t *testing.T) {
+		observations, count, err := history.FindObservations(ctx, jb.ID, time.Time{}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, observations, 1)
+		assert.Equal(t, "b", observations[0].BridgeName)
+		assert.InDelta(t, 3, observations[0].Value, 0)
+	})
+
+	t.Run("prunes old observations and those beyond the maximum kept", func(t *testing.T) {
+		pruned, err := history.PruneObservations(ctx, jb.ID, now.Add(-time.Hour), 3)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), pruned)
+
+		pruned, err = history.PruneObservations(ctx, jb.ID, now.Add(-time.Hour), 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), pruned)
+
+		observations, count, err := history.FindObservations(ctx, jb.ID, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, observations, 1)
+		assert.Equal(t, "a", observations[0].BridgeName)
+
+		observations, count, err = history.FindObservations(ctx, otherJob.ID, time.Time{}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Len(t, observations, 1)
+	})
+}
@@ -14,6 +14,12 @@ type Runner interface {
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *pipeline.Run, saveSuccessfulTaskRuns bool) error
 }
 
+// RunObserver is notified of every run saved with a RunResultSaver, whether or
+// not it is persisted.
+type RunObserver interface {
+	ObserveRun(ctx context.Context, run *pipeline.Run)
+}
+
 type RunResultSaver struct {
 	services.StateMachine
 
@@ -22,6 +28,7 @@ type RunResultSaver struct {
 	pipelineRunner    Runner
 	stopCh            services.StopChan
 	logger            logger.Logger
+	observers         []RunObserver
 }
 
 func (r *RunResultSaver) HealthReport() map[string]error {
@@ -42,6 +49,11 @@ func NewResultRunSaver(pipelineRunner Runner,
 	}
 }
 
+// AddObserver adds an observer of the saved runs. It must be called before Start.
+func (r *RunResultSaver) AddObserver(o RunObserver) {
+	r.observers = append(r.observers, o)
+}
+
 // Save sends the run on the internal `runResults` channel for saving.
 // IMPORTANT: if the `runResults` pipeline is full, the run will be dropped.
 func (r *RunResultSaver) Save(run *pipeline.Run) {
@@ -61,6 +73,9 @@ func (r *RunResultSaver) Start(context.Context) error {
 			for {
 				select {
 				case run := <-r.runResults:
+					for _, o := range r.observers {
+						o.ObserveRun(ctx, run)
+					}
 					if !run.HasErrors() && r.maxSuccessfulRuns == 0 {
 						// optimisation: don't bother persisting it if we don't need to save successful runs
 						r.logger.Tracew("Skipping save of successful run due to MaxSuccessfulRuns=0", "run", run)
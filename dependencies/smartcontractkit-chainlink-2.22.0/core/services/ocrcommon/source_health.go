@@ -0,0 +1,454 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/loop"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	promSourceHealthScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_median_source_health_score",
+		Help: "Fraction of recent rounds in which a source of a median task agreed with the median",
+	},
+		[]string{"job_id", "job_name", "median", "source"})
+
+	promSourceHealthExcluded = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ocr_median_source_excluded",
+		Help: "Whether a source of a median task is excluded from the median (1) or not (0)",
+	},
+		[]string{"job_id", "job_name", "median", "source"})
+)
+
+const (
+	defaultSourceHealthMaxDeviation = 0.01
+	defaultSourceHealthWindow       = 100
+	defaultSourceHealthMinScore     = 0.5
+)
+
+var _ RunObserver = (*SourceHealthTracker)(nil)
+
+// SourceHealthTracker scores the inputs of the median tasks of an answer
+// pipeline by the fraction of recent rounds in which they agreed with their
+// median, as observed from the runs saved with a RunResultSaver. Sources
+// scoring below the minimum score are unhealthy, and are optionally excluded
+// from their median as long as a majority of its sources remain.
+type SourceHealthTracker struct {
+	services.StateMachine
+	lggr     logger.Logger
+	jb       job.Job
+	cfg      config.SourceHealth
+	errorLog loop.ErrorLog
+	// registry and monitoringEndpoint are optional
+	registry           *SourceHealthRegistry
+	monitoringEndpoint commontypes.MonitoringEndpoint
+
+	// medians maps the dot IDs of the median tasks to the dot IDs of their inputs
+	medians map[string][]string
+
+	mu       sync.RWMutex
+	sources  map[sourceKey]*sourceHealth
+	excluded map[string][]string
+}
+
+type sourceKey struct {
+	median string
+	source string
+}
+
+type sourceHealth struct {
+	// agreed is a ring buffer of whether the source agreed with the median in
+	// the latest rounds
+	agreed   []bool
+	next     int
+	rounds   int
+	score    float64
+	excluded bool
+}
+
+// SourceScore is the health of a source of a median task.
+type SourceScore struct {
+	Median string
+	Source string
+	// Score is the fraction of the last Rounds in which the source agreed
+	// with the median
+	Score     float64
+	Rounds    int
+	Unhealthy bool
+	Excluded  bool
+}
+
+// SourceHealthRegistry gives access to the source scores of the running jobs
+// tracking the health of their median sources.
+type SourceHealthRegistry struct {
+	mu       sync.RWMutex
+	trackers map[int32]*SourceHealthTracker
+}
+
+func NewSourceHealthRegistry() *SourceHealthRegistry {
+	return &SourceHealthRegistry{trackers: make(map[int32]*SourceHealthTracker)}
+}
+
+// Scores returns the source scores of a job, false if the job is not running
+// or doesn't track the health of its sources.
+func (r *SourceHealthRegistry) Scores(jobID int32) ([]SourceScore, bool) {
+	r.mu.RLock()
+	t, ok := r.trackers[jobID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return t.Scores(), true
+}
+
+func (r *SourceHealthRegistry) add(t *SourceHealthTracker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.trackers[t.jb.ID] = t
+}
+
+func (r *SourceHealthRegistry) remove(t *SourceHealthTracker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.trackers[t.jb.ID] == t {
+		delete(r.trackers, t.jb.ID)
+	}
+}
+
+// NewSourceHealthTracker returns a tracker of the sources of the median tasks
+// of the job's pipeline which take their values from their inputs. While
+// started, its scores are available from the registry, and are sent to the
+// monitoring endpoint every round. Both are optional.
+func NewSourceHealthTracker(lggr logger.Logger, jb job.Job, cfg config.SourceHealth, errorLog loop.ErrorLog, registry *SourceHealthRegistry, monitoringEndpoint commontypes.MonitoringEndpoint) (*SourceHealthTracker, error) {
+	if cfg.MaxDeviation == 0 {
+		cfg.MaxDeviation = defaultSourceHealthMaxDeviation
+	}
+	if cfg.Window == 0 {
+		cfg.Window = defaultSourceHealthWindow
+	}
+	if cfg.MinScore == 0 {
+		cfg.MinScore = defaultSourceHealthMinScore
+	}
+	if jb.PipelineSpec == nil {
+		return nil, errors.New("source health tracking requires a pipeline")
+	}
+	p, err := pipeline.Parse(jb.PipelineSpec.DotDagSource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse pipeline")
+	}
+
+	t := &SourceHealthTracker{
+		lggr:     lggr.Named("SourceHealthTracker"),
+		jb:       jb,
+		cfg:      cfg,
+		errorLog: errorLog,
+
+		registry:           registry,
+		monitoringEndpoint: monitoringEndpoint,
+
+		medians:  make(map[string][]string),
+		sources:  make(map[sourceKey]*sourceHealth),
+		excluded: make(map[string][]string),
+	}
+	for _, task := range p.Tasks {
+		median, ok := task.(*pipeline.MedianTask)
+		if !ok || median.Values != "" {
+			continue
+		}
+		for _, input := range median.Inputs() {
+			if !input.PropagateResult {
+				continue
+			}
+			source := input.InputTask.DotID()
+			t.medians[median.DotID()] = append(t.medians[median.DotID()], source)
+			t.sources[sourceKey{median.DotID(), source}] = &sourceHealth{agreed: make([]bool, cfg.Window), score: 1}
+		}
+	}
+	if len(t.medians) == 0 {
+		return nil, errors.New("source health tracking requires a median task taking its values from its inputs")
+	}
+	for key := range t.sources {
+		promSourceHealthScore.WithLabelValues(t.promLabels(key)...).Set(1)
+		promSourceHealthExcluded.WithLabelValues(t.promLabels(key)...).Set(0)
+	}
+	return t, nil
+}
+
+func (t *SourceHealthTracker) Start(context.Context) error {
+	return t.StartOnce("SourceHealthTracker", func() error {
+		if t.registry != nil {
+			t.registry.add(t)
+		}
+		return nil
+	})
+}
+
+func (t *SourceHealthTracker) Close() error {
+	return t.StopOnce("SourceHealthTracker", func() error {
+		if t.registry != nil {
+			t.registry.remove(t)
+		}
+		return nil
+	})
+}
+
+func (t *SourceHealthTracker) Name() string { return t.lggr.Name() }
+
+// HealthReport reports the unhealthy sources, with their scores.
+func (t *SourceHealthTracker) HealthReport() map[string]error {
+	err := t.Healthy()
+	if err == nil {
+		var unhealthy []string
+		t.mu.RLock()
+		for key, h := range t.sources {
+			if t.isUnhealthy(h) {
+				unhealthy = append(unhealthy, t.describe(key, h))
+			}
+		}
+		t.mu.RUnlock()
+		if len(unhealthy) > 0 {
+			sort.Strings(unhealthy)
+			err = fmt.Errorf("unhealthy median sources: %s", strings.Join(unhealthy, ", "))
+		}
+	}
+	return map[string]error{t.Name(): err}
+}
+
+// Scores returns the score of every source of every median task, sorted by
+// median task and source dot ID.
+func (t *SourceHealthTracker) Scores() []SourceScore {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.scores()
+}
+
+// WARNING: must hold the lock
+func (t *SourceHealthTracker) scores() []SourceScore {
+	scores := make([]SourceScore, 0, len(t.sources))
+	for key, h := range t.sources {
+		scores = append(scores, SourceScore{
+			Median:    key.median,
+			Source:    key.source,
+			Score:     h.score,
+			Rounds:    h.rounds,
+			Unhealthy: t.isUnhealthy(h),
+			Excluded:  h.excluded,
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Median != scores[j].Median {
+			return scores[i].Median < scores[j].Median
+		}
+		return scores[i].Source < scores[j].Source
+	})
+	return scores
+}
+
+// ExcludedInputs returns the value of pipeline.MedianExcludedInputsVar for the
+// next run, nil if no source is excluded.
+func (t *SourceHealthTracker) ExcludedInputs() map[string]interface{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if len(t.excluded) == 0 {
+		return nil
+	}
+	excluded := make(map[string]interface{}, len(t.excluded))
+	for median, sources := range t.excluded {
+		dotIDs := make([]interface{}, len(sources))
+		for i, source := range sources {
+			dotIDs[i] = source
+		}
+		excluded[median] = dotIDs
+	}
+	return excluded
+}
+
+// ObserveRun scores the sources of every median task which succeeded in run.
+func (t *SourceHealthTracker) ObserveRun(ctx context.Context, run *pipeline.Run) {
+	taskRuns := make(map[string]pipeline.TaskRun, len(run.PipelineTaskRuns))
+	for _, tr := range run.PipelineTaskRuns {
+		taskRuns[tr.DotID] = tr
+	}
+
+	var transitions []string
+	var observed bool
+	t.mu.Lock()
+	for median, sources := range t.medians {
+		medianValue, ok := taskRunDecimal(taskRuns[median])
+		if !ok {
+			// nothing to compare the sources to
+			continue
+		}
+		observed = true
+		wasUnhealthy := make(map[string]bool, len(sources))
+		for _, source := range sources {
+			key := sourceKey{median, source}
+			h := t.sources[key]
+			wasUnhealthy[source] = t.isUnhealthy(h)
+			value, ok := taskRunDecimal(taskRuns[source])
+			h.observe(ok && t.agrees(value, medianValue))
+			promSourceHealthScore.WithLabelValues(t.promLabels(key)...).Set(h.score)
+		}
+		t.updateExcluded(median)
+		for _, source := range sources {
+			key := sourceKey{median, source}
+			if h := t.sources[key]; t.isUnhealthy(h) != wasUnhealthy[source] {
+				transitions = append(transitions, t.describe(key, h))
+			}
+		}
+	}
+	var scores []SourceScore
+	if observed && t.monitoringEndpoint != nil {
+		scores = t.scores()
+	}
+	t.mu.Unlock()
+
+	if len(scores) > 0 {
+		t.sendTelemetry(scores)
+	}
+	for _, transition := range transitions {
+		t.lggr.Warnw("Median source health changed", "source", transition)
+		if t.errorLog == nil {
+			continue
+		}
+		if err := t.errorLog.SaveError(ctx, "median source health changed: "+transition); err != nil {
+			t.lggr.Errorw("Failed to save median source health change", "err", err)
+		}
+	}
+}
+
+func (t *SourceHealthTracker) sendTelemetry(scores []SourceScore) {
+	msg := &telem.MedianSourceHealth{Sources: make([]*telem.MedianSourceScore, len(scores))}
+	for i, s := range scores {
+		msg.Sources[i] = &telem.MedianSourceScore{
+			Median:    s.Median,
+			Source:    s.Source,
+			Score:     s.Score,
+			Rounds:    int64(s.Rounds),
+			Unhealthy: s.Unhealthy,
+			Excluded:  s.Excluded,
+		}
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.lggr.Warnw("protobuf marshal failed", "err", err)
+		return
+	}
+	t.monitoringEndpoint.SendLog(b)
+}
+
+// agrees returns whether value deviates from the median by no more than the
+// maximum relative deviation.
+func (t *SourceHealthTracker) agrees(value, median decimal.Decimal) bool {
+	if median.IsZero() {
+		return value.IsZero()
+	}
+	deviation, _ := value.Sub(median).Div(median).Abs().Float64()
+	return deviation <= t.cfg.MaxDeviation
+}
+
+// updateExcluded excludes the unhealthy sources of median with the lowest
+// scores, as long as a majority of its sources remain.
+//
+// WARNING: must hold the lock
+func (t *SourceHealthTracker) updateExcluded(median string) {
+	var excluded []string
+	if t.cfg.ExcludeOutliers {
+		sources := t.medians[median]
+		var unhealthy []string
+		for _, source := range sources {
+			if t.isUnhealthy(t.sources[sourceKey{median, source}]) {
+				unhealthy = append(unhealthy, source)
+			}
+		}
+		sort.SliceStable(unhealthy, func(i, j int) bool {
+			return t.sources[sourceKey{median, unhealthy[i]}].score < t.sources[sourceKey{median, unhealthy[j]}].score
+		})
+		excluded = unhealthy[:min(len(unhealthy), (len(sources)-1)/2)]
+	}
+
+	isExcluded := make(map[string]bool, len(excluded))
+	for _, source := range excluded {
+		isExcluded[source] = true
+	}
+	for _, source := range t.medians[median] {
+		key := sourceKey{median, source}
+		h := t.sources[key]
+		if h.excluded != isExcluded[source] {
+			h.excluded = isExcluded[source]
+			var v float64
+			if h.excluded {
+				v = 1
+			}
+			promSourceHealthExcluded.WithLabelValues(t.promLabels(key)...).Set(v)
+		}
+	}
+	if len(excluded) == 0 {
+		delete(t.excluded, median)
+	} else {
+		t.excluded[median] = excluded
+	}
+}
+
+// isUnhealthy returns whether a source scores below the minimum score over a
+// full window.
+func (t *SourceHealthTracker) isUnhealthy(h *sourceHealth) bool {
+	return h.rounds >= len(h.agreed) && h.score < t.cfg.MinScore
+}
+
+func (t *SourceHealthTracker) describe(key sourceKey, h *sourceHealth) string {
+	s := fmt.Sprintf("%s of %s (score %.2f over %d rounds", key.source, key.median, h.score, h.rounds)
+	if h.excluded {
+		s += ", excluded"
+	}
+	return s + ")"
+}
+
+func (t *SourceHealthTracker) promLabels(key sourceKey) []string {
+	return []string{fmt.Sprintf("%d", t.jb.ID), t.jb.Name.ValueOrZero(), key.median, key.source}
+}
+
+func (h *sourceHealth) observe(agreed bool) {
+	h.agreed[h.next] = agreed
+	h.next = (h.next + 1) % len(h.agreed)
+	h.rounds = min(h.rounds+1, len(h.agreed))
+	var n int
+	for i := 0; i < h.rounds; i++ {
+		if h.agreed[i] {
+			n++
+		}
+	}
+	h.score = float64(n) / float64(h.rounds)
+}
+
+func taskRunDecimal(tr pipeline.TaskRun) (decimal.Decimal, bool) {
+	result := tr.Result()
+	if result.Error != nil || result.Value == nil {
+		return decimal.Decimal{}, false
+	}
+	d, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		return decimal.Decimal{}, false
+	}
+	return d, true
+}
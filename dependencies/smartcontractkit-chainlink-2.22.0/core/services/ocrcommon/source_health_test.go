@@ -0,0 +1,191 @@
+package ocrcommon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+type savedErrors []string
+
+func (e *savedErrors) SaveError(_ context.Context, msg string) error {
+	*e = append(*e, msg)
+	return nil
+}
+
+type sentLogs [][]byte
+
+func (l *sentLogs) SendLog(log []byte) {
+	*l = append(*l, log)
+}
+
+// scoresOf returns the scores of the sources of a median task by source
+func scoresOf(tracker *SourceHealthTracker, median string) map[string]float64 {
+	scores := make(map[string]float64)
+	for _, s := range tracker.Scores() {
+		if s.Median == median {
+			scores[s.Source] = s.Score
+		}
+	}
+	return scores
+}
+
+func sourceHealthRun(values map[string]interface{}) *pipeline.Run {
+	run := &pipeline.Run{}
+	for dotID, v := range values {
+		tr := pipeline.TaskRun{DotID: dotID}
+		if err, ok := v.(error); ok {
+			tr.Error = null.StringFrom(err.Error())
+		} else {
+			tr.Output = jsonserializable.JSONSerializable{Val: v, Valid: true}
+		}
+		run.PipelineTaskRuns = append(run.PipelineTaskRuns, tr)
+	}
+	return run
+}
+
+func TestSourceHealthTracker(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	jb := job.Job{ID: 1, PipelineSpec: &pipeline.Spec{DotDagSource: `
+	ds1    [type=memo value=1];
+	ds2    [type=memo value=1];
+	ds3    [type=memo value=1];
+	answer [type=median];
+
+	ds1 -> answer;
+	ds2 -> answer;
+	ds3 -> answer;
+`}}
+	cfg := config.SourceHealth{MaxDeviation: 0.01, Window: 4, MinScore: 0.5, ExcludeOutliers: true}
+
+	t.Run("requires a median taking its values from its inputs", func(t *testing.T) {
+		_, err := NewSourceHealthTracker(lggr, job.Job{PipelineSpec: &pipeline.Spec{DotDagSource: `
+	ds1    [type=memo value=1];
+	answer [type=median values=<[ $(ds1) ]>];
+
+	ds1 -> answer;
+`}}, cfg, nil, nil, nil)
+		require.EqualError(t, err, "source health tracking requires a median task taking its values from its inputs")
+	})
+
+	t.Run("excludes a source once it has been unhealthy over a full window", func(t *testing.T) {
+		var errs savedErrors
+		tracker, err := NewSourceHealthTracker(lggr, jb, cfg, &errs, nil, nil)
+		require.NoError(t, err)
+		servicetest.Run(t, tracker)
+		ctx := testutils.Context(t)
+
+		for i := 0; i < 3; i++ {
+			tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100.5", "ds3": "120", "answer": "100.5"}))
+		}
+		// rounds where the median failed are not scored
+		tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100.5", "ds3": "120", "answer": errors.New("too many errors")}))
+		assert.Equal(t, map[string]float64{"ds1": 1, "ds2": 1, "ds3": 0}, scoresOf(tracker, "answer"))
+		assert.Nil(t, tracker.ExcludedInputs())
+		assert.NoError(t, tracker.HealthReport()[tracker.Name()])
+
+		// errored sources disagree
+		tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100.5", "ds3": errors.New("timeout"), "answer": "100.25"}))
+		assert.Equal(t, map[string]interface{}{"answer": []interface{}{"ds3"}}, tracker.ExcludedInputs())
+		assert.EqualError(t, tracker.HealthReport()[tracker.Name()], "unhealthy median sources: ds3 of answer (score 0.00 over 4 rounds, excluded)")
+		assert.Equal(t, savedErrors{"median source health changed: ds3 of answer (score 0.00 over 4 rounds, excluded)"}, errs)
+
+		// recovers once it agrees in half of the window
+		for i := 0; i < 2; i++ {
+			tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100.5", "ds3": "100.2", "answer": "100.25"}))
+		}
+		assert.Nil(t, tracker.ExcludedInputs())
+		assert.NoError(t, tracker.HealthReport()[tracker.Name()])
+		assert.Equal(t, 0.5, scoresOf(tracker, "answer")["ds3"])
+		assert.Len(t, errs, 2)
+	})
+
+	t.Run("keeps a majority of sources", func(t *testing.T) {
+		tracker, err := NewSourceHealthTracker(lggr, jb, cfg, nil, nil, nil)
+		require.NoError(t, err)
+		ctx := testutils.Context(t)
+
+		for i := 0; i < 4; i++ {
+			ds2 := "150"
+			if i == 0 {
+				ds2 = "100"
+			}
+			tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": ds2, "ds3": "200", "answer": "100"}))
+		}
+		scores := scoresOf(tracker, "answer")
+		assert.Equal(t, 0.25, scores["ds2"])
+		assert.Equal(t, 0.0, scores["ds3"])
+		// only the lowest scoring source is excluded
+		assert.Equal(t, map[string]interface{}{"answer": []interface{}{"ds3"}}, tracker.ExcludedInputs())
+	})
+
+	t.Run("only reports unhealthy sources unless excluding outliers", func(t *testing.T) {
+		cfg := cfg
+		cfg.ExcludeOutliers = false
+		tracker, err := NewSourceHealthTracker(lggr, jb, cfg, nil, nil, nil)
+		require.NoError(t, err)
+		servicetest.Run(t, tracker)
+		ctx := testutils.Context(t)
+
+		for i := 0; i < 4; i++ {
+			tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100", "ds3": "200", "answer": "100"}))
+		}
+		assert.Nil(t, tracker.ExcludedInputs())
+		assert.EqualError(t, tracker.HealthReport()[tracker.Name()], "unhealthy median sources: ds3 of answer (score 0.00 over 4 rounds)")
+	})
+
+	t.Run("registers its scores while started", func(t *testing.T) {
+		registry := NewSourceHealthRegistry()
+		tracker, err := NewSourceHealthTracker(lggr, jb, cfg, nil, registry, nil)
+		require.NoError(t, err)
+		_, ok := registry.Scores(jb.ID)
+		assert.False(t, ok)
+
+		require.NoError(t, tracker.Start(testutils.Context(t)))
+		tracker.ObserveRun(testutils.Context(t), sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100", "ds3": "200", "answer": "100"}))
+		scores, ok := registry.Scores(jb.ID)
+		require.True(t, ok)
+		assert.Equal(t, []SourceScore{
+			{Median: "answer", Source: "ds1", Score: 1, Rounds: 1},
+			{Median: "answer", Source: "ds2", Score: 1, Rounds: 1},
+			{Median: "answer", Source: "ds3", Score: 0, Rounds: 1},
+		}, scores)
+
+		require.NoError(t, tracker.Close())
+		_, ok = registry.Scores(jb.ID)
+		assert.False(t, ok)
+	})
+
+	t.Run("sends its scores every scored round", func(t *testing.T) {
+		var logs sentLogs
+		tracker, err := NewSourceHealthTracker(lggr, jb, cfg, nil, nil, &logs)
+		require.NoError(t, err)
+		ctx := testutils.Context(t)
+
+		tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100", "ds3": "200", "answer": "100"}))
+		tracker.ObserveRun(ctx, sourceHealthRun(map[string]interface{}{"ds1": "100", "ds2": "100", "ds3": "200", "answer": errors.New("too many errors")}))
+		require.Len(t, logs, 1)
+
+		var msg telem.MedianSourceHealth
+		require.NoError(t, proto.Unmarshal(logs[0], &msg))
+		require.Len(t, msg.Sources, 3)
+		assert.Equal(t, "answer", msg.Sources[2].Median)
+		assert.Equal(t, "ds3", msg.Sources[2].Source)
+		assert.Equal(t, 0.0, msg.Sources[2].Score)
+		assert.Equal(t, int64(1), msg.Sources[2].Rounds)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
@@ -28,6 +29,7 @@ import (
 )
 
 type EATelemetry struct {
+	BridgeName                    string
 	DataSource                    string
 	ProviderRequestedTimestamp    int64
 	ProviderReceivedTimestamp     int64
@@ -69,15 +71,19 @@ type EnhancedTelemetryService[T EnhancedTelemetryData | EnhancedTelemetryMercury
 	chTelem            <-chan T
 	chDone             chan struct{}
 	monitoringEndpoint commontypes.MonitoringEndpoint
+	history            EASourceHistory
 	job                *job.Job
 	lggr               logger.Logger
 }
 
-func NewEnhancedTelemetryService[T EnhancedTelemetryData | EnhancedTelemetryMercuryData](job *job.Job, chTelem <-chan T, done chan struct{}, me commontypes.MonitoringEndpoint, lggr logger.Logger) *EnhancedTelemetryService[T] {
+// NewEnhancedTelemetryService creates a service sending the enhanced EA telemetry of a job to the monitoring endpoint.
+// If history is not nil, the observations of the external adapters are also recorded to it.
+func NewEnhancedTelemetryService[T EnhancedTelemetryData | EnhancedTelemetryMercuryData](job *job.Job, chTelem <-chan T, done chan struct{}, me commontypes.MonitoringEndpoint, history EASourceHistory, lggr logger.Logger) *EnhancedTelemetryService[T] {
 	return &EnhancedTelemetryService[T]{
 		chTelem:            chTelem,
 		chDone:             done,
 		monitoringEndpoint: me,
+		history:            history,
 		lggr:               lggr,
 		job:                job,
 	}
@@ -88,6 +94,12 @@ func (e *EnhancedTelemetryService[T]) Start(context.Context) error {
 	return e.StartOnce("EnhancedTelemetryService", func() error {
 		go func() {
 			e.lggr.Infof("Started enhanced telemetry service for job %d", e.job.ID)
+			var pruneC <-chan time.Time
+			if e.history != nil {
+				ticker := time.NewTicker(eaSourceHistoryPruneInterval)
+				defer ticker.Stop()
+				pruneC = ticker.C
+			}
 			for {
 				select {
 				case t := <-e.chTelem:
@@ -99,6 +111,8 @@ func (e *EnhancedTelemetryService[T]) Start(context.Context) error {
 					default:
 						e.lggr.Errorf("unrecognised telemetry data type: %T", t)
 					}
+				case <-pruneC:
+					e.pruneHistory()
 				case <-e.chDone:
 					return
 				}
@@ -170,6 +184,7 @@ func ParseMercuryEATelemetry(lggr logger.Logger, trrs pipeline.TaskRunResults, f
 		if err != nil {
 			lggr.Warnw(fmt.Sprintf("cannot parse EA telemetry, id=%s, name=%q", trr.Task.DotID(), bridgeName), "err", err, "dotID", trr.Task.DotID(), "bridgeName", bridgeName)
 		}
+		eaTelem.BridgeName = bridgeName
 		eaTelem.BridgeRequestData = bridgeTask.RequestData
 		eaTelem.DpBenchmarkPrice, eaTelem.DpBid, eaTelem.DpAsk = getPricesFromBridgeTask(lggr, trr, trrs, feedVersion)
 
@@ -280,9 +295,35 @@ func (e *EnhancedTelemetryService[T]) getParsedValue(trrs *pipeline.TaskRunResul
 	return *parsedValue
 }
 
+// recordHistory stores the observations of the external adapters, if the service keeps a history
+func (e *EnhancedTelemetryService[T]) recordHistory(observations []EASourceObservation) {
+	if e.history == nil || len(observations) == 0 {
+		return
+	}
+	ctx, cancel := services.StopChan(e.chDone).NewCtx()
+	defer cancel()
+	if err := e.history.InsertObservations(ctx, observations); err != nil {
+		e.lggr.Warnw("Failed to record EA source observations", "err", err)
+	}
+}
+
+// pruneHistory deletes the observations of the job's external adapters which are too old or beyond the maximum kept
+func (e *EnhancedTelemetryService[T]) pruneHistory() {
+	ctx, cancel := services.StopChan(e.chDone).NewCtx()
+	defer cancel()
+	pruned, err := e.history.PruneObservations(ctx, e.job.ID, time.Now().Add(-EASourceHistoryMaxAge), EASourceHistoryMaxRows)
+	if err != nil {
+		e.lggr.Warnw("Failed to prune EA source observations", "err", err)
+		return
+	}
+	if pruned > 0 {
+		e.lggr.Debugw("Pruned EA source observations", "count", pruned)
+	}
+}
+
 // collectEATelemetry checks if EA telemetry should be collected, gathers the information and sends it for ingestion
 func (e *EnhancedTelemetryService[T]) collectEATelemetry(trrs pipeline.TaskRunResults, finalResult pipeline.FinalResult, timestamp ObservationTimestamp) {
-	if e.monitoringEndpoint == nil {
+	if e.monitoringEndpoint == nil && e.history == nil {
 		return
 	}
 
@@ -295,6 +336,8 @@ func (e *EnhancedTelemetryService[T]) collectAndSend(trrs *pipeline.TaskRunResul
 
 	observation := e.getObservation(finalResult)
 
+	var observations []EASourceObservation
+
 	for _, trr := range *trrs {
 		if trr.Task.Type() != pipeline.TaskTypeBridge {
 			continue
@@ -312,12 +355,19 @@ func (e *EnhancedTelemetryService[T]) collectAndSend(trrs *pipeline.TaskRunResul
 			continue
 		}
 		value := e.getParsedValue(trrs, trr)
+		eaTelem.BridgeTaskRunStartedTimestamp = trr.CreatedAt.UnixMilli()
+		eaTelem.BridgeTaskRunEndedTimestamp = trr.FinishedAt.Time.UnixMilli()
+		observations = append(observations, newEASourceObservation(e.job.ID, trr.Task.(*pipeline.BridgeTask).Name, value, eaTelem))
+
+		if e.monitoringEndpoint == nil {
+			continue
+		}
 
 		t := &telem.EnhancedEA{
 			DataSource:                    eaTelem.DataSource,
 			Value:                         value,
-			BridgeTaskRunStartedTimestamp: trr.CreatedAt.UnixMilli(),
-			BridgeTaskRunEndedTimestamp:   trr.FinishedAt.Time.UnixMilli(),
+			BridgeTaskRunStartedTimestamp: eaTelem.BridgeTaskRunStartedTimestamp,
+			BridgeTaskRunEndedTimestamp:   eaTelem.BridgeTaskRunEndedTimestamp,
 			ProviderRequestedTimestamp:    eaTelem.ProviderRequestedTimestamp,
 			ProviderReceivedTimestamp:     eaTelem.ProviderReceivedTimestamp,
 			ProviderDataStreamEstablished: eaTelem.ProviderDataStreamEstablished,
@@ -338,12 +388,13 @@ func (e *EnhancedTelemetryService[T]) collectAndSend(trrs *pipeline.TaskRunResul
 
 		e.monitoringEndpoint.SendLog(bytes)
 	}
+	e.recordHistory(observations)
 }
 
 // collectMercuryEnhancedTelemetry checks if enhanced telemetry should be collected, fetches the information needed and
 // sends the telemetry
 func (e *EnhancedTelemetryService[T]) collectMercuryEnhancedTelemetry(d EnhancedTelemetryMercuryData) {
-	if e.monitoringEndpoint == nil {
+	if e.monitoringEndpoint == nil && e.history == nil {
 		return
 	}
 
@@ -436,6 +487,15 @@ func (e *EnhancedTelemetryService[T]) collectMercuryEnhancedTelemetry(d Enhanced
 	}
 
 	eaTelemetryValues := ParseMercuryEATelemetry(logger.Sugared(e.lggr).With("jobID", e.job.ID), d.TaskRunResults, d.FeedVersion)
+	observations := make([]EASourceObservation, 0, len(eaTelemetryValues))
+	for _, eaTelem := range eaTelemetryValues {
+		observations = append(observations, newEASourceObservation(e.job.ID, eaTelem.BridgeName, eaTelem.DpBenchmarkPrice, eaTelem))
+	}
+	e.recordHistory(observations)
+	if e.monitoringEndpoint == nil {
+		return
+	}
+
 	for _, eaTelem := range eaTelemetryValues {
 		t := &telem.EnhancedEAMercury{
 			DataSource:                      eaTelem.DataSource,
@@ -1,10 +1,13 @@
 package ocrcommon
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
@@ -14,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/mercury"
@@ -309,7 +313,7 @@ func TestSendEATelemetry(t *testing.T) {
 
 	lggr, _ := logger.TestLoggerObserved(t, zap.WarnLevel)
 	doneCh := make(chan struct{})
-	enhancedTelemService := NewEnhancedTelemetryService(&jb, enhancedTelemChan, doneCh, monitoringEndpoint, lggr.Named("Enhanced Telemetry Mercury"))
+	enhancedTelemService := NewEnhancedTelemetryService(&jb, enhancedTelemChan, doneCh, monitoringEndpoint, nil, lggr.Named("Enhanced Telemetry Mercury"))
 	servicetest.Run(t, enhancedTelemService)
 	trrs := pipeline.TaskRunResults{
 		pipeline.TaskRunResult{
@@ -417,7 +421,7 @@ func TestCollectAndSend(t *testing.T) {
 	enhancedTelemChan := make(chan EnhancedTelemetryData, 100)
 	doneCh := make(chan struct{})
 
-	enhancedTelemService := NewEnhancedTelemetryService(&jb, enhancedTelemChan, doneCh, monitoringEndpoint, lggr.Named("Enhanced Telemetry"))
+	enhancedTelemService := NewEnhancedTelemetryService(&jb, enhancedTelemChan, doneCh, monitoringEndpoint, nil, lggr.Named("Enhanced Telemetry"))
 	servicetest.Run(t, enhancedTelemService)
 	finalResult := &pipeline.FinalResult{
 		Values:      []interface{}{"123456"},
@@ -477,6 +481,71 @@ func TestCollectAndSend(t *testing.T) {
 	doneCh <- struct{}{}
 }
 
+type fakeEASourceHistory struct {
+	EASourceHistory
+	chObservations chan []EASourceObservation
+}
+
+func (h *fakeEASourceHistory) InsertObservations(_ context.Context, observations []EASourceObservation) error {
+	h.chObservations <- observations
+	return nil
+}
+
+func TestCollectAndSend_RecordsHistory(t *testing.T) {
+	history := &fakeEASourceHistory{chObservations: make(chan []EASourceObservation, 1)}
+	jb := job.Job{
+		ID:   42,
+		Type: job.Type(pipeline.OffchainReportingJobType),
+		OCROracleSpec: &job.OCROracleSpec{
+			CaptureEATelemetry: true,
+		},
+	}
+
+	enhancedTelemChan := make(chan EnhancedTelemetryData, 100)
+	// no monitoring endpoint is needed to record the history
+	enhancedTelemService := NewEnhancedTelemetryService(&jb, enhancedTelemChan, make(chan struct{}), nil, history, logger.TestLogger(t))
+	servicetest.Run(t, enhancedTelemService)
+
+	startedAt := time.Now().Add(-time.Second).Truncate(time.Millisecond)
+	enhancedTelemChan <- EnhancedTelemetryData{
+		TaskRunResults: pipeline.TaskRunResults{
+			pipeline.TaskRunResult{
+				Task: &pipeline.BridgeTask{
+					Name:     "test-bridge",
+					BaseTask: pipeline.NewBaseTask(0, "ds1", nil, nil, 0),
+				},
+				Result:     pipeline.Result{Value: bridgeResponse},
+				CreatedAt:  startedAt,
+				FinishedAt: null.TimeFrom(startedAt.Add(300 * time.Millisecond)),
+			},
+			pipeline.TaskRunResult{
+				Task: &pipeline.JSONParseTask{
+					BaseTask: pipeline.NewBaseTask(1, "ds1_parse", nil, nil, 1),
+				},
+				Result: pipeline.Result{Value: "123.45"},
+			},
+			pipeline.TaskRunResult{
+				Task: &pipeline.BridgeTask{
+					Name:     "failing-bridge",
+					BaseTask: pipeline.NewBaseTask(2, "ds2", nil, nil, 2),
+				},
+				Result: pipeline.Result{Error: errors.New("bridge failed")},
+			},
+		},
+		FinalResults: pipeline.FinalResult{Values: []interface{}{"123"}, FatalErrors: []error{nil}},
+	}
+
+	observations := <-history.chObservations
+	require.Len(t, observations, 1)
+	assert.Equal(t, int32(42), observations[0].JobID)
+	assert.Equal(t, "test-bridge", observations[0].BridgeName)
+	assert.Equal(t, "data-source-name", observations[0].DataSource)
+	assert.InDelta(t, 123.45, observations[0].Value, 0)
+	assert.Equal(t, 300*time.Millisecond, observations[0].Latency())
+	assert.Equal(t, int64(92233720368547760), observations[0].ProviderRequestedTimestamp)
+	assert.Equal(t, int64(-123456789), observations[0].ProviderIndicatedTime)
+}
+
 var trrsMercuryV1 = pipeline.TaskRunResults{
 	pipeline.TaskRunResult{
 		Task: &pipeline.BridgeTask{
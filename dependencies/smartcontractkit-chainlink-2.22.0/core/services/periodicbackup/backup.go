@@ -7,9 +7,13 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 
@@ -19,7 +23,9 @@ import (
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePrefix         = "cl_backup_"
+	filePattern        = filePrefix + "%s_%s.dump"
+	fileTimeFormat     = "20060102T150405Z"
 	minBackupFrequency = time.Minute
 
 	excludedDataFromTables = []string{
@@ -31,14 +37,24 @@ var (
 type backupResult struct {
 	size            int64
 	path            string
+	metadata        Metadata
 	maskedArguments []string
 	pgDumpArguments []string
 }
 
+// Status describes the outcome of the most recent backups.
+type Status struct {
+	LastSuccess     time.Time
+	LastSuccessPath string
+	LastAttempt     time.Time
+	LastError       error
+}
+
 type (
 	DatabaseBackup interface {
 		services.Service
 		RunBackup(version string) error
+		Status() Status
 	}
 
 	databaseBackup struct {
@@ -48,7 +64,14 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		maxBackups      uint32
+		maxAge          time.Duration
+		compression     config.DatabaseBackupCompression
+		encryptionKey   string
 		done            chan bool
+
+		statusMu sync.RWMutex
+		status   Status
 	}
 
 	BackupConfig interface {
@@ -56,6 +79,10 @@ type (
 		Dir() string
 		Mode() config.DatabaseBackupMode
 		Frequency() time.Duration
+		MaxBackups() uint32
+		MaxAge() time.Duration
+		Compression() config.DatabaseBackupCompression
+		EncryptionKey() string
 	}
 )
 
@@ -77,13 +104,16 @@ func NewDatabaseBackup(dbUrl url.URL, rootDir string, backupConfig BackupConfig,
 	}
 
 	return &databaseBackup{
-		services.StateMachine{},
-		lggr,
-		dbUrl,
-		backupConfig.Mode(),
-		backupConfig.Frequency(),
-		outputParentDir,
-		make(chan bool),
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            backupConfig.Mode(),
+		frequency:       backupConfig.Frequency(),
+		outputParentDir: outputParentDir,
+		maxBackups:      backupConfig.MaxBackups(),
+		maxAge:          backupConfig.MaxAge(),
+		compression:     backupConfig.Compression(),
+		encryptionKey:   backupConfig.EncryptionKey(),
+		done:            make(chan bool),
 	}, nil
 }
 
@@ -128,8 +158,37 @@ func (backup *databaseBackup) Name() string {
 	return backup.logger.Name()
 }
 
+// HealthReport reports the service unhealthy if the most recent backup failed,
+// or if periodic backups are enabled and none has succeeded for two periods.
+// Both errors include the time of the last successful backup.
 func (backup *databaseBackup) HealthReport() map[string]error {
-	return map[string]error{backup.Name(): backup.Healthy()}
+	err := backup.Healthy()
+	if err == nil {
+		err = backup.statusErr(time.Now())
+	}
+	return map[string]error{backup.Name(): err}
+}
+
+func (backup *databaseBackup) statusErr(now time.Time) error {
+	status := backup.Status()
+	lastSuccess := "never"
+	if !status.LastSuccess.IsZero() {
+		lastSuccess = status.LastSuccess.Format(time.RFC3339)
+	}
+	if status.LastError != nil {
+		return errors.Errorf("last backup at %s failed (last success: %s): %v", status.LastAttempt.Format(time.RFC3339), lastSuccess, status.LastError)
+	}
+	if backup.frequency > 0 && !status.LastSuccess.IsZero() && now.Sub(status.LastSuccess) > 2*backup.frequency {
+		return errors.Errorf("no successful backup since %s, expected one every %s", lastSuccess, backup.frequency)
+	}
+	return nil
+}
+
+// Status returns the outcome of the most recent backups.
+func (backup *databaseBackup) Status() Status {
+	backup.statusMu.RLock()
+	defer backup.statusMu.RUnlock()
+	return backup.status
 }
 
 func (backup *databaseBackup) frequencyIsTooSmall() bool {
@@ -141,12 +200,26 @@ func (backup *databaseBackup) RunBackup(version string) error {
 	startAt := time.Now()
 	result, err := backup.runBackup(version)
 	duration := time.Since(startAt)
+
+	backup.statusMu.Lock()
+	backup.status.LastAttempt = startAt
+	backup.status.LastError = err
+	if err == nil {
+		backup.status.LastSuccess = startAt
+		backup.status.LastSuccessPath = result.path
+	}
+	backup.statusMu.Unlock()
+
 	if err != nil {
 		backup.logger.Criticalw("Backup failed", "duration", duration, "err", err)
 		backup.SvcErrBuffer.Append(err)
 		return err
 	}
-	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path, "sha256", result.metadata.SHA256)
+
+	if err = backup.applyRetention(time.Now(), result.path); err != nil {
+		backup.logger.Errorw("Failed to remove expired backups", "err", err)
+	}
 	return nil
 }
 
@@ -170,6 +243,8 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		"-F", "c", // format: custom (zipped)
 	}
 
+	args = append(args, compressionArgs(backup.compression)...)
+
 	if backup.mode == config.DatabaseBackupModeLite {
 		for _, table := range excludedDataFromTables {
 			args = append(args, fmt.Sprintf("--exclude-table-data=%s", table))
@@ -209,23 +284,130 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 	if version == "" {
 		version = "unknown"
 	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	createdAt := time.Now().UTC()
+	dumpPath := tmpFile.Name()
+	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version, createdAt.Format(fileTimeFormat)))
+	if backup.encryptionKey != "" {
+		encryptedPath := dumpPath + encryptedSuffix
+		err = encryptFile(encryptedPath, dumpPath, backup.encryptionKey)
+		_ = os.Remove(dumpPath)
+		if err != nil {
+			_ = os.Remove(encryptedPath)
+			return nil, errors.Wrap(err, "Failed to encrypt the backup")
+		}
+		dumpPath = encryptedPath
+		finalFilePath += encryptedSuffix
+	}
+
+	sum, size, err := checksumFile(dumpPath)
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+		_ = os.Remove(dumpPath)
+		return nil, errors.Wrap(err, "Failed to checksum the backup")
 	}
 
-	file, err := os.Stat(finalFilePath)
+	err = os.Rename(dumpPath, finalFilePath)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to access the final backup file")
+		_ = os.Remove(dumpPath)
+		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+	}
+
+	metadata := Metadata{
+		Version:     version,
+		Mode:        backup.mode,
+		Compression: backup.compression,
+		Encrypted:   backup.encryptionKey != "",
+		SHA256:      sum,
+		Size:        size,
+		CreatedAt:   createdAt,
+	}
+	if err = writeMetadata(finalFilePath, metadata); err != nil {
+		return nil, errors.Wrap(err, "Failed to write the backup metadata")
 	}
 
 	return &backupResult{
-		size:            file.Size(),
+		size:            size,
 		path:            finalFilePath,
+		metadata:        metadata,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
 }
+
+// compressionArgs returns the pg_dump flags for the configured compression.
+// lz4 and zstd require pg_dump 16 or later.
+func compressionArgs(compression config.DatabaseBackupCompression) []string {
+	switch compression {
+	case config.DatabaseBackupCompressionNone:
+		return []string{"-Z", "0"}
+	case config.DatabaseBackupCompressionLZ4, config.DatabaseBackupCompressionZstd:
+		return []string{"--compress=" + string(compression)}
+	default:
+		return []string{"-Z", "6"}
+	}
+}
+
+// applyRetention removes backups beyond MaxBackups, and those older than
+// MaxAge, from the output directory. The backup at keep is never removed.
+func (backup *databaseBackup) applyRetention(now time.Time, keep string) error {
+	if backup.maxBackups == 0 && backup.maxAge == 0 {
+		return nil
+	}
+	backups, err := ListBackups(backup.outputParentDir)
+	if err != nil {
+		return err
+	}
+	// newest first
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Metadata.CreatedAt.After(backups[j].Metadata.CreatedAt)
+	})
+
+	var errs error
+	for i, b := range backups {
+		if b.Path == keep {
+			continue
+		}
+		expired := backup.maxAge > 0 && now.Sub(b.Metadata.CreatedAt) > backup.maxAge
+		excess := backup.maxBackups > 0 && i >= int(backup.maxBackups)
+		if !expired && !excess {
+			continue
+		}
+		backup.logger.Infow("Removing expired backup", "filePath", b.Path, "createdAt", b.Metadata.CreatedAt)
+		if err := os.Remove(b.Path); err != nil && !os.IsNotExist(err) {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if err := os.Remove(metadataPath(b.Path)); err != nil && !os.IsNotExist(err) {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Backup is a backup file found on disk along with its metadata.
+type Backup struct {
+	Path     string
+	Metadata Metadata
+}
+
+// ListBackups returns all backups in dir that have metadata. Backups taken
+// before metadata was introduced are ignored and never removed by retention.
+func ListBackups(dir string) ([]Backup, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backups in %s", dir)
+	}
+	var backups []Backup
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, filePrefix) || strings.HasSuffix(name, metadataSuffix) {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		metadata, err := ReadMetadata(path)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, Backup{Path: path, Metadata: metadata})
+	}
+	return backups, nil
+}
@@ -1,9 +1,11 @@
 package periodicbackup
 
 import (
+	"errors"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -117,14 +119,146 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	require.NoError(t, err, "error not nil when checking for output file")
 
 	assert.Greater(t, file.Size(), int64(0))
-	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
+	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9_")
+	assert.True(t, strings.HasSuffix(result.path, ".dump"))
+}
+
+func TestPeriodicBackup_MetadataAndEncryption(t *testing.T) {
+	backupConfig := newTestConfig(time.Minute, nil, t.TempDir(), config.DatabaseBackupModeLite)
+	backupConfig.encryptionKey = "backup-secret"
+	periodicBackup := mustNewDatabaseBackup(t, *(must(t, string(env.DatabaseURL.Get()))), os.TempDir(), backupConfig)
+
+	result, err := periodicBackup.runBackup("0.9.9")
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(result.path, ".dump.enc"))
+
+	metadata, err := ReadMetadata(result.path)
+	require.NoError(t, err)
+	assert.Equal(t, "0.9.9", metadata.Version)
+	assert.Equal(t, config.DatabaseBackupModeLite, metadata.Mode)
+	assert.Equal(t, config.DatabaseBackupCompressionGzip, metadata.Compression)
+	assert.True(t, metadata.Encrypted)
+	assert.Equal(t, result.size, metadata.Size)
+	require.NoError(t, VerifyChecksum(result.path, metadata))
+
+	decrypted := filepath.Join(t.TempDir(), "backup.dump")
+	require.Error(t, decryptFile(decrypted, result.path, "wrong-secret"))
+	decrypted = filepath.Join(t.TempDir(), "backup.dump")
+	require.NoError(t, decryptFile(decrypted, result.path, "backup-secret"))
+	b, err := os.ReadFile(decrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "PGDMP", string(b[:5]), "expected a pg_dump custom format archive")
+
+	f, err := os.OpenFile(result.path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("tampered"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.ErrorContains(t, VerifyChecksum(result.path, metadata), "is corrupted")
+}
+
+func TestEncryption_RoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, encryptionChunkSize - 1, encryptionChunkSize, 3*encryptionChunkSize + 7} {
+		dir := t.TempDir()
+		plain := make([]byte, size)
+		for i := range plain {
+			plain[i] = byte(i)
+		}
+		src := filepath.Join(dir, "plain")
+		require.NoError(t, os.WriteFile(src, plain, 0o600))
+
+		enc := filepath.Join(dir, "enc")
+		require.NoError(t, encryptFile(enc, src, "secret"))
+
+		dec := filepath.Join(dir, "dec")
+		require.NoError(t, decryptFile(dec, enc, "secret"))
+		got, err := os.ReadFile(dec)
+		require.NoError(t, err)
+		assert.Equal(t, plain, got, "size %d", size)
+
+		if size > encryptionChunkSize {
+			// dropping the final chunk must be detected
+			b, err := os.ReadFile(enc)
+			require.NoError(t, err)
+			truncated := filepath.Join(dir, "truncated")
+			require.NoError(t, os.WriteFile(truncated, b[:len(b)-(size%encryptionChunkSize)-16], 0o600))
+			require.Error(t, decryptFile(filepath.Join(dir, "dec2"), truncated, "secret"))
+		}
+	}
+}
+
+func TestPeriodicBackup_Retention(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	write := func(name string, age time.Duration) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o600))
+		require.NoError(t, writeMetadata(path, Metadata{Version: "1.0.0", CreatedAt: now.Add(-age)}))
+		return path
+	}
+	newest := write("cl_backup_1.0.0_a.dump", 0)
+	recent := write("cl_backup_1.0.0_b.dump", time.Hour)
+	old := write("cl_backup_1.0.0_c.dump", 2*time.Hour)
+	ancient := write("cl_backup_1.0.0_d.dump", 48*time.Hour)
+	legacy := filepath.Join(dir, "cl_backup_0.9.9.dump")
+	require.NoError(t, os.WriteFile(legacy, []byte("legacy"), 0o600))
+
+	backupConfig := newTestConfig(time.Minute, nil, dir, config.DatabaseBackupModeFull)
+	backupConfig.maxBackups = 3
+	backupConfig.maxAge = 24 * time.Hour
+	periodicBackup := mustNewDatabaseBackup(t, *(must(t, string(env.DatabaseURL.Get()))), os.TempDir(), backupConfig)
+
+	require.NoError(t, periodicBackup.applyRetention(now, newest))
+	for _, p := range []string{newest, recent, old, legacy} {
+		assert.FileExists(t, p)
+	}
+	assert.NoFileExists(t, ancient)
+	assert.NoFileExists(t, metadataPath(ancient))
+
+	periodicBackup.maxBackups = 1
+	require.NoError(t, periodicBackup.applyRetention(now, newest))
+	assert.FileExists(t, newest)
+	assert.NoFileExists(t, recent)
+	assert.NoFileExists(t, old)
+	assert.FileExists(t, legacy, "backups without metadata are never removed")
+}
+
+func TestPeriodicBackup_HealthReport(t *testing.T) {
+	backupConfig := newTestConfig(time.Hour, nil, "", config.DatabaseBackupModeFull)
+	periodicBackup := mustNewDatabaseBackup(t, *(must(t, string(env.DatabaseURL.Get()))), os.TempDir(), backupConfig)
+	now := time.Now()
+
+	assert.NoError(t, periodicBackup.statusErr(now))
+
+	periodicBackup.status = Status{LastSuccess: now.Add(-time.Hour), LastAttempt: now, LastError: errors.New("pg_dump failed")}
+	err := periodicBackup.statusErr(now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pg_dump failed")
+	assert.Contains(t, err.Error(), now.Add(-time.Hour).Format(time.RFC3339))
+
+	periodicBackup.status = Status{LastSuccess: now.Add(-3 * time.Hour), LastAttempt: now.Add(-3 * time.Hour)}
+	assert.ErrorContains(t, periodicBackup.statusErr(now), "no successful backup since")
+
+	periodicBackup.status = Status{LastSuccess: now.Add(-time.Hour), LastAttempt: now.Add(-time.Hour)}
+	assert.NoError(t, periodicBackup.statusErr(now))
+}
+
+func TestCheckVersionCompatibility(t *testing.T) {
+	assert.NoError(t, CheckVersionCompatibility("2.21.0", "2.22.0"))
+	assert.NoError(t, CheckVersionCompatibility("2.22.0", "2.22.0"))
+	assert.ErrorContains(t, CheckVersionCompatibility("2.23.0", "2.22.0"), "newer than this node")
+	assert.ErrorContains(t, CheckVersionCompatibility("unset", "2.22.0"), "not valid semver")
 }
 
 type testConfig struct {
-	frequency time.Duration
-	mode      config.DatabaseBackupMode
-	url       *url.URL
-	dir       string
+	frequency     time.Duration
+	mode          config.DatabaseBackupMode
+	url           *url.URL
+	dir           string
+	maxBackups    uint32
+	maxAge        time.Duration
+	compression   config.DatabaseBackupCompression
+	encryptionKey string
 }
 
 func (t *testConfig) Frequency() time.Duration {
@@ -143,11 +277,28 @@ func (t *testConfig) Dir() string {
 	return t.dir
 }
 
+func (t *testConfig) MaxBackups() uint32 {
+	return t.maxBackups
+}
+
+func (t *testConfig) MaxAge() time.Duration {
+	return t.maxAge
+}
+
+func (t *testConfig) Compression() config.DatabaseBackupCompression {
+	return t.compression
+}
+
+func (t *testConfig) EncryptionKey() string {
+	return t.encryptionKey
+}
+
 func newTestConfig(frequency time.Duration, databaseBackupURL *url.URL, databaseBackupDir string, mode config.DatabaseBackupMode) *testConfig {
 	return &testConfig{
-		frequency: frequency,
-		mode:      mode,
-		url:       databaseBackupURL,
-		dir:       databaseBackupDir,
+		frequency:   frequency,
+		mode:        mode,
+		url:         databaseBackupURL,
+		dir:         databaseBackupDir,
+		compression: config.DatabaseBackupCompressionGzip,
 	}
 }
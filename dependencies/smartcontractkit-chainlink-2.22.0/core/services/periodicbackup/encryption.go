@@ -0,0 +1,165 @@
+package periodicbackup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Backups are encrypted with AES-256-GCM in fixed size chunks so that dumps of
+// any size can be streamed. The nonce of each chunk is derived from a random
+// prefix, the chunk counter and a final chunk flag, which prevents chunks from
+// being reordered, dropped or the file from being truncated without detection.
+//
+// File layout: magic | salt | nonce prefix | sealed chunk...
+const (
+	encryptedSuffix     = ".enc"
+	encryptionMagic     = "CLBKENC1"
+	encryptionChunkSize = 64 * 1024
+	saltSize            = 16
+	noncePrefixSize     = 7
+)
+
+var hkdfInfo = []byte("chainlink database backup")
+
+func newBackupAEAD(secret string, salt []byte) (cipher.AEAD, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(secret), salt, hkdfInfo), key); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// readChunk fills buf from r and reports whether it was the final chunk.
+func readChunk(r *bufio.Reader, buf []byte) (n int, last bool, err error) {
+	n, err = io.ReadFull(r, buf)
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return n, true, nil
+	case err != nil:
+		return n, false, err
+	}
+	if _, err = r.Peek(1); errors.Is(err, io.EOF) {
+		return n, true, nil
+	}
+	return n, false, err
+}
+
+func encryptFile(dstPath, srcPath string, secret string) (err error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(dstPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := dst.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	header := make([]byte, len(encryptionMagic)+saltSize+noncePrefixSize)
+	copy(header, encryptionMagic)
+	if _, err = rand.Read(header[len(encryptionMagic):]); err != nil {
+		return err
+	}
+	salt := header[len(encryptionMagic) : len(encryptionMagic)+saltSize]
+	prefix := header[len(encryptionMagic)+saltSize:]
+	aead, err := newBackupAEAD(secret, salt)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(dst)
+	if _, err = w.Write(header); err != nil {
+		return err
+	}
+	r := bufio.NewReader(src)
+	buf := make([]byte, encryptionChunkSize)
+	for counter := uint32(0); ; counter++ {
+		n, last, err := readChunk(r, buf)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(aead.Seal(nil, chunkNonce(prefix, counter, last), buf[:n], nil)); err != nil {
+			return err
+		}
+		if last {
+			return w.Flush()
+		}
+	}
+}
+
+func decryptFile(dstPath, srcPath string, secret string) (err error) {
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	r := bufio.NewReader(src)
+
+	header := make([]byte, len(encryptionMagic)+saltSize+noncePrefixSize)
+	if _, err = io.ReadFull(r, header); err != nil || !bytes.Equal(header[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return errors.New("not an encrypted chainlink backup")
+	}
+	salt := header[len(encryptionMagic) : len(encryptionMagic)+saltSize]
+	prefix := header[len(encryptionMagic)+saltSize:]
+	aead, err := newBackupAEAD(secret, salt)
+	if err != nil {
+		return err
+	}
+
+	dst, err := os.OpenFile(dstPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := dst.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	w := bufio.NewWriter(dst)
+	buf := make([]byte, encryptionChunkSize+aead.Overhead())
+	for counter := uint32(0); ; counter++ {
+		n, last, err := readChunk(r, buf)
+		if err != nil {
+			return err
+		}
+		plain, err := aead.Open(nil, chunkNonce(prefix, counter, last), buf[:n], nil)
+		if err != nil {
+			return errors.New("failed to decrypt backup: wrong encryption key or corrupted file")
+		}
+		if _, err = w.Write(plain); err != nil {
+			return err
+		}
+		if last {
+			return w.Flush()
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package periodicbackup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+)
+
+const metadataSuffix = ".json"
+
+// Metadata is written next to every backup and records how it was taken, so
+// that it can be validated before being restored.
+type Metadata struct {
+	// Version is the node version passed to RunBackup.
+	Version     string                           `json:"version"`
+	Mode        config.DatabaseBackupMode        `json:"mode"`
+	Compression config.DatabaseBackupCompression `json:"compression"`
+	Encrypted   bool                             `json:"encrypted"`
+	// SHA256 is the hex encoded checksum of the backup file as stored on disk.
+	SHA256    string    `json:"sha256"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func metadataPath(backupPath string) string {
+	return backupPath + metadataSuffix
+}
+
+func writeMetadata(backupPath string, m Metadata) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(metadataPath(backupPath), b, 0o600)
+}
+
+// ReadMetadata loads the metadata stored alongside the backup at backupPath.
+func ReadMetadata(backupPath string) (m Metadata, err error) {
+	b, err := os.ReadFile(metadataPath(backupPath))
+	if err != nil {
+		return m, errors.Wrap(err, "failed to read backup metadata")
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "failed to parse backup metadata")
+	}
+	return m, nil
+}
+
+// VerifyChecksum checks that the backup at backupPath matches its metadata.
+func VerifyChecksum(backupPath string, m Metadata) error {
+	sum, size, err := checksumFile(backupPath)
+	if err != nil {
+		return err
+	}
+	if size != m.Size || sum != m.SHA256 {
+		return errors.Errorf("backup %s is corrupted: expected sha256 %s (%d bytes), got %s (%d bytes)", backupPath, m.SHA256, m.Size, sum, size)
+	}
+	return nil
+}
+
+func checksumFile(path string) (sum string, size int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if size, err = io.Copy(h, f); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
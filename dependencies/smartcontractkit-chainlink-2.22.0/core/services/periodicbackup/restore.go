@@ -0,0 +1,92 @@
+package periodicbackup
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// RestoreOpts configures Restore.
+type RestoreOpts struct {
+	// AppVersion is the version of the node that will run against the restored database.
+	AppVersion string
+	// EncryptionKey decrypts encrypted backups.
+	EncryptionKey string
+	// SkipVersionCheck allows restoring backups taken by unknown or newer node versions.
+	SkipVersionCheck bool
+}
+
+// Restore validates the backup at backupPath against its metadata and loads it
+// into the database at dbURL with pg_restore, replacing any existing objects.
+func Restore(ctx context.Context, lggr logger.Logger, dbURL url.URL, backupPath string, opts RestoreOpts) error {
+	metadata, err := ReadMetadata(backupPath)
+	if err != nil {
+		return err
+	}
+	if err = VerifyChecksum(backupPath, metadata); err != nil {
+		return err
+	}
+	if !opts.SkipVersionCheck {
+		if err = CheckVersionCompatibility(metadata.Version, opts.AppVersion); err != nil {
+			return err
+		}
+	}
+
+	dumpPath := backupPath
+	if metadata.Encrypted {
+		if opts.EncryptionKey == "" {
+			return errors.New("backup is encrypted, but no Database.BackupEncryptionKey is set in secrets")
+		}
+		tmpDir, err := os.MkdirTemp(filepath.Dir(backupPath), "cl_restore_tmp_")
+		if err != nil {
+			return errors.Wrap(err, "failed to create a tmp dir")
+		}
+		defer os.RemoveAll(tmpDir)
+		dumpPath = filepath.Join(tmpDir, "backup.dump")
+		if err = decryptFile(dumpPath, backupPath, opts.EncryptionKey); err != nil {
+			return err
+		}
+	}
+
+	args := []string{
+		"--clean", "--if-exists", "--no-owner", "--single-transaction",
+		"-d", dbURL.String(),
+		dumpPath,
+	}
+	lggr.Infow("Restoring database backup", "filePath", backupPath, "version", metadata.Version, "mode", metadata.Mode, "createdAt", metadata.CreatedAt, "url", dbURL.Redacted())
+
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	if _, err = cmd.Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
+
+// CheckVersionCompatibility returns an error if a backup taken by backupVersion
+// cannot safely be run by appVersion. Migrations only move forward, so backups
+// taken by a newer node would leave the schema ahead of the application.
+func CheckVersionCompatibility(backupVersion, appVersion string) error {
+	bv, err := semver.NewVersion(backupVersion)
+	if err != nil {
+		return errors.Errorf("backup version %q is not valid semver; the backup may have been taken by a development build", backupVersion)
+	}
+	av, err := semver.NewVersion(appVersion)
+	if err != nil {
+		return errors.Errorf("application version %q is not valid semver", appVersion)
+	}
+	if bv.GreaterThan(av) {
+		return errors.Errorf("backup was taken by node version %s, which is newer than this node (%s)", bv, av)
+	}
+	return nil
+}
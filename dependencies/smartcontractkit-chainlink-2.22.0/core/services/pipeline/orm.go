@@ -59,6 +59,27 @@ const KeepersObservationSource = `
     check_success            	[type=conditional
                                  failEarly=true
                                  data="$(decode_check_perform_tx.success)"]
+    estimate_perform_upkeep_gas [type=estimategaslimit
+                                 evmChainID="$(jobSpec.evmChainID)"
+                                 to="$(jobSpec.contractAddress)"
+                                 from="$(jobSpec.effectiveKeeperAddress)"
+                                 data="$(encode_perform_upkeep_tx)"
+                                 fallbackGasLimit="0"]
+    calculate_perform_cost      [type=multiply
+                                 input="$(estimate_perform_upkeep_gas)"
+                                 times="$(jobSpec.performGasPrice)"]
+    calculate_max_payment       [type=multiply
+                                 input="$(decode_check_upkeep_tx.maxLinkPayment)"
+                                 times="$(decode_check_upkeep_tx.linkEth)"]
+    calculate_max_reimbursement [type=divide
+                                 input="$(calculate_max_payment)"
+                                 divisor="1000000000000000000"]
+    cost_lessthan_reimbursement [type=lessthan
+                                 left="$(calculate_perform_cost)"
+                                 right="$(calculate_max_reimbursement)"]
+    check_profitable            [type=conditional
+                                 failEarly=true
+                                 data="$(cost_lessthan_reimbursement)"]
     perform_upkeep_tx        	[type=ethtx
                                  minConfirmations=0
                                  to="$(jobSpec.contractAddress)"
@@ -67,7 +88,7 @@ const KeepersObservationSource = `
                                  data="$(encode_perform_upkeep_tx)"
                                  gasLimit="$(jobSpec.performUpkeepGasLimit)"
                                  txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
-    encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
+    encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> estimate_perform_upkeep_gas -> calculate_perform_cost -> calculate_max_payment -> calculate_max_reimbursement -> cost_lessthan_reimbursement -> check_profitable -> perform_upkeep_tx
 `
 
 type CreateDataSource interface {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -69,6 +70,9 @@ type runner struct {
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
 
+	// number of runs currently executing
+	inFlight atomic.Int64
+
 	// test helper
 	runFinished func(*Run)
 
@@ -375,7 +379,15 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
+// InFlightRuns returns the number of pipeline runs currently executing.
+func (r *runner) InFlightRuns() int64 {
+	return r.inFlight.Load()
+}
+
 func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars) TaskRunResults {
+	r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+
 	l := r.lggr.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	if r.config.VerboseLogging() {
 		l.Debug("Initiating tasks for pipeline run of spec")
@@ -36,7 +36,9 @@ func (s *scheduler) newMemoryTaskRun(task Task, vars Vars) *memoryTaskRun {
 				inputs = append(inputs, input{index: i.InputTask.OutputIndex(), result: s.results[i.InputTask.ID()].Result})
 			}
 		}
-		sort.Slice(inputs, func(i, j int) bool {
+		// stable, so that tasks can tell which result came from which of their
+		// Inputs, see MedianTask.excludeInputs
+		sort.SliceStable(inputs, func(i, j int) bool {
 			return inputs[i].index < inputs[j].index
 		})
 		run.inputs = make([]Result, len(inputs))
@@ -22,15 +22,19 @@ import (
 // Return types:
 //
 //	uint64
+//
+// If the gas can't be estimated, the task returns FallbackGasLimit, or the
+// configured gas limit if it is not set.
 type EstimateGasLimitTask struct {
-	BaseTask   `mapstructure:",squash"`
-	Input      string `json:"input"`
-	From       string `json:"from"`
-	To         string `json:"to"`
-	Multiplier string `json:"multiplier"`
-	Data       string `json:"data"`
-	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
-	Block      string `json:"block"`
+	BaseTask         `mapstructure:",squash"`
+	Input            string `json:"input"`
+	From             string `json:"from"`
+	To               string `json:"to"`
+	Multiplier       string `json:"multiplier"`
+	Data             string `json:"data"`
+	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
+	Block            string `json:"block"`
+	FallbackGasLimit string `json:"fallbackGasLimit"`
 
 	specGasLimit *uint32
 	legacyChains legacyevm.LegacyChainContainer
@@ -65,6 +69,7 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		multiplier DecimalParam
 		chainID    StringParam
 		block      StringParam
+		fallback   MaybeUint64Param
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddr, From(VarExpr(t.From, vars), utils.ZeroAddress)), "from"),
@@ -74,6 +79,7 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		errors.Wrap(ResolveParam(&multiplier, From(VarExpr(t.Multiplier, vars), NonemptyString(t.Multiplier), decimal.New(1, 0))), "multiplier"),
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
 		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&fallback, From(VarExpr(t.FallbackGasLimit, vars), t.FallbackGasLimit)), "fallbackGasLimit"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -106,8 +112,12 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 	)
 
 	if err != nil {
-		// Fallback to the maximum conceivable gas limit
+		// Fallback to the given gas limit, or else the maximum conceivable gas limit,
 		// if we're unable to call estimate gas for whatever reason.
+		if fallbackGasLimit, ok := fallback.Uint64(); ok {
+			lggr.Warnw("EstimateGas: unable to estimate, fallback to given limit", "err", err, "fallback", fallbackGasLimit)
+			return Result{Value: fallbackGasLimit}, runInfo
+		}
 		lggr.Warnw("EstimateGas: unable to estimate, fallback to configured limit", "err", err, "fallback", maximumGasLimit)
 		return Result{Value: maximumGasLimit}, runInfo
 	}
@@ -22,6 +22,11 @@ type MedianTask struct {
 
 var _ Task = (*MedianTask)(nil)
 
+// MedianExcludedInputsVar is the var holding the inputs excluded from median
+// tasks, as a map of median task dot IDs to lists of input dot IDs. It is set
+// by data sources which track the health of the sources of a median.
+const MedianExcludedInputsVar = "medianExcludedInputs"
+
 func (t *MedianTask) Type() TaskType {
 	return TaskTypeMedian
 }
@@ -34,6 +39,9 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 		allowedFaults      int
 		faults             int
 	)
+	if t.Values == "" {
+		inputs = t.excludeInputs(vars, inputs)
+	}
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
 		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
@@ -70,3 +78,47 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 	median := decimalValues[k].Add(decimalValues[k-1]).Div(decimal.NewFromInt(2))
 	return Result{Value: median}, runInfo
 }
+
+// excludeInputs removes the results of the inputs listed for the task in
+// MedianExcludedInputsVar.
+func (t *MedianTask) excludeInputs(vars Vars, inputs []Result) []Result {
+	val, err := vars.Get(MedianExcludedInputsVar + KeypathSeparator + t.DotID())
+	if err != nil {
+		return inputs
+	}
+	dotIDs, ok := val.([]interface{})
+	if !ok || len(dotIDs) == 0 {
+		return inputs
+	}
+	excluded := make(map[string]struct{}, len(dotIDs))
+	for _, dotID := range dotIDs {
+		if s, ok := dotID.(string); ok {
+			excluded[s] = struct{}{}
+		}
+	}
+
+	// the results are in the order of the propagated inputs, stably sorted by
+	// OutputIndex, see scheduler.newMemoryTaskRun
+	var deps []TaskDependency
+	for _, dep := range t.Inputs() {
+		if dep.PropagateResult {
+			deps = append(deps, dep)
+		}
+	}
+	sort.SliceStable(deps, func(i, j int) bool {
+		return deps[i].InputTask.OutputIndex() < deps[j].InputTask.OutputIndex()
+	})
+	if len(deps) != len(inputs) {
+		return inputs
+	}
+	included := make([]Result, 0, len(inputs))
+	for i, dep := range deps {
+		if _, ok := excluded[dep.InputTask.DotID()]; !ok {
+			included = append(included, inputs[i])
+		}
+	}
+	if len(included) == 0 {
+		return inputs
+	}
+	return included
+}
@@ -203,3 +203,51 @@ func TestMedianTask_AllowedFaults_Unmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestMedianTask_ExcludedInputs(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+	ds1    [type=memo value=1];
+	ds2    [type=memo value=2];
+	ds3    [type=memo value=100];
+	answer [type=median];
+
+	ds1 -> answer;
+	ds2 -> answer;
+	ds3 -> answer;
+`)
+	require.NoError(t, err)
+	var task *pipeline.MedianTask
+	for _, tsk := range p.Tasks {
+		if tsk.Type() == pipeline.TaskTypeMedian {
+			task = tsk.(*pipeline.MedianTask)
+		}
+	}
+	require.NotNil(t, task)
+	// the inputs all have output index 0, so results are in the order of the inputs
+	values := map[string]string{"ds1": "1", "ds2": "2", "ds3": "100"}
+	var inputs []pipeline.Result
+	for _, input := range task.Inputs() {
+		inputs = append(inputs, pipeline.Result{Value: values[input.InputTask.DotID()]})
+	}
+
+	run := func(vars map[string]interface{}) string {
+		output, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(vars), inputs)
+		require.NoError(t, output.Error)
+		return output.Value.(decimal.Decimal).String()
+	}
+
+	assert.Equal(t, "2", run(nil))
+	assert.Equal(t, "1.5", run(map[string]interface{}{
+		pipeline.MedianExcludedInputsVar: map[string]interface{}{"answer": []interface{}{"ds3"}},
+	}))
+	// other median tasks are unaffected
+	assert.Equal(t, "2", run(map[string]interface{}{
+		pipeline.MedianExcludedInputsVar: map[string]interface{}{"other": []interface{}{"ds3"}},
+	}))
+	// all inputs are never excluded
+	assert.Equal(t, "2", run(map[string]interface{}{
+		pipeline.MedianExcludedInputsVar: map[string]interface{}{"answer": []interface{}{"ds1", "ds2", "ds3"}},
+	}))
+}
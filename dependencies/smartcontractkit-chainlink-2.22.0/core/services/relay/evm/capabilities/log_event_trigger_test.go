@@ -1,8 +1,12 @@
 package logevent_test
 
 import (
+	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -52,7 +56,8 @@ func TestLogEventTriggerEVMHappyPath(t *testing.T) {
 	logEventTriggerService, err := logevent.NewTriggerService(ctx,
 		th.BackendTH.Lggr,
 		relayer,
-		logEventConfig)
+		logEventConfig,
+		nil)
 	require.NoError(t, err)
 
 	// Start the service
@@ -99,7 +104,8 @@ func TestLogEventTriggerCursorNewLogs(t *testing.T) {
 	logEventTriggerService, err := logevent.NewTriggerService(ctx,
 		th.BackendTH.Lggr,
 		relayer,
-		logEventConfig)
+		logEventConfig,
+		nil)
 	require.NoError(t, err)
 
 	// Start the service
@@ -116,6 +122,152 @@ func TestLogEventTriggerCursorNewLogs(t *testing.T) {
 	emitLogTxnAndWaitForLog(t, th, log1Ch, []*big.Int{big.NewInt(11), big.NewInt(12)})
 }
 
+// Test if Log Event Trigger Capability resumes from the cursor it persisted
+// when it is registered again after a restart, instead of looking back from
+// the latest head and sending the logs it already sent
+func TestLogEventTriggerResumesFromStoredCursor(t *testing.T) {
+	t.Parallel()
+	th := testutils.NewContractReaderTH(t)
+
+	logEventConfig := logevent.Config{
+		ChainID:        th.BackendTH.ChainID.String(),
+		Network:        "evm",
+		LookbackBlocks: 1000,
+		PollPeriod:     1000,
+	}
+	store := &memKVStore{m: map[string][]byte{}}
+
+	ctx := coretestutils.Context(t)
+
+	height, err := th.BackendTH.EVMClient.LatestBlockHeight(ctx)
+	require.NoError(t, err)
+	block, err := th.BackendTH.EVMClient.BlockByNumber(ctx, height)
+	require.NoError(t, err)
+
+	relayer := commonmocks.NewRelayer(t)
+	relayer.On("NewContractReader", mock.Anything, th.LogEmitterContractReaderCfg).Return(th.LogEmitterContractReader, nil).Once()
+	relayer.On("LatestHead", mock.Anything).Return(commontypes.Head{
+		Height:    height.String(),
+		Hash:      block.Hash().Bytes(),
+		Timestamp: block.Time(),
+	}, nil).Once()
+
+	logEventTriggerService, err := logevent.NewTriggerService(ctx,
+		th.BackendTH.Lggr,
+		relayer,
+		logEventConfig,
+		store)
+	require.NoError(t, err)
+	require.NoError(t, logEventTriggerService.Start(ctx))
+
+	log1Ch, err := logEventTriggerService.RegisterTrigger(ctx, th.LogEmitterRegRequest)
+	require.NoError(t, err)
+	emitLogTxnAndWaitForLog(t, th, log1Ch, []*big.Int{big.NewInt(10)})
+	require.NoError(t, logEventTriggerService.Close())
+
+	// A restarted service with the same store does not need the latest head,
+	// and only sends the logs emitted after the stored cursor
+	contractReader, err := th.BackendTH.NewContractReader(ctx, t, th.LogEmitterContractReaderCfg)
+	require.NoError(t, err)
+	restartedRelayer := commonmocks.NewRelayer(t)
+	restartedRelayer.On("NewContractReader", mock.Anything, th.LogEmitterContractReaderCfg).Return(contractReader, nil).Once()
+
+	restartedService, err := logevent.NewTriggerService(ctx,
+		th.BackendTH.Lggr,
+		restartedRelayer,
+		logEventConfig,
+		store)
+	require.NoError(t, err)
+	servicetest.Run(t, restartedService)
+
+	log1Ch, err = restartedService.RegisterTrigger(ctx, th.LogEmitterRegRequest)
+	require.NoError(t, err)
+	emitLogTxnAndWaitForLog(t, th, log1Ch, []*big.Int{big.NewInt(11)})
+}
+
+// Test if Log Event Trigger Capability forgets the cursor and delivered logs
+// of a trigger when it is unregistered
+func TestLogEventTriggerUnregisterDeletesState(t *testing.T) {
+	t.Parallel()
+	th := testutils.NewContractReaderTH(t)
+
+	logEventConfig := logevent.Config{
+		ChainID:        th.BackendTH.ChainID.String(),
+		Network:        "evm",
+		LookbackBlocks: 1000,
+		PollPeriod:     1000,
+	}
+	store := &memKVStore{m: map[string][]byte{}}
+
+	ctx := coretestutils.Context(t)
+
+	height, err := th.BackendTH.EVMClient.LatestBlockHeight(ctx)
+	require.NoError(t, err)
+	block, err := th.BackendTH.EVMClient.BlockByNumber(ctx, height)
+	require.NoError(t, err)
+
+	relayer := commonmocks.NewRelayer(t)
+	relayer.On("NewContractReader", mock.Anything, th.LogEmitterContractReaderCfg).Return(th.LogEmitterContractReader, nil).Once()
+	relayer.On("LatestHead", mock.Anything).Return(commontypes.Head{
+		Height:    height.String(),
+		Hash:      block.Hash().Bytes(),
+		Timestamp: block.Time(),
+	}, nil).Once()
+
+	logEventTriggerService, err := logevent.NewTriggerService(ctx,
+		th.BackendTH.Lggr,
+		relayer,
+		logEventConfig,
+		store)
+	require.NoError(t, err)
+	servicetest.Run(t, logEventTriggerService)
+
+	log1Ch, err := logEventTriggerService.RegisterTrigger(ctx, th.LogEmitterRegRequest)
+	require.NoError(t, err)
+	emitLogTxnAndWaitForLog(t, th, log1Ch, []*big.Int{big.NewInt(10)})
+	require.Eventually(t, func() bool {
+		return len(store.nonEmpty()) > 0
+	}, tests.WaitTimeout(t), 100*time.Millisecond)
+
+	require.NoError(t, logEventTriggerService.UnregisterTrigger(ctx, th.LogEmitterRegRequest))
+	assert.Empty(t, store.nonEmpty())
+}
+
+type memKVStore struct {
+	mu sync.Mutex
+	m  map[string][]byte
+}
+
+func (s *memKVStore) Store(_ context.Context, key string, val []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[key] = val
+	return nil
+}
+
+func (s *memKVStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	val, ok := s.m[key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", key)
+	}
+	return val, nil
+}
+
+// nonEmpty returns the keys holding a value
+func (s *memKVStore) nonEmpty() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for key, val := range s.m {
+		if len(val) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 // Send a transaction to EmitLog contract to emit Log1 events with given
 // input parameters and wait for those logs to be received from relayer
 // and ContractReader's QueryKey APIs used by Log Event Trigger
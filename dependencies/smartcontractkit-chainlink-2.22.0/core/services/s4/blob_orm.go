@@ -0,0 +1,166 @@
+package s4
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-integrations/evm/utils/big"
+)
+
+const BlobMetadataTableName = "blob_metadata"
+
+// BlobORM is an ORM which keeps payloads in a BlobStore and only their
+// metadata in Postgres, for payloads too large to store in the database.
+type BlobORM interface {
+	ORM
+
+	// GetReferencedHashes returns the hashes of all payloads referenced by
+	// metadata rows, in every namespace. Blobs not in this set may be deleted.
+	GetReferencedHashes(ctx context.Context) (map[string]struct{}, error)
+}
+
+type blobRow struct {
+	Address     *big.Big `db:"address"`
+	SlotId      uint     `db:"slot_id"`
+	Version     uint64   `db:"version"`
+	Expiration  int64    `db:"expiration"`
+	Confirmed   bool     `db:"confirmed"`
+	PayloadHash []byte   `db:"payload_hash"`
+	Signature   []byte   `db:"signature"`
+}
+
+type blobORM struct {
+	ds        sqlutil.DataSource
+	blobs     *BlobStore
+	tableName string
+	namespace string
+}
+
+var _ BlobORM = (*blobORM)(nil)
+
+func NewBlobORM(ds sqlutil.DataSource, blobs *BlobStore, tableName, namespace string) BlobORM {
+	return &blobORM{
+		ds:        ds,
+		blobs:     blobs,
+		tableName: fmt.Sprintf(`"%s".%s`, s4PostgresSchema, tableName),
+		namespace: namespace,
+	}
+}
+
+func (o *blobORM) Get(ctx context.Context, address *big.Big, slotId uint) (*Row, error) {
+	var br blobRow
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload_hash, signature FROM %s
+WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
+	if err := o.ds.GetContext(ctx, &br, stmt, o.namespace, address, slotId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = ErrNotFound
+		}
+		return nil, err
+	}
+	return o.toRow(br)
+}
+
+// Update stores the payload before the metadata, so metadata never references
+// a missing blob. Blobs left behind by rejected updates are removed by the Compactor.
+func (o *blobORM) Update(ctx context.Context, row *Row) error {
+	hash, err := o.blobs.Put(row.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to store payload")
+	}
+
+	// Same versioning semantics as the Postgres ORM.
+	stmt := fmt.Sprintf(`INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload_hash, payload_size, signature, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+ON CONFLICT (namespace, address, slot_id)
+DO UPDATE SET version = EXCLUDED.version,
+expiration = EXCLUDED.expiration,
+confirmed = EXCLUDED.confirmed,
+payload_hash = EXCLUDED.payload_hash,
+payload_size = EXCLUDED.payload_size,
+signature = EXCLUDED.signature,
+updated_at = NOW()
+WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
+RETURNING id;`, o.tableName)
+	var id uint64
+	err = o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, hash, len(row.Payload), row.Signature)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrVersionTooLow
+	}
+	return err
+}
+
+func (o *blobORM) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
+	with := fmt.Sprintf(`WITH rows AS (SELECT id FROM %s WHERE namespace = $1 AND expiration < $2 LIMIT $3)`, o.tableName)
+	stmt := fmt.Sprintf(`%s DELETE FROM %s WHERE id IN (SELECT id FROM rows);`, with, o.tableName)
+	result, err := o.ds.ExecContext(ctx, stmt, o.namespace, utcNow.UnixMilli(), limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (o *blobORM) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
+	rows := make([]*SnapshotRow, 0)
+
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload_size FROM %s WHERE namespace = $1 AND address >= $2 AND address <= $3;`, o.tableName)
+	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, addressRange.MinAddress, addressRange.MaxAddress); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (o *blobORM) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error) {
+	var brs []blobRow
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload_hash, signature FROM %s
+WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.tableName)
+	if err := o.ds.SelectContext(ctx, &brs, stmt, o.namespace, limit); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+	}
+	rows := make([]*Row, 0, len(brs))
+	for _, br := range brs {
+		row, err := o.toRow(br)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (o *blobORM) GetReferencedHashes(ctx context.Context) (map[string]struct{}, error) {
+	var hashes [][]byte
+	stmt := fmt.Sprintf(`SELECT DISTINCT payload_hash FROM %s;`, o.tableName)
+	if err := o.ds.SelectContext(ctx, &hashes, stmt); err != nil {
+		return nil, err
+	}
+	referenced := make(map[string]struct{}, len(hashes))
+	for _, h := range hashes {
+		referenced[string(h)] = struct{}{}
+	}
+	return referenced, nil
+}
+
+func (o *blobORM) toRow(br blobRow) (*Row, error) {
+	payload, err := o.blobs.Get(br.PayloadHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load payload for address %s slot %d", br.Address, br.SlotId)
+	}
+	return &Row{
+		Address:    br.Address,
+		SlotId:     br.SlotId,
+		Payload:    payload,
+		Version:    br.Version,
+		Expiration: br.Expiration,
+		Confirmed:  br.Confirmed,
+		Signature:  br.Signature,
+	}, nil
+}
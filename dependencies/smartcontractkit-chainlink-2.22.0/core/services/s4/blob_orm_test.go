@@ -0,0 +1,118 @@
+package s4_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+func setupBlobORM(t *testing.T, namespace string) (s4.BlobORM, *s4.BlobStore) {
+	t.Helper()
+
+	db := pgtest.NewSqlxDB(t)
+	blobs, err := s4.NewBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return s4.NewBlobORM(db, blobs, s4.BlobMetadataTableName, namespace), blobs
+}
+
+func TestBlobORM_UpdateAndGet(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm, _ := setupBlobORM(t, "test")
+	rows := generateTestRows(t, 10)
+
+	for _, row := range rows {
+		require.NoError(t, orm.Update(ctx, row))
+
+		row.Version++
+		require.NoError(t, orm.Update(ctx, row))
+
+		err := orm.Update(ctx, row)
+		if !row.Confirmed {
+			assert.ErrorIs(t, err, s4.ErrVersionTooLow)
+		}
+	}
+
+	for _, row := range rows {
+		gotRow, err := orm.Get(ctx, row.Address, row.SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, row, gotRow)
+	}
+
+	snapshot, err := orm.GetSnapshot(ctx, s4.NewFullAddressRange())
+	require.NoError(t, err)
+	require.Len(t, snapshot, len(rows))
+	for _, row := range snapshot {
+		assert.Equal(t, uint64(32), row.PayloadSize)
+	}
+
+	unconfirmed, err := orm.GetUnconfirmedRows(ctx, 100)
+	require.NoError(t, err)
+	assert.Len(t, unconfirmed, len(rows)/2)
+
+	_, err = orm.Get(ctx, generateTestRows(t, 1)[0].Address, 1)
+	assert.ErrorIs(t, err, s4.ErrNotFound)
+}
+
+func TestCompactor(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm, blobs := setupBlobORM(t, "test")
+	rows := generateTestRows(t, 3)
+	for _, row := range rows {
+		require.NoError(t, orm.Update(ctx, row))
+	}
+
+	// supersede the payload of the first row
+	superseded := rows[0].Clone()
+	rows[0].Version++
+	rows[0].Payload = []byte("new payload")
+	require.NoError(t, orm.Update(ctx, rows[0]))
+
+	// expire the second row
+	rows[1].Version++
+	rows[1].Expiration = time.Now().Add(-time.Minute).UnixMilli()
+	require.NoError(t, orm.Update(ctx, rows[1]))
+
+	clock := clockwork.NewFakeClockAt(time.Now())
+	compactor := s4.NewCompactor(logger.TestLogger(t), orm, blobs, s4.CompactorConfig{
+		Interval:         time.Hour,
+		MaxDeleteExpired: 100,
+		GracePeriod:      time.Minute,
+	}, clock)
+
+	// recent blobs are protected by the grace period
+	expired, collected, err := compactor.Compact(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), expired)
+	assert.Equal(t, 0, collected)
+
+	clock.Advance(2 * time.Minute)
+	_, collected, err = compactor.Compact(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, collected)
+
+	list, err := blobs.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	supersededHash := sha256.Sum256(superseded.Payload)
+	_, err = blobs.Get(supersededHash[:])
+	assert.ErrorIs(t, err, s4.ErrNotFound)
+	for _, row := range []*s4.Row{rows[0], rows[2]} {
+		got, err := orm.Get(ctx, row.Address, row.SlotId)
+		require.NoError(t, err)
+		assert.Equal(t, row.Payload, got.Payload)
+	}
+}
@@ -0,0 +1,165 @@
+package s4
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// BlobStore is a content-addressed store of payloads on the local filesystem.
+// Blobs are keyed by the SHA-256 hash of their contents, so identical payloads
+// are stored once. Blobs are never modified, only created and deleted.
+// All functions are thread-safe.
+type BlobStore struct {
+	root string
+	// mu serializes Put, which may only refresh the modification time of an
+	// existing blob, against DeleteIfUnmodifiedSince, which relies on it.
+	mu sync.RWMutex
+}
+
+// NewBlobStore returns a BlobStore rooted at the given directory, creating it if needed.
+func NewBlobStore(root string) (*BlobStore, error) {
+	if err := utils.EnsureDirAndMaxPerms(root, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+	return &BlobStore{root: root}, nil
+}
+
+// BlobInfo describes a stored blob.
+type BlobInfo struct {
+	Hash    []byte
+	Size    int64
+	ModTime time.Time
+}
+
+// Put stores payload and returns its hash. Storing an existing payload only
+// refreshes its modification time, which protects it from compaction.
+func (b *BlobStore) Put(payload []byte) ([]byte, error) {
+	sum := sha256.Sum256(payload)
+	hash := sum[:]
+	path := b.path(hash)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err == nil {
+		return hash, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if err := utils.EnsureDirAndMaxPerms(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(payload); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err = tmp.Close(); err != nil {
+		return nil, err
+	}
+	// rename is atomic, so readers never observe a partial blob
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// Get returns the payload with the given hash, or ErrNotFound.
+func (b *BlobStore) Get(hash []byte) ([]byte, error) {
+	payload, err := os.ReadFile(b.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if sum := sha256.Sum256(payload); !bytes.Equal(sum[:], hash) {
+		return nil, fmt.Errorf("blob %x is corrupted", hash)
+	}
+	return payload, nil
+}
+
+// Delete removes the blob with the given hash. Deleting a missing blob is not an error.
+func (b *BlobStore) Delete(hash []byte) error {
+	err := os.Remove(b.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// DeleteIfUnmodifiedSince removes the blob with the given hash, unless it was
+// stored or refreshed by Put after cutoff. It reports whether the blob was removed.
+func (b *BlobStore) DeleteIfUnmodifiedSince(hash []byte, cutoff time.Time) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	path := b.path(hash)
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if info.ModTime().After(cutoff) {
+		return false, nil
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+	return true, nil
+}
+
+// List returns all stored blobs.
+func (b *BlobStore) List() ([]BlobInfo, error) {
+	var blobs []BlobInfo
+	err := filepath.WalkDir(b.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		hash, err := hex.DecodeString(d.Name())
+		if err != nil || len(hash) != sha256.Size {
+			return nil // temp files and strays
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil // deleted concurrently
+		} else if err != nil {
+			return err
+		}
+		blobs = append(blobs, BlobInfo{Hash: hash, Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	return blobs, err
+}
+
+// path shards blobs into directories by the first byte of their hash.
+func (b *BlobStore) path(hash []byte) string {
+	h := hex.EncodeToString(hash)
+	if len(h) < 2 {
+		return filepath.Join(b.root, h)
+	}
+	return filepath.Join(b.root, h[:2], h)
+}
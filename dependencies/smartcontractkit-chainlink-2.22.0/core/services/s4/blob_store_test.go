@@ -0,0 +1,93 @@
+package s4_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
+)
+
+func TestBlobStore(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	blobs, err := s4.NewBlobStore(root)
+	require.NoError(t, err)
+
+	payload := []byte("encrypted config")
+	hash, err := blobs.Put(payload)
+	require.NoError(t, err)
+	sum := sha256.Sum256(payload)
+	assert.Equal(t, sum[:], hash)
+
+	got, err := blobs.Get(hash)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+
+	// identical payloads are stored once
+	hash2, err := blobs.Put(payload)
+	require.NoError(t, err)
+	assert.Equal(t, hash, hash2)
+	_, err = blobs.Put([]byte("another"))
+	require.NoError(t, err)
+
+	list, err := blobs.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	t.Run("corrupted", func(t *testing.T) {
+		h := hex.EncodeToString(hash)
+		require.NoError(t, os.WriteFile(filepath.Join(root, h[:2], h), []byte("tampered"), 0600))
+		_, err := blobs.Get(hash)
+		assert.ErrorContains(t, err, "corrupted")
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, blobs.Delete(hash))
+		require.NoError(t, blobs.Delete(hash))
+		_, err := blobs.Get(hash)
+		assert.ErrorIs(t, err, s4.ErrNotFound)
+
+		list, err := blobs.List()
+		require.NoError(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("delete if unmodified since", func(t *testing.T) {
+		payload := []byte("refreshed")
+		hash, err := blobs.Put(payload)
+		require.NoError(t, err)
+		h := hex.EncodeToString(hash)
+		old := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(root, h[:2], h), old, old))
+		cutoff := time.Now().Add(-time.Minute)
+
+		// storing the payload again refreshes it, so it survives compaction
+		_, err = blobs.Put(payload)
+		require.NoError(t, err)
+		deleted, err := blobs.DeleteIfUnmodifiedSince(hash, cutoff)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		got, err := blobs.Get(hash)
+		require.NoError(t, err)
+		assert.Equal(t, payload, got)
+
+		require.NoError(t, os.Chtimes(filepath.Join(root, h[:2], h), old, old))
+		deleted, err = blobs.DeleteIfUnmodifiedSince(hash, cutoff)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		_, err = blobs.Get(hash)
+		assert.ErrorIs(t, err, s4.ErrNotFound)
+
+		deleted, err = blobs.DeleteIfUnmodifiedSince(hash, cutoff)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+	})
+}
@@ -0,0 +1,99 @@
+package s4
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/timeutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// CompactorConfig configures the Compactor.
+type CompactorConfig struct {
+	// Interval between compactions.
+	Interval time.Duration
+	// MaxDeleteExpired limits the number of expired rows deleted per compaction.
+	MaxDeleteExpired uint
+	// GracePeriod protects recently written blobs, whose metadata may not be
+	// committed yet, from being collected.
+	GracePeriod time.Duration
+}
+
+// Compactor periodically deletes expired rows from a BlobORM and garbage
+// collects blobs which are no longer referenced, because their row expired
+// or was superseded by a newer version.
+type Compactor struct {
+	services.Service
+	eng *services.Engine
+
+	orm   BlobORM
+	blobs *BlobStore
+	cfg   CompactorConfig
+	clock clockwork.Clock
+}
+
+func NewCompactor(lggr logger.Logger, orm BlobORM, blobs *BlobStore, cfg CompactorConfig, clock clockwork.Clock) *Compactor {
+	c := &Compactor{
+		orm:   orm,
+		blobs: blobs,
+		cfg:   cfg,
+		clock: clock,
+	}
+	c.Service, c.eng = services.Config{
+		Name:  "S4Compactor",
+		Start: c.start,
+	}.NewServiceEngine(lggr)
+	return c
+}
+
+func (c *Compactor) start(_ context.Context) error {
+	c.eng.GoTick(timeutil.NewTicker(func() time.Duration { return c.cfg.Interval }), func(ctx context.Context) {
+		if _, _, err := c.Compact(ctx); err != nil {
+			c.eng.Errorw("S4 compaction failed", "err", err)
+		}
+	})
+	return nil
+}
+
+// Compact runs a single compaction, returning the number of deleted expired
+// rows and garbage collected blobs.
+func (c *Compactor) Compact(ctx context.Context) (expired int64, collected int, err error) {
+	expired, err = c.orm.DeleteExpired(ctx, c.cfg.MaxDeleteExpired, c.clock.Now().UTC())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// List blobs before loading references: a blob written after this point is
+	// either not listed, or its reference is loaded below.
+	blobs, err := c.blobs.List()
+	if err != nil {
+		return expired, 0, err
+	}
+	referenced, err := c.orm.GetReferencedHashes(ctx)
+	if err != nil {
+		return expired, 0, err
+	}
+	cutoff := c.clock.Now().Add(-c.cfg.GracePeriod)
+	for _, b := range blobs {
+		if _, ok := referenced[string(b.Hash)]; ok || b.ModTime.After(cutoff) {
+			continue
+		}
+		// The blob may have been stored again since it was listed, ahead of a
+		// row referencing it, so only delete it if Put did not refresh it.
+		deleted, err := c.blobs.DeleteIfUnmodifiedSince(b.Hash, cutoff)
+		if err != nil {
+			return expired, collected, err
+		}
+		if deleted {
+			collected++
+		}
+	}
+	if expired > 0 || collected > 0 {
+		c.eng.Debugw("S4 compaction complete", "expiredRows", expired, "collectedBlobs", collected)
+	}
+	return expired, collected, nil
+}
@@ -16,6 +16,8 @@ type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// MaxBytesPerUser limits the total payload size across all slots of a user. Zero means no limit.
+	MaxBytesPerUser uint64 `json:"maxBytesPerUser"`
 }
 
 // Key identifies a versioned user record.
@@ -61,6 +63,7 @@ type Storage interface {
 
 	// List returns a snapshot for the specified address.
 	// Slots having no data are not returned.
+	// The PayloadSize of the rows adds up to the usage counted towards MaxBytesPerUser.
 	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
 }
 
@@ -146,6 +149,12 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 		return ErrWrongSignature
 	}
 
+	if s.contraints.MaxBytesPerUser > 0 {
+		if err = s.checkQuota(ctx, key, uint64(len(record.Payload)), now); err != nil {
+			return err
+		}
+	}
+
 	row := &Row{
 		Address:    big.New(key.Address.Big()),
 		SlotId:     key.SlotId,
@@ -160,3 +169,24 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 
 	return s.orm.Update(ctx, row)
 }
+
+// checkQuota returns ErrQuotaExceeded if storing a payload of the given size
+// in key's slot would take the user over MaxBytesPerUser.
+// The payload currently in the slot is replaced, so it does not count.
+func (s *storage) checkQuota(ctx context.Context, key *Key, size uint64, now int64) error {
+	rows, err := s.List(ctx, key.Address)
+	if err != nil {
+		return err
+	}
+	used := size
+	for _, row := range rows {
+		if row.SlotId == key.SlotId || row.Expiration <= now {
+			continue
+		}
+		used += row.PayloadSize
+	}
+	if used > s.contraints.MaxBytesPerUser {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
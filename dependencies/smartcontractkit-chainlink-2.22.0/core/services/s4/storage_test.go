@@ -235,3 +235,39 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_Quota(t *testing.T) {
+	t.Parallel()
+
+	ormMock := mocks.NewORM(t)
+	clock := clockwork.NewFakeClock()
+	quotaConstraints := constraints
+	quotaConstraints.MaxBytesPerUser = 40
+	storage := s4.NewStorage(logger.TestLogger(t), quotaConstraints, ormMock, clock)
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	addressRange, err := s4.NewSingleAddressRange(big.New(address.Big()))
+	require.NoError(t, err)
+	now := clock.Now()
+	ormMock.On("GetSnapshot", mock.Anything, addressRange).Return([]*s4.SnapshotRow{
+		{SlotId: 0, Version: 1, Expiration: now.Add(time.Hour).UnixMilli(), PayloadSize: 20},
+		{SlotId: 1, Version: 1, Expiration: now.Add(time.Hour).UnixMilli(), PayloadSize: 10},
+		{SlotId: 2, Version: 1, Expiration: now.Add(-time.Hour).UnixMilli(), PayloadSize: 30},
+	}, nil)
+	ormMock.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	put := func(slotID uint, size int) error {
+		key := &s4.Key{Address: address, SlotId: slotID, Version: 2}
+		record := &s4.Record{Payload: make([]byte, size), Expiration: now.Add(time.Minute).UnixMilli()}
+		signature, err := s4.NewEnvelopeFromRecord(key, record).Sign(privateKey)
+		require.NoError(t, err)
+		return storage.Put(testutils.Context(t), key, record, signature)
+	}
+
+	// expired slots do not count
+	assert.NoError(t, put(3, 10))
+	assert.ErrorIs(t, put(3, 11), s4.ErrQuotaExceeded)
+	// the payload being replaced does not count
+	assert.NoError(t, put(0, 30))
+	assert.ErrorIs(t, put(0, 31), s4.ErrQuotaExceeded)
+}
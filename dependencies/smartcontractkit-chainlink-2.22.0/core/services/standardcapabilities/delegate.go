@@ -50,6 +50,7 @@ type Delegate struct {
 	peerWrapper             *ocrcommon.SingletonPeerWrapper
 	newOracleFactoryFn      NewOracleFactoryFn
 	computeFetcherFactoryFn compute.FetcherFactory
+	computeUsageReporter    compute.UsageReporter
 
 	isNewlyCreatedJob bool
 }
@@ -76,6 +77,7 @@ func NewDelegate(
 	peerWrapper *ocrcommon.SingletonPeerWrapper,
 	newOracleFactoryFn NewOracleFactoryFn,
 	fetcherFactoryFn compute.FetcherFactory,
+	computeUsageReporter compute.UsageReporter,
 ) *Delegate {
 	return &Delegate{
 		logger:                  logger,
@@ -92,6 +94,7 @@ func NewDelegate(
 		peerWrapper:             peerWrapper,
 		newOracleFactoryFn:      newOracleFactoryFn,
 		computeFetcherFactoryFn: fetcherFactoryFn,
+		computeUsageReporter:    computeUsageReporter,
 	}
 }
 
@@ -270,7 +273,11 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 			return nil, errors.New("config is empty")
 		}
 
-		computeSrvc, err := compute.NewAction(cfg, log, d.registry, fetcherFactoryFn)
+		var opts []func(*compute.Compute)
+		if d.computeUsageReporter != nil {
+			opts = append(opts, compute.WithUsageReporter(d.computeUsageReporter))
+		}
+		computeSrvc, err := compute.NewAction(cfg, log, d.registry, fetcherFactoryFn, opts...)
 		if err != nil {
 			return nil, err
 		}
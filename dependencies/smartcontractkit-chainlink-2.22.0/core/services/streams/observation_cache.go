@@ -0,0 +1,114 @@
+package streams
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+var (
+	promObservationCacheHitCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "streams",
+		Subsystem: "observation_cache",
+		Name:      "hit_count",
+		Help:      "Number of observations of a stream that shared a pipeline run started for another observation",
+	},
+		[]string{"streamID"},
+	)
+	promObservationCacheMissCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "streams",
+		Subsystem: "observation_cache",
+		Name:      "miss_count",
+		Help:      "Number of observations of a stream that started a new pipeline run",
+	},
+		[]string{"streamID"},
+	)
+	promObservationCacheStaleness = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "streams",
+		Subsystem: "observation_cache",
+		Name:      "staleness_seconds",
+		Help:      "How long before the observation timestamp the shared pipeline run started",
+		Buckets:   []float64{0, 0.01, 0.025, 0.05, 0.1, 0.15, 0.2, 0.25},
+	},
+		[]string{"streamID"},
+	)
+)
+
+// observationCacheMaxAge is how long before the observation timestamp a
+// pipeline run may have started for an observation to use its results.
+const observationCacheMaxAge = 250 * time.Millisecond
+
+// CachingPipeline is a Pipeline whose runs are shared by observations, e.g.
+// by the concurrent rounds of several jobs using the same streams.
+type CachingPipeline interface {
+	Pipeline
+	// RunCached returns the results of the latest run of the pipeline if it
+	// started no more than observationCacheMaxAge before the observation
+	// timestamp, waiting for it to finish if needed. Otherwise it runs the
+	// pipeline, and concurrent calls wait for that run instead of starting
+	// their own.
+	RunCached(ctx context.Context, streamID StreamID, observationTimestamp time.Time) (*pipeline.Run, pipeline.TaskRunResults, error)
+}
+
+var _ CachingPipeline = (*multiStreamPipeline)(nil)
+
+type cachedRun struct {
+	startedAt time.Time
+	done      chan struct{}
+
+	run  *pipeline.Run
+	trrs pipeline.TaskRunResults
+	err  error
+}
+
+// observationCache holds the latest run of a pipeline.
+type observationCache struct {
+	maxAge time.Duration
+
+	mu     sync.Mutex
+	latest *cachedRun
+}
+
+func (s *multiStreamPipeline) RunCached(ctx context.Context, streamID StreamID, observationTimestamp time.Time) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	strmIDStr := strconv.FormatUint(uint64(streamID), 10)
+	if observationTimestamp.IsZero() {
+		observationTimestamp = time.Now()
+	}
+
+	c := &s.cache
+	c.mu.Lock()
+	if cr := c.latest; cr != nil && !cr.startedAt.Before(observationTimestamp.Add(-c.maxAge)) {
+		c.mu.Unlock()
+		select {
+		case <-cr.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		promObservationCacheHitCount.WithLabelValues(strmIDStr).Inc()
+		promObservationCacheStaleness.WithLabelValues(strmIDStr).Observe(max(0, observationTimestamp.Sub(cr.startedAt).Seconds()))
+		return cr.run, cr.trrs, cr.err
+	}
+	cr := &cachedRun{startedAt: time.Now(), done: make(chan struct{})}
+	c.latest = cr
+	c.mu.Unlock()
+
+	promObservationCacheMissCount.WithLabelValues(strmIDStr).Inc()
+	cr.run, cr.trrs, cr.err = s.Run(ctx)
+	close(cr.done)
+
+	if cr.err != nil {
+		// only observations already waiting for it share a failed run
+		c.mu.Lock()
+		if c.latest == cr {
+			c.latest = nil
+		}
+		c.mu.Unlock()
+	}
+	return cr.run, cr.trrs, cr.err
+}
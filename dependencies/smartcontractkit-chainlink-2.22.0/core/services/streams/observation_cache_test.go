@@ -0,0 +1,110 @@
+package streams
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// blockingRunner counts its runs, which block until release is closed.
+type blockingRunner struct {
+	mockRunner
+	runs    atomic.Int64
+	release chan struct{}
+}
+
+func (m *blockingRunner) ExecuteRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	n := m.runs.Add(1)
+	select {
+	case <-m.release:
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+	return &pipeline.Run{ID: n}, nil, m.err
+}
+
+func Test_multiStreamPipeline_RunCached(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	jb := job.Job{StreamID: ptr(StreamID(123)), PipelineSpec: &pipeline.Spec{DotDagSource: `
+succeed             [type=memo value=42 streamID=124];
+succeed;
+	`}}
+
+	t.Run("concurrent observations share one run", func(t *testing.T) {
+		runner := &blockingRunner{release: make(chan struct{})}
+		strm, err := newMultiStreamPipeline(lggr, jb, runner, nil)
+		require.NoError(t, err)
+
+		ts := time.Now()
+		var wg sync.WaitGroup
+		runIDs := make([]int64, 10)
+		for i := range runIDs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				run, _, err := strm.RunCached(ctx, 123, ts)
+				assert.NoError(t, err)
+				runIDs[i] = run.ID
+			}()
+		}
+		require.Eventually(t, func() bool { return runner.runs.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		close(runner.release)
+		wg.Wait()
+
+		assert.Equal(t, int64(1), runner.runs.Load())
+		for _, id := range runIDs {
+			assert.Equal(t, int64(1), id)
+		}
+
+		// later observations within the max age reuse the finished run
+		run, _, err := strm.RunCached(ctx, 124, ts.Add(observationCacheMaxAge/2))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), run.ID)
+		assert.Equal(t, int64(1), runner.runs.Load())
+	})
+
+	t.Run("observations after the max age run the pipeline again", func(t *testing.T) {
+		runner := &blockingRunner{release: make(chan struct{})}
+		close(runner.release)
+		strm, err := newMultiStreamPipeline(lggr, jb, runner, nil)
+		require.NoError(t, err)
+
+		ts := time.Now()
+		run, _, err := strm.RunCached(ctx, 123, ts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), run.ID)
+
+		run, _, err = strm.RunCached(ctx, 123, ts.Add(2*observationCacheMaxAge))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), run.ID)
+	})
+
+	t.Run("failed runs are not reused", func(t *testing.T) {
+		runner := &blockingRunner{release: make(chan struct{})}
+		close(runner.release)
+		runner.err = errors.New("something exploded")
+		strm, err := newMultiStreamPipeline(lggr, jb, runner, nil)
+		require.NoError(t, err)
+
+		ts := time.Now()
+		_, _, err = strm.RunCached(ctx, 123, ts)
+		require.EqualError(t, err, "Run failed: error executing run for spec ID 0: something exploded")
+
+		runner.err = nil
+		run, _, err := strm.RunCached(ctx, 123, ts)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), run.ID)
+	})
+}
@@ -32,6 +32,8 @@ type multiStreamPipeline struct {
 	rrs       RunResultSaver
 	streamIDs []StreamID
 	newVars   func() pipeline.Vars
+
+	cache observationCache
 }
 
 func NewMultiStreamPipeline(lggr logger.Logger, jb job.Job, runner Runner, rrs RunResultSaver) (Pipeline, error) {
@@ -90,7 +92,9 @@ func newMultiStreamPipeline(lggr logger.Logger, jb job.Job, runner Runner, rrs R
 		runner,
 		rrs,
 		streamIDs,
-		vars}, nil
+		vars,
+		observationCache{maxAge: observationCacheMaxAge},
+	}, nil
 }
 
 func validateStreamIDs(streamIDs []StreamID) error {
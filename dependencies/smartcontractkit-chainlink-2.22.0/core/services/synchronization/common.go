@@ -31,6 +31,8 @@ const (
 	OCR3CCIPBootstrap TelemetryType = "ocr3-bootstrap"
 	HeadReport        TelemetryType = "head-report"
 
+	MedianSourceHealth TelemetryType = "median-source-health"
+
 	PipelineBridge TelemetryType = "pipeline-bridge"
 	LLOObservation TelemetryType = "llo-observation"
 	LLOOutcome     TelemetryType = "llo-outcome"
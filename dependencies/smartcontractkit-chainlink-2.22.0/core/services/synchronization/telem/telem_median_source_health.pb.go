@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.0
+// source: core/services/synchronization/telem/telem_median_source_health.proto
+
+package telem
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MedianSourceHealth is sent every round by OCR2 median jobs tracking the
+// health of the sources of their median tasks.
+type MedianSourceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources []*MedianSourceScore `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *MedianSourceHealth) Reset() {
+	*x = MedianSourceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedianSourceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedianSourceHealth) ProtoMessage() {}
+
+func (x *MedianSourceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedianSourceHealth.ProtoReflect.Descriptor instead.
+func (*MedianSourceHealth) Descriptor() ([]byte, []int) {
+	return file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MedianSourceHealth) GetSources() []*MedianSourceScore {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type MedianSourceScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Median    string  `protobuf:"bytes,1,opt,name=median,proto3" json:"median,omitempty"`
+	Source    string  `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Score     float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	Rounds    int64   `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Unhealthy bool    `protobuf:"varint,5,opt,name=unhealthy,proto3" json:"unhealthy,omitempty"`
+	Excluded  bool    `protobuf:"varint,6,opt,name=excluded,proto3" json:"excluded,omitempty"`
+}
+
+func (x *MedianSourceScore) Reset() {
+	*x = MedianSourceScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedianSourceScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedianSourceScore) ProtoMessage() {}
+
+func (x *MedianSourceScore) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedianSourceScore.ProtoReflect.Descriptor instead.
+func (*MedianSourceScore) Descriptor() ([]byte, []int) {
+	return file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MedianSourceScore) GetMedian() string {
+	if x != nil {
+		return x.Median
+	}
+	return ""
+}
+
+func (x *MedianSourceScore) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MedianSourceScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MedianSourceScore) GetRounds() int64 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *MedianSourceScore) GetUnhealthy() bool {
+	if x != nil {
+		return x.Unhealthy
+	}
+	return false
+}
+
+func (x *MedianSourceScore) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+var File_core_services_synchronization_telem_telem_median_source_health_proto protoreflect.FileDescriptor
+
+var file_core_services_synchronization_telem_telem_median_source_health_proto_rawDesc = []byte{
+	0x0a, 0x44, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x22, 0x48, 0x0a,
+	0x12, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2e, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x76,
+	0x32, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x74, 0x65, 0x6c, 0x65, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescOnce sync.Once
+	file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescData = file_core_services_synchronization_telem_telem_median_source_health_proto_rawDesc
+)
+
+func file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescGZIP() []byte {
+	file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescOnce.Do(func() {
+		file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescData)
+	})
+	return file_core_services_synchronization_telem_telem_median_source_health_proto_rawDescData
+}
+
+var file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_core_services_synchronization_telem_telem_median_source_health_proto_goTypes = []any{
+	(*MedianSourceHealth)(nil), // 0: telem.MedianSourceHealth
+	(*MedianSourceScore)(nil),  // 1: telem.MedianSourceScore
+}
+var file_core_services_synchronization_telem_telem_median_source_health_proto_depIdxs = []int32{
+	1, // 0: telem.MedianSourceHealth.sources:type_name -> telem.MedianSourceScore
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_core_services_synchronization_telem_telem_median_source_health_proto_init() }
+func file_core_services_synchronization_telem_telem_median_source_health_proto_init() {
+	if File_core_services_synchronization_telem_telem_median_source_health_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MedianSourceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MedianSourceScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_core_services_synchronization_telem_telem_median_source_health_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_core_services_synchronization_telem_telem_median_source_health_proto_goTypes,
+		DependencyIndexes: file_core_services_synchronization_telem_telem_median_source_health_proto_depIdxs,
+		MessageInfos:      file_core_services_synchronization_telem_telem_median_source_health_proto_msgTypes,
+	}.Build()
+	File_core_services_synchronization_telem_telem_median_source_health_proto = out.File
+	file_core_services_synchronization_telem_telem_median_source_health_proto_rawDesc = nil
+	file_core_services_synchronization_telem_telem_median_source_health_proto_goTypes = nil
+	file_core_services_synchronization_telem_telem_median_source_health_proto_depIdxs = nil
+}
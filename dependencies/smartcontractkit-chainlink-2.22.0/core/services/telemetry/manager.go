@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/libocr/commontypes"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/beholder"
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	common "github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -30,6 +31,7 @@ type Manager struct {
 	sendTimeout                 time.Duration
 	uniConn                     bool
 	useBatchSend                bool
+	otlpExporter                *OTLPExporter
 	MonitoringEndpointGenerator MonitoringEndpointGenerator
 }
 
@@ -53,6 +55,14 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 		uniConn:      cfg.UniConn(),
 		useBatchSend: cfg.UseBatchSend(),
 	}
+	if cfg.OTLPExport() {
+		exporter, err := NewOTLPExporter(beholder.GetLogger(), beholder.GetMeter(), lggr)
+		if err != nil {
+			lggr.Errorw("Failed to create OTLP exporter, telemetry will only be sent to the ingress", "err", err)
+		} else {
+			m.otlpExporter = exporter
+		}
+	}
 	m.Service, m.eng = services.Config{
 		Name: "TelemetryManager",
 		NewSubServices: func(lggr common.Logger) (subs []services.Service) {
@@ -71,34 +81,55 @@ func NewManager(cfg config.TelemetryIngress, csaKeyStore keystore.CSA, lggr logg
 }
 
 // GenMonitoringEndpoint creates a new monitoring endpoints based on the existing available endpoints defined in the core config TOML, if no endpoint for the network and chainID exists, a NOOP agent will be used and the telemetry will not be sent
+// When OTLP export is enabled, the telemetry is also exported to the OTLP collector, including for networks without an endpoint
 func (m *Manager) GenMonitoringEndpoint(network string, chainID string, contractID string, telemType synchronization.TelemetryType) commontypes.MonitoringEndpoint {
 	e, found := m.getEndpoint(network, chainID)
 
 	if !found {
+		if m.otlpExporter != nil {
+			m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry %q for contractID %q will NOT be sent to the ingress, only exported to the OTLP collector", network, chainID, telemType, contractID)
+			return NewOTLPAgent(m.otlpExporter, network, chainID, contractID, telemType)
+		}
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry %q for contractID %q will NOT be sent", network, chainID, telemType, contractID)
 		return &NoopAgent{}
 	}
 
+	var agent commontypes.MonitoringEndpoint
 	if m.useBatchSend {
-		return NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+		agent = NewTypedIngressAgentBatch(e.client, network, chainID, contractID, telemType)
+	} else {
+		agent = NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
 	}
 
-	return NewTypedIngressAgent(e.client, network, chainID, contractID, telemType)
+	if m.otlpExporter != nil {
+		return teeEndpoint{agent, NewOTLPAgent(m.otlpExporter, network, chainID, contractID, telemType)}
+	}
+	return agent
 }
 
 func (m *Manager) GenMultitypeMonitoringEndpoint(network string, chainID string, contractID string) MultitypeMonitoringEndpoint {
 	e, found := m.getEndpoint(network, chainID)
 
 	if !found {
+		if m.otlpExporter != nil {
+			m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry for contractID %q will NOT be sent to the ingress, only exported to the OTLP collector", network, chainID, contractID)
+			return NewOTLPAgent(m.otlpExporter, network, chainID, contractID, "")
+		}
 		m.eng.Warnf("no telemetry endpoint found for network %q chainID %q, telemetry for contractID %q will NOT be sent", network, chainID, contractID)
 		return &NoopAgent{}
 	}
 
+	var agent MultitypeMonitoringEndpoint
 	if m.useBatchSend {
-		return NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+		agent = NewMultiIngressAgentBatch(e.client, network, chainID, contractID)
+	} else {
+		agent = NewMultiIngressAgent(e.client, network, chainID, contractID)
 	}
 
-	return NewMultiIngressAgent(e.client, network, chainID, contractID)
+	if m.otlpExporter != nil {
+		return teeMultitypeEndpoint{agent, NewOTLPAgent(m.otlpExporter, network, chainID, contractID, "")}
+	}
+	return agent
 }
 
 func (m *Manager) newEndpoint(e config.TelemetryIngressEndpoint, lggr logger.Logger, cfg config.TelemetryIngress) (services.Service, error) {
@@ -24,7 +24,7 @@ import (
 	mocks2 "github.com/smartcontractkit/chainlink/v2/core/services/synchronization/mocks"
 )
 
-func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
+func setupMockConfig(t *testing.T, useBatchSend bool, otlpExport bool) *mocks.TelemetryIngress {
 	tic := mocks.NewTelemetryIngress(t)
 	tic.On("BufferSize").Return(uint(123))
 	tic.On("Logging").Return(true)
@@ -33,12 +33,13 @@ func setupMockConfig(t *testing.T, useBatchSend bool) *mocks.TelemetryIngress {
 	tic.On("SendTimeout").Return(time.Second * 7)
 	tic.On("UniConn").Return(true)
 	tic.On("UseBatchSend").Return(useBatchSend)
+	tic.On("OTLPExport").Return(otlpExport)
 
 	return tic
 }
 
 func TestManagerAgents(t *testing.T) {
-	tic := setupMockConfig(t, true)
+	tic := setupMockConfig(t, true, false)
 	te := mocks.NewTelemetryIngressEndpoint(t)
 	te.On("Network").Return("network-1")
 	te.On("ChainID").Return("network-1-chainID-1")
@@ -56,7 +57,7 @@ func TestManagerAgents(t *testing.T) {
 	me := tm.GenMonitoringEndpoint("network-1", "network-1-chainID-1", "", "")
 	assert.Equal(t, "*telemetry.TypedIngressAgentBatch", reflect.TypeOf(me).String())
 
-	tic = setupMockConfig(t, false)
+	tic = setupMockConfig(t, false, false)
 	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te})
 	tm = NewManager(tic, ks, lggr)
 	require.Equal(t, "*synchronization.telemetryIngressClient", reflect.TypeOf(tm.endpoints[0].client).String())
@@ -64,6 +65,35 @@ func TestManagerAgents(t *testing.T) {
 	assert.Equal(t, "*telemetry.TypedIngressAgent", reflect.TypeOf(me).String())
 }
 
+func TestManagerOTLPExport(t *testing.T) {
+	tic := setupMockConfig(t, false, true)
+	te := mocks.NewTelemetryIngressEndpoint(t)
+	te.On("Network").Return("network-1")
+	te.On("ChainID").Return("network-1-chainID-1")
+	te.On("ServerPubKey").Return("some-pubkey")
+	u, _ := url.Parse("http://some-url.test")
+	te.On("URL").Return(u)
+	tic.On("Endpoints").Return([]config.TelemetryIngressEndpoint{te})
+
+	lggr, obsLogs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
+
+	tm := NewManager(tic, mocks3.NewCSA(t), lggr)
+	require.NotNil(t, tm.otlpExporter)
+
+	// telemetry for networks with an endpoint is also exported over OTLP
+	me := tm.GenMonitoringEndpoint("network-1", "network-1-chainID-1", "", "")
+	assert.Equal(t, "telemetry.teeEndpoint", reflect.TypeOf(me).String())
+	mme := tm.GenMultitypeMonitoringEndpoint("network-1", "network-1-chainID-1", "")
+	assert.Equal(t, "telemetry.teeMultitypeEndpoint", reflect.TypeOf(mme).String())
+
+	// telemetry for other networks is only exported over OTLP
+	me = tm.GenMonitoringEndpoint("unknown-network", "unknown-chainID", "", "")
+	assert.Equal(t, "*telemetry.OTLPAgent", reflect.TypeOf(me).String())
+	mme = tm.GenMultitypeMonitoringEndpoint("unknown-network", "unknown-chainID", "")
+	assert.Equal(t, "*telemetry.OTLPAgent", reflect.TypeOf(mme).String())
+	assert.Equal(t, 0, obsLogs.FilterMessageSnippet("no telemetry endpoint found").Len())
+}
+
 func TestNewManager(t *testing.T) {
 	type endpointTest struct {
 		network       string
@@ -144,7 +174,7 @@ func TestNewManager(t *testing.T) {
 		mockEndpoints = append(mockEndpoints, te)
 	}
 
-	tic := setupMockConfig(t, true)
+	tic := setupMockConfig(t, true, false)
 	tic.On("Endpoints").Return(mockEndpoints)
 
 	lggr, logObs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
@@ -196,7 +226,7 @@ func TestNewManager(t *testing.T) {
 }
 
 func TestCorrectEndpointRouting(t *testing.T) {
-	tic := setupMockConfig(t, true)
+	tic := setupMockConfig(t, true, false)
 	tic.On("Endpoints").Return(nil)
 
 	lggr, obsLogs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
@@ -0,0 +1,227 @@
+package telemetry
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	datastreamsllo "github.com/smartcontractkit/chainlink-data-streams/llo"
+
+	llotelem "github.com/smartcontractkit/chainlink/v2/core/services/llo/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+// OTLPExporter converts job telemetry into OTLP log records and metrics, which
+// are sent to the OpenTelemetry collector configured in [Telemetry], so that
+// telemetry can be collected without a telemetry ingress.
+//
+// Every payload is exported as a log record. The payloads of the telemetry
+// types defined in this repository and in chainlink-data-streams are decoded
+// into a JSON body, and enhanced EA, mercury EA, LLO observation, bridge and
+// median source health payloads are also recorded as metrics. OCR, OCR2 and
+// OCR3 telemetry is exported as raw protobuf bytes, as its TelemetryWrapper
+// message is internal to libocr.
+type OTLPExporter struct {
+	lggr   logger.Logger
+	logger otellog.Logger
+
+	messages          metric.Int64Counter
+	values            metric.Float64Gauge
+	bridgeLatency     metric.Int64Histogram
+	observationErrors metric.Int64Counter
+	sourceScores      metric.Float64Gauge
+}
+
+// NewOTLPExporter creates an OTLPExporter emitting to the given OpenTelemetry
+// logger and meter.
+func NewOTLPExporter(otelLogger otellog.Logger, meter metric.Meter, lggr logger.Logger) (*OTLPExporter, error) {
+	e := &OTLPExporter{lggr: logger.Named(lggr, "OTLPExporter"), logger: otelLogger}
+	var err error
+	if e.messages, err = meter.Int64Counter("job_telemetry_messages",
+		metric.WithDescription("The number of job telemetry messages exported")); err != nil {
+		return nil, errors.Wrap(err, "failed to create job_telemetry_messages counter")
+	}
+	if e.values, err = meter.Float64Gauge("job_telemetry_ea_value",
+		metric.WithDescription("The latest value returned by an external adapter")); err != nil {
+		return nil, errors.Wrap(err, "failed to create job_telemetry_ea_value gauge")
+	}
+	if e.bridgeLatency, err = meter.Int64Histogram("job_telemetry_bridge_latency",
+		metric.WithDescription("How long bridge requests to external adapters took"), metric.WithUnit("ms")); err != nil {
+		return nil, errors.Wrap(err, "failed to create job_telemetry_bridge_latency histogram")
+	}
+	if e.observationErrors, err = meter.Int64Counter("job_telemetry_observation_errors",
+		metric.WithDescription("The number of LLO stream observations which failed")); err != nil {
+		return nil, errors.Wrap(err, "failed to create job_telemetry_observation_errors counter")
+	}
+	if e.sourceScores, err = meter.Float64Gauge("job_telemetry_median_source_score",
+		metric.WithDescription("The health score of a source of a median task")); err != nil {
+		return nil, errors.Wrap(err, "failed to create job_telemetry_median_source_score gauge")
+	}
+	return e, nil
+}
+
+// Export sends a telemetry payload as an OTLP log record and records the
+// metrics derived from it.
+func (e *OTLPExporter) Export(ctx context.Context, network, chainID, contractID string, telemType synchronization.TelemetryType, payload []byte) {
+	attrs := []attribute.KeyValue{
+		attribute.String("telemetry_type", string(telemType)),
+		attribute.String("network", network),
+		attribute.String("chain_id", chainID),
+		attribute.String("contract_id", contractID),
+	}
+
+	var record otellog.Record
+	record.SetTimestamp(time.Now())
+	record.SetSeverity(otellog.SeverityInfo)
+	for _, a := range attrs {
+		record.AddAttributes(otellog.String(string(a.Key), a.Value.AsString()))
+	}
+
+	msg, err := decodeTelemetry(telemType, payload)
+	if err != nil {
+		e.lggr.Debugw("Failed to decode telemetry, exporting raw payload", "telemType", telemType, "err", err)
+	}
+	if msg != nil {
+		body, err := protojson.Marshal(msg)
+		if err != nil {
+			e.lggr.Debugw("Failed to marshal telemetry, exporting raw payload", "telemType", telemType, "err", err)
+			msg = nil
+		} else {
+			record.SetBody(otellog.StringValue(string(body)))
+		}
+	}
+	if msg == nil {
+		record.SetBody(otellog.BytesValue(payload))
+	}
+
+	e.logger.Emit(ctx, record)
+	e.messages.Add(ctx, 1, metric.WithAttributes(attrs...))
+	e.recordMetrics(ctx, msg, attrs)
+}
+
+func (e *OTLPExporter) recordMetrics(ctx context.Context, msg proto.Message, attrs []attribute.KeyValue) {
+	switch v := msg.(type) {
+	case *telem.EnhancedEA:
+		attrs = append(attrs, attribute.String("data_source", v.DataSource), attribute.String("feed", v.Feed))
+		e.values.Record(ctx, v.Value, metric.WithAttributes(attrs...))
+		e.recordBridgeLatency(ctx, v.BridgeTaskRunEndedTimestamp-v.BridgeTaskRunStartedTimestamp, attrs)
+	case *telem.EnhancedEAMercury:
+		attrs = append(attrs, attribute.String("data_source", v.DataSource), attribute.String("feed", v.Feed))
+		e.values.Record(ctx, v.DpBenchmarkPrice, metric.WithAttributes(attrs...))
+		e.recordBridgeLatency(ctx, v.BridgeTaskRunEndedTimestamp-v.BridgeTaskRunStartedTimestamp, attrs)
+	case *llotelem.LLOBridgeTelemetry:
+		attrs = append(attrs, attribute.String("data_source", v.BridgeAdapterName))
+		e.recordBridgeLatency(ctx, time.Duration(v.RequestFinishTimestamp-v.RequestStartTimestamp).Milliseconds(), attrs)
+	case *llotelem.LLOObservationTelemetry:
+		if v.ObservationError != nil {
+			attrs = append(attrs, attribute.String("stream_id", strconv.FormatUint(uint64(v.StreamId), 10)))
+			e.observationErrors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+	case *telem.MedianSourceHealth:
+		for _, source := range v.Sources {
+			sourceAttrs := append(slices.Clip(attrs), attribute.String("median", source.Median), attribute.String("source", source.Source))
+			e.sourceScores.Record(ctx, source.Score, metric.WithAttributes(sourceAttrs...))
+		}
+	}
+}
+
+func (e *OTLPExporter) recordBridgeLatency(ctx context.Context, latencyMs int64, attrs []attribute.KeyValue) {
+	if latencyMs < 0 {
+		return
+	}
+	e.bridgeLatency.Record(ctx, latencyMs, metric.WithAttributes(attrs...))
+}
+
+// decodeTelemetry decodes the payloads of the telemetry types exported with a
+// JSON body. It returns a nil message for other types.
+func decodeTelemetry(telemType synchronization.TelemetryType, payload []byte) (proto.Message, error) {
+	var msg proto.Message
+	switch telemType {
+	case synchronization.EnhancedEA:
+		msg = &telem.EnhancedEA{}
+	case synchronization.EnhancedEAMercury:
+		msg = &telem.EnhancedEAMercury{}
+	case synchronization.PipelineBridge:
+		msg = &llotelem.LLOBridgeTelemetry{}
+	case synchronization.LLOObservation:
+		msg = &llotelem.LLOObservationTelemetry{}
+	case synchronization.LLOOutcome:
+		msg = &datastreamsllo.LLOOutcomeTelemetry{}
+	case synchronization.LLOReport:
+		msg = &datastreamsllo.LLOReportTelemetry{}
+	case synchronization.MedianSourceHealth:
+		msg = &telem.MedianSourceHealth{}
+	case synchronization.HeadReport:
+		msg = &telem.HeadReportRequest{}
+	case synchronization.FunctionsRequests:
+		msg = &telem.FunctionsRequest{}
+	case synchronization.AutomationCustom:
+		msg = &telem.AutomationTelemWrapper{}
+	default:
+		return nil, nil
+	}
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// OTLPAgent is a monitoring endpoint exporting the telemetry of a contract
+// through an OTLPExporter.
+type OTLPAgent struct {
+	exporter   *OTLPExporter
+	network    string
+	chainID    string
+	contractID string
+	telemType  synchronization.TelemetryType
+}
+
+func NewOTLPAgent(exporter *OTLPExporter, network string, chainID string, contractID string, telemType synchronization.TelemetryType) *OTLPAgent {
+	return &OTLPAgent{
+		exporter:   exporter,
+		network:    network,
+		chainID:    chainID,
+		contractID: contractID,
+		telemType:  telemType,
+	}
+}
+
+// SendLog exports a telemetry log of the agent's type
+func (t *OTLPAgent) SendLog(telemetry []byte) {
+	t.exporter.Export(context.Background(), t.network, t.chainID, t.contractID, t.telemType, telemetry)
+}
+
+// SendTypedLog exports a telemetry log of the given type
+func (t *OTLPAgent) SendTypedLog(telemType synchronization.TelemetryType, telemetry []byte) {
+	t.exporter.Export(context.Background(), t.network, t.chainID, t.contractID, telemType, telemetry)
+}
+
+// teeEndpoint sends telemetry to each of its endpoints, so that it's exported
+// over OTLP as well as sent to the ingress.
+type teeEndpoint []commontypes.MonitoringEndpoint
+
+func (t teeEndpoint) SendLog(log []byte) {
+	for _, e := range t {
+		e.SendLog(log)
+	}
+}
+
+// teeMultitypeEndpoint is the MultitypeMonitoringEndpoint counterpart of teeEndpoint.
+type teeMultitypeEndpoint []MultitypeMonitoringEndpoint
+
+func (t teeMultitypeEndpoint) SendTypedLog(telemType synchronization.TelemetryType, log []byte) {
+	for _, e := range t {
+		e.SendTypedLog(telemType, log)
+	}
+}
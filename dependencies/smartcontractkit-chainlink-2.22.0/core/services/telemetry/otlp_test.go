@@ -0,0 +1,118 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	llotelem "github.com/smartcontractkit/chainlink/v2/core/services/llo/telem"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization"
+	"github.com/smartcontractkit/chainlink/v2/core/services/synchronization/telem"
+)
+
+type recordingLogger struct {
+	embedded.Logger
+	records []otellog.Record
+}
+
+func (l *recordingLogger) Emit(_ context.Context, record otellog.Record) {
+	l.records = append(l.records, record)
+}
+
+func (l *recordingLogger) Enabled(context.Context, otellog.EnabledParameters) bool {
+	return true
+}
+
+func recordAttributes(record otellog.Record) map[string]string {
+	attrs := map[string]string{}
+	record.WalkAttributes(func(kv otellog.KeyValue) bool {
+		attrs[kv.Key] = kv.Value.AsString()
+		return true
+	})
+	return attrs
+}
+
+func collectMetrics(t *testing.T, reader sdkmetric.Reader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(testutils.Context(t), &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func TestOTLPExporter(t *testing.T) {
+	otelLogger := &recordingLogger{}
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+	exporter, err := NewOTLPExporter(otelLogger, meter, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	ea, err := proto.Marshal(&telem.EnhancedEA{
+		DataSource:                    "data-source",
+		Value:                         123.5,
+		BridgeTaskRunStartedTimestamp: 1000,
+		BridgeTaskRunEndedTimestamp:   1250,
+		Feed:                          "0xfeed",
+	})
+	require.NoError(t, err)
+	observation, err := proto.Marshal(&llotelem.LLOObservationTelemetry{StreamId: 7, ObservationError: proto.String("boom")})
+	require.NoError(t, err)
+	sourceHealth, err := proto.Marshal(&telem.MedianSourceHealth{Sources: []*telem.MedianSourceScore{
+		{Median: "answer", Source: "ds1", Score: 0.75, Rounds: 4},
+	}})
+	require.NoError(t, err)
+
+	NewOTLPAgent(exporter, "EVM", "1", "0xcontract", synchronization.EnhancedEA).SendLog(ea)
+	agent := NewOTLPAgent(exporter, "EVM", "1", "0xcontract", "")
+	agent.SendTypedLog(synchronization.LLOObservation, observation)
+	agent.SendTypedLog(synchronization.OCR, []byte{1, 2, 3})
+	agent.SendTypedLog(synchronization.MedianSourceHealth, sourceHealth)
+
+	require.Len(t, otelLogger.records, 4)
+	assert.Equal(t, map[string]string{
+		"telemetry_type": "enhanced-ea",
+		"network":        "EVM",
+		"chain_id":       "1",
+		"contract_id":    "0xcontract",
+	}, recordAttributes(otelLogger.records[0]))
+	assert.Equal(t, otellog.KindString, otelLogger.records[0].Body().Kind())
+	assert.Contains(t, otelLogger.records[0].Body().AsString(), `"dataSource":"data-source"`)
+	assert.Equal(t, "llo-observation", recordAttributes(otelLogger.records[1])["telemetry_type"])
+	assert.Contains(t, otelLogger.records[1].Body().AsString(), `"observationError":"boom"`)
+	// payloads which aren't decoded are exported as is
+	assert.Equal(t, otellog.KindBytes, otelLogger.records[2].Body().Kind())
+	assert.Equal(t, []byte{1, 2, 3}, otelLogger.records[2].Body().AsBytes())
+	assert.Contains(t, otelLogger.records[3].Body().AsString(), `"source":"ds1"`)
+
+	metrics := collectMetrics(t, reader)
+	messages := metrics["job_telemetry_messages"].(metricdata.Sum[int64])
+	assert.Len(t, messages.DataPoints, 4)
+	values := metrics["job_telemetry_ea_value"].(metricdata.Gauge[float64])
+	require.Len(t, values.DataPoints, 1)
+	assert.InDelta(t, 123.5, values.DataPoints[0].Value, 0)
+	latency := metrics["job_telemetry_bridge_latency"].(metricdata.Histogram[int64])
+	require.Len(t, latency.DataPoints, 1)
+	assert.Equal(t, int64(250), latency.DataPoints[0].Sum)
+	observationErrors := metrics["job_telemetry_observation_errors"].(metricdata.Sum[int64])
+	require.Len(t, observationErrors.DataPoints, 1)
+	assert.Equal(t, int64(1), observationErrors.DataPoints[0].Value)
+	sourceScores := metrics["job_telemetry_median_source_score"].(metricdata.Gauge[float64])
+	require.Len(t, sourceScores.DataPoints, 1)
+	assert.InDelta(t, 0.75, sourceScores.DataPoints[0].Value, 0)
+	source, ok := sourceScores.DataPoints[0].Attributes.Value("source")
+	require.True(t, ok)
+	assert.Equal(t, "ds1", source.AsString())
+}
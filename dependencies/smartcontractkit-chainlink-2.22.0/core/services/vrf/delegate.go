@@ -40,6 +40,8 @@ type Delegate struct {
 	legacyChains legacyevm.LegacyChainContainer
 	lggr         logger.Logger
 	mailMon      *mailbox.Monitor
+
+	subscriptions *vrfcommon.SubscriptionBalances
 }
 
 func NewDelegate(
@@ -49,15 +51,17 @@ func NewDelegate(
 	porm pipeline.ORM,
 	legacyChains legacyevm.LegacyChainContainer,
 	lggr logger.Logger,
-	mailMon *mailbox.Monitor) *Delegate {
+	mailMon *mailbox.Monitor,
+	subscriptions *vrfcommon.SubscriptionBalances) *Delegate {
 	return &Delegate{
-		ds:           ds,
-		ks:           ks,
-		pr:           pr,
-		porm:         porm,
-		legacyChains: legacyChains,
-		lggr:         lggr.Named("VRF"),
-		mailMon:      mailMon,
+		ds:            ds,
+		ks:            ks,
+		pr:            pr,
+		porm:          porm,
+		legacyChains:  legacyChains,
+		lggr:          lggr.Named("VRF"),
+		mailMon:       mailMon,
+		subscriptions: subscriptions,
 	}
 }
 
@@ -195,6 +199,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 					// otherwise we will end up re-delivering logs that were already delivered.
 					vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 					vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+					d.subscriptions,
 				),
 			}, nil
 		}
@@ -249,6 +254,7 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, jb job.Job) ([]job.Servi
 				// otherwise we will end up re-delivering logs that were already delivered.
 				vrfcommon.NewInflightCache(int(chain.Config().EVM().FinalityDepth())),
 				vrfcommon.NewLogDeduper(int(chain.Config().EVM().FinalityDepth())),
+				d.subscriptions,
 			),
 			}, nil
 		}
@@ -169,7 +169,8 @@ func setup(t *testing.T) (vrfUniverse, *v1.Listener, job.Job) {
 		vuni.prm,
 		vuni.legacyChains,
 		logger.TestLogger(t),
-		mailMon)
+		mailMon,
+		vrfcommon.NewSubscriptionBalances())
 	vs := testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{PublicKey: vuni.vrfkey.PublicKey.String(), EVMChainID: testutils.FixtureChainID.String()})
 	jb, err := vrfcommon.ValidatedVRFSpec(vs.Toml())
 	require.NoError(t, err)
@@ -698,7 +699,8 @@ func Test_VRFV2PlusServiceFailsWhenVRFOwnerProvided(t *testing.T) {
 		vuni.prm,
 		vuni.legacyChains,
 		logger.TestLogger(t),
-		mailMon)
+		mailMon,
+		vrfcommon.NewSubscriptionBalances())
 	chain, err := vuni.legacyChains.Get(testutils.FixtureChainID.String())
 	require.NoError(t, err)
 	vs := testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
@@ -54,6 +54,8 @@ type CoordinatorV2_X interface {
 	RandomWordsRequestedTopic() common.Hash
 	// RandomWordsFulfilledTopic returns the log topic of the RandomWordsFulfilled log
 	RandomWordsFulfilledTopic() common.Hash
+	// SubscriptionEventTopics returns the log topics of the funding and cancellation of subscriptions
+	SubscriptionEventTopics() []common.Hash
 }
 
 type coordinatorV2 struct {
@@ -76,6 +78,13 @@ func (c *coordinatorV2) RandomWordsFulfilledTopic() common.Hash {
 	return vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic()
 }
 
+func (c *coordinatorV2) SubscriptionEventTopics() []common.Hash {
+	return []common.Hash{
+		vrf_coordinator_v2.VRFCoordinatorV2SubscriptionFunded{}.Topic(),
+		vrf_coordinator_v2.VRFCoordinatorV2SubscriptionCanceled{}.Topic(),
+	}
+}
+
 func (c *coordinatorV2) Address() common.Address {
 	return c.coordinator.Address()
 }
@@ -229,6 +238,14 @@ func (c *coordinatorV2_5) RandomWordsFulfilledTopic() common.Hash {
 	return vrf_coordinator_v2plus_interface.IVRFCoordinatorV2PlusInternalRandomWordsFulfilled{}.Topic()
 }
 
+func (c *coordinatorV2_5) SubscriptionEventTopics() []common.Hash {
+	return []common.Hash{
+		vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionFunded{}.Topic(),
+		vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionFundedWithNative{}.Topic(),
+		vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionCanceled{}.Topic(),
+	}
+}
+
 func (c *coordinatorV2_5) Address() common.Address {
 	return c.coordinator.Address()
 }
@@ -82,6 +82,7 @@ func New(
 	reqAdded func(),
 	inflightCache vrfcommon.InflightCache,
 	fulfillmentDeduper *vrfcommon.LogDeduper,
+	subscriptions *vrfcommon.SubscriptionBalances,
 ) job.ServiceCtx {
 	return &listenerV2{
 		cfg:                   cfg,
@@ -104,6 +105,7 @@ func New(
 		aggregator:            aggregator,
 		inflightCache:         inflightCache,
 		fulfillmentLogDeduper: fulfillmentDeduper,
+		subscriptions:         subscriptions,
 	}
 }
 
@@ -145,14 +147,19 @@ type listenerV2 struct {
 	// aggregator client to get link/eth feed prices from chain. Can be nil for VRF V2 plus
 	aggregator aggregator_v3_interface.AggregatorV3InterfaceInterface
 
-	// fulfillmentLogDeduper prevents re-processing fulfillment logs.
+	// fulfillmentLogDeduper prevents re-processing fulfillment and subscription logs.
 	// fulfillment logs are used to increment counts in the respCount map
-	// and to update the blockNumberToReqID heap.
+	// and to update the blockNumberToReqID heap, subscription logs to update
+	// the balances of subscriptions.
 	fulfillmentLogDeduper *vrfcommon.LogDeduper
 
 	// inflightCache is a cache of in-flight requests, used to prevent
 	// re-processing of requests that are in-flight or already fulfilled.
 	inflightCache vrfcommon.InflightCache
+
+	// subscriptions keeps the balances of the subscriptions with pending requests,
+	// and the forecast cost of fulfilling them. Can be nil.
+	subscriptions *vrfcommon.SubscriptionBalances
 }
 
 func (lsn *listenerV2) HealthReport() map[string]error {
@@ -273,6 +280,9 @@ func (lsn *listenerV2) Close() error {
 		close(lsn.chStop)
 		// wait on the request handler, log listener
 		lsn.wg.Wait()
+		if lsn.subscriptions != nil {
+			lsn.subscriptions.RemoveJob(lsn.job.ID)
+		}
 		return nil
 	})
 }
@@ -0,0 +1,137 @@
+package v2
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// forecastFulfillments forecasts the cost of fulfilling the requests of a
+// subscription at the gas price estimated by the gas estimator, and returns
+// the requests its balance can pay for along with the status of the
+// subscription. The other requests are deferred: they are left unprocessed
+// until the subscription is funded, without simulating them.
+//
+// Requests are expected to be sorted by ascending callback gas limit, so that
+// the cheapest requests are kept.
+func (lsn *listenerV2) forecastFulfillments(
+	ctx context.Context,
+	l logger.Logger,
+	subID *big.Int,
+	linkBalance *big.Int,
+	nativeBalance *big.Int,
+	subIsActive bool,
+	reqs []pendingRequest,
+) ([]pendingRequest, vrfcommon.SubscriptionStatus) {
+	status := vrfcommon.SubscriptionStatus{
+		JobID:           lsn.job.ID,
+		ChainID:         lsn.chainID.String(),
+		Coordinator:     lsn.coordinator.Address(),
+		Version:         lsn.coordinator.Version(),
+		SubID:           subID,
+		Active:          subIsActive,
+		Balance:         linkBalance,
+		NativeBalance:   nativeBalance,
+		PendingRequests: len(reqs),
+		UpdatedAt:       time.Now(),
+	}
+	// Requests of cancelled subscriptions are force-fulfilled whatever their cost.
+	if !subIsActive {
+		return reqs, status
+	}
+
+	gasPrice, err := lsn.forecastGasPrice(ctx)
+	if err != nil {
+		l.Warnw("Unable to forecast fulfillment costs, processing requests anyway", "err", err)
+		return reqs, status
+	}
+	var weiPerUnitLink *big.Int
+	if slices.ContainsFunc(reqs, func(r pendingRequest) bool { return !r.req.NativePayment() }) {
+		if lsn.aggregator == nil {
+			return reqs, status
+		}
+		if weiPerUnitLink, err = lsn.weiPerUnitLink(ctx); err != nil {
+			l.Warnw("Unable to forecast fulfillment costs, processing requests anyway", "err", err)
+			return reqs, status
+		}
+	}
+
+	var (
+		payable         []pendingRequest
+		deferred        []string
+		cost            = big.NewInt(0)
+		nativeCost      = big.NewInt(0)
+		committed       = big.NewInt(0)
+		nativeCommitted = big.NewInt(0)
+	)
+	for _, req := range reqs {
+		var fee *big.Int
+		balance, total, paid := linkBalance, cost, committed
+		if req.req.NativePayment() {
+			balance, total, paid = nativeBalance, nativeCost, nativeCommitted
+			fee, err = EstimateFeeWei(req.req.CallbackGasLimit(), gasPrice.ToInt())
+		} else {
+			fee, err = EstimateFeeJuels(req.req.CallbackGasLimit(), gasPrice.ToInt(), weiPerUnitLink)
+		}
+		if err != nil {
+			l.Warnw("Unable to forecast fulfillment costs, processing requests anyway", "err", err)
+			return reqs, status
+		}
+		total.Add(total, fee)
+		if balance == nil || balance.Cmp(new(big.Int).Add(paid, fee)) < 0 {
+			l.Infow("Deferring request until the subscription is funded, its balance does not cover the forecast fulfillment cost",
+				"reqID", req.req.RequestID().String(),
+				"nativePayment", req.req.NativePayment(),
+				"forecastGasPrice", gasPrice.String(),
+				"forecastFee", fee.String(),
+				"balance", balance,
+				"committed", paid.String())
+			deferred = append(deferred, req.req.RequestID().String())
+			continue
+		}
+		paid.Add(paid, fee)
+		payable = append(payable, req)
+	}
+
+	status.ForecastCost, status.ForecastNativeCost = cost, nativeCost
+	status.DeferredRequestIDs = deferred
+	if len(deferred) > 0 {
+		vrfcommon.IncDeferredReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, lsn.coordinator.Version(), len(deferred))
+	}
+	return payable, status
+}
+
+// forecastGasPrice returns the gas price estimated for the next fulfillments,
+// capped at the max gas price of the gas lane. The fee cap is used for dynamic
+// fees.
+func (lsn *listenerV2) forecastGasPrice(ctx context.Context) (*assets.Wei, error) {
+	// All fromAddresses passed to the VRFv2 job have the same KeySpecific-MaxPrice value.
+	maxGasPriceWei := lsn.feeCfg.PriceMaxKey(lsn.fromAddresses()[0])
+	fee, _, err := lsn.chain.GasEstimator().GetFee(ctx, nil, 0, maxGasPriceWei, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to estimate gas price")
+	}
+	if fee.ValidDynamic() {
+		return fee.GasFeeCap, nil
+	}
+	if fee.GasPrice == nil {
+		return nil, errors.New("gas estimator returned neither a gas price nor a dynamic fee")
+	}
+	return fee.GasPrice, nil
+}
+
+// setSubscriptions records the statuses of the subscriptions with requests
+// ready for processing.
+func (lsn *listenerV2) setSubscriptions(statuses []vrfcommon.SubscriptionStatus) {
+	if lsn.subscriptions == nil {
+		return
+	}
+	lsn.subscriptions.SetJob(lsn.job.ID, statuses)
+}
@@ -0,0 +1,110 @@
+package v2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-integrations/evm/assets"
+	"github.com/smartcontractkit/chainlink-integrations/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink-integrations/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/aggregator_v3_interface"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	vrf_mocks "github.com/smartcontractkit/chainlink/v2/core/services/vrf/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
+)
+
+func TestListener_ForecastFulfillments(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := testutils.Context(t)
+	fromAddress := testutils.NewAddress()
+	j, err := vrfcommon.ValidatedVRFSpec(testspecs.GenerateVRFSpec(testspecs.VRFSpecParams{
+		EVMChainID:    testutils.FixtureChainID.String(),
+		FromAddresses: []string{fromAddress.Hex()},
+	}).Toml())
+	require.NoError(t, err)
+
+	feeCfg := vrf_mocks.NewFeeConfig(t)
+	feeCfg.On("PriceMaxKey", fromAddress).Maybe().Return(assets.GWei(100))
+	ge := gasmocks.NewEvmFeeEstimator(t)
+	ge.On("GetFee", mock.Anything, mock.Anything, mock.Anything, assets.GWei(100), mock.Anything, mock.Anything).Maybe().
+		Return(gas.EvmFee{GasPrice: assets.GWei(10)}, uint64(0), nil)
+	chain := evmmocks.NewChain(t)
+	chain.On("GasEstimator").Maybe().Return(ge)
+	// 0.005 ETH per LINK
+	aggregator := vrf_mocks.NewAggregatorV3InterfaceInterface(t)
+	aggregator.On("LatestRoundData", mock.Anything).Maybe().
+		Return(aggregator_v3_interface.LatestRoundData{Answer: big.NewInt(5e15)}, nil)
+	coordinatorAddress := testutils.NewAddress()
+	coordinator := vrf_mocks.NewVRFCoordinatorV2Interface(t)
+	coordinator.On("Address").Maybe().Return(coordinatorAddress)
+
+	newListener := func(chain legacyevm.Chain) *listenerV2 {
+		return &listenerV2{
+			feeCfg:      feeCfg,
+			l:           logger.Sugared(lggr),
+			chain:       chain,
+			chainID:     testutils.FixtureChainID,
+			coordinator: NewCoordinatorV2(coordinator),
+			aggregator:  aggregator,
+			job:         j,
+		}
+	}
+	lsn := newListener(chain)
+	subID := big.NewInt(1)
+	request := func(reqID int64, callbackGasLimit uint32) pendingRequest {
+		return pendingRequest{req: NewV2RandomWordsRequested(&vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+			RequestId:        big.NewInt(reqID),
+			SubId:            subID.Uint64(),
+			CallbackGasLimit: callbackGasLimit,
+		})}
+	}
+	// At 10 gwei, the fulfillments cost 0.6, 0.6 and 1 LINK.
+	reqs := []pendingRequest{request(1, 100_000), request(2, 100_000), request(3, 300_000)}
+
+	t.Run("defers the requests the balance does not cover", func(t *testing.T) {
+		payable, status := lsn.forecastFulfillments(ctx, lggr, subID, big.NewInt(1.5e18), nil, true, reqs)
+		assert.Equal(t, reqs[:2], payable)
+		assert.Equal(t, []string{"3"}, status.DeferredRequestIDs)
+		assert.Equal(t, big.NewInt(2.2e18), status.ForecastCost)
+		assert.Equal(t, big.NewInt(0), status.ForecastNativeCost)
+		assert.Equal(t, coordinatorAddress, status.Coordinator)
+		assert.Equal(t, 3, status.PendingRequests)
+		assert.True(t, status.AtRisk())
+	})
+
+	t.Run("keeps all requests of a funded subscription", func(t *testing.T) {
+		payable, status := lsn.forecastFulfillments(ctx, lggr, subID, big.NewInt(3e18), nil, true, reqs)
+		assert.Equal(t, reqs, payable)
+		assert.Empty(t, status.DeferredRequestIDs)
+		assert.False(t, status.AtRisk())
+	})
+
+	t.Run("keeps all requests of a cancelled subscription", func(t *testing.T) {
+		payable, status := lsn.forecastFulfillments(ctx, lggr, subID, big.NewInt(0), nil, false, reqs)
+		assert.Equal(t, reqs, payable)
+		assert.Nil(t, status.ForecastCost)
+		assert.False(t, status.AtRisk())
+	})
+
+	t.Run("keeps all requests when the cost can't be forecast", func(t *testing.T) {
+		ge := gasmocks.NewEvmFeeEstimator(t)
+		ge.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.EvmFee{}, uint64(0), assert.AnError)
+		chain := evmmocks.NewChain(t)
+		chain.On("GasEstimator").Return(ge)
+
+		payable, status := newListener(chain).forecastFulfillments(ctx, lggr, subID, big.NewInt(0), nil, true, reqs)
+		assert.Equal(t, reqs, payable)
+		assert.Empty(t, status.DeferredRequestIDs)
+		assert.Nil(t, status.ForecastCost)
+	})
+}
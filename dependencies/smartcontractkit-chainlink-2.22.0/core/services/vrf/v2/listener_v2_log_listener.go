@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,6 +14,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
 	"github.com/smartcontractkit/chainlink-integrations/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink-integrations/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/vrf_coordinator_v2_5"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 )
@@ -59,6 +62,22 @@ func (lsn *listenerV2) runLogListener(
 					continue
 				}
 			}
+			subscriptionsFilterName := lsn.getSubscriptionsFilterName()
+			if lsn.subscriptions != nil && !lsn.chain.LogPoller().HasFilter(subscriptionsFilterName) {
+				err := lsn.chain.LogPoller().RegisterFilter(ctx, logpoller.Filter{
+					Name:      subscriptionsFilterName,
+					EventSigs: lsn.coordinator.SubscriptionEventTopics(),
+					Addresses: evmtypes.AddressArray{
+						lsn.coordinator.Address(),
+					},
+				})
+				if err != nil {
+					lsn.l.Errorw("error registering subscriptions filter in log poller, retrying",
+						"err", err,
+						"elapsed", time.Since(start))
+					continue
+				}
+			}
 
 			// on startup we want to initialize the last processed block
 			if startingUp {
@@ -105,6 +124,17 @@ func (lsn *listenerV2) getLogPollerFilterName() string {
 		"coordinatorAddress", lsn.coordinator.Address())
 }
 
+// getSubscriptionsFilterName returns the name of the log poller filter of the
+// funding and cancellation of subscriptions, which are only polled to keep the
+// balances of subscriptions up to date between rounds.
+func (lsn *listenerV2) getSubscriptionsFilterName() string {
+	return logpoller.FilterName(
+		"VRFListenerSubscriptions",
+		"version", lsn.coordinator.Version(),
+		"keyhash", lsn.job.VRFSpec.PublicKey.MustHash(),
+		"coordinatorAddress", lsn.coordinator.Address())
+}
+
 // initializeLastProcessedBlock returns the earliest block number that we need to
 // process requests for. This is the block number of the earliest unfulfilled request
 // or the latest finalized block, if there are no unfulfilled requests.
@@ -259,11 +289,15 @@ func (lsn *listenerV2) pollLogs(ctx context.Context, minConfs uint32, lastProces
 
 	// We don't specify confs because each request can have a different conf above
 	// the minimum. So we do all conf handling in getConfirmedAt.
+	sigs := []common.Hash{lsn.coordinator.RandomWordsFulfilledTopic(), lsn.coordinator.RandomWordsRequestedTopic()}
+	if lsn.subscriptions != nil {
+		sigs = append(sigs, lsn.coordinator.SubscriptionEventTopics()...)
+	}
 	logs, err := lp.LogsWithSigs(
 		ctx,
 		lastProcessedBlock,
 		latestBlock.BlockNumber,
-		[]common.Hash{lsn.coordinator.RandomWordsFulfilledTopic(), lsn.coordinator.RandomWordsRequestedTopic()},
+		sigs,
 		lsn.coordinator.Address(),
 	)
 	if err != nil {
@@ -278,6 +312,7 @@ func (lsn *listenerV2) pollLogs(ctx context.Context, minConfs uint32, lastProces
 	}
 
 	lsn.handleFulfilled(fulfilled)
+	lsn.handleSubscriptionEvents(logs)
 
 	return lsn.handleRequested(unfulfilled, unfulfilledLP, minConfs), nil
 }
@@ -380,6 +415,48 @@ func (lsn *listenerV2) handleFulfilled(fulfilled map[string]RandomWordsFulfilled
 			blockNumber: v.Raw().BlockNumber,
 			reqID:       v.RequestID().String(),
 		})
+		// VRF V2 fulfilled logs don't include the subscription ID, so the payments
+		// of V2 subscriptions are only accounted for when read from the coordinator.
+		if lsn.subscriptions != nil && lsn.coordinator.Version() == vrfcommon.V2Plus {
+			lsn.subscriptions.RecordPayment(lsn.job.ID, v.SubID(), v.Payment(), v.NativePayment())
+		}
+	}
+}
+
+// handleSubscriptionEvents applies the funding and cancellation of subscriptions
+// to their known balances, until they are read again from the coordinator.
+func (lsn *listenerV2) handleSubscriptionEvents(logs []logpoller.Log) {
+	if lsn.subscriptions == nil {
+		return
+	}
+	topics := lsn.coordinator.SubscriptionEventTopics()
+	for _, l := range logs {
+		if !slices.Contains(topics, l.EventSig) {
+			continue
+		}
+		// don't apply the same event over again, it would overwrite the balance
+		// read from the coordinator since
+		if !lsn.fulfillmentLogDeduper.ShouldDeliver(l.ToGethLog()) {
+			continue
+		}
+		parsed, err := lsn.coordinator.ParseLog(l.ToGethLog())
+		if err != nil {
+			// should never happen
+			lsn.l.Errorw("failed to parse subscription log", "err", err, "txHash", l.TxHash)
+			continue
+		}
+		switch e := parsed.(type) {
+		case *vrf_coordinator_v2.VRFCoordinatorV2SubscriptionFunded:
+			lsn.subscriptions.RecordFunding(lsn.job.ID, new(big.Int).SetUint64(e.SubId), e.NewBalance, false)
+		case *vrf_coordinator_v2.VRFCoordinatorV2SubscriptionCanceled:
+			lsn.subscriptions.RecordCancellation(lsn.job.ID, new(big.Int).SetUint64(e.SubId))
+		case *vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionFunded:
+			lsn.subscriptions.RecordFunding(lsn.job.ID, e.SubId, e.NewBalance, false)
+		case *vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionFundedWithNative:
+			lsn.subscriptions.RecordFunding(lsn.job.ID, e.SubId, e.NewNativeBalance, true)
+		case *vrf_coordinator_v2_5.VRFCoordinatorV25SubscriptionCanceled:
+			lsn.subscriptions.RecordCancellation(lsn.job.ID, e.SubId)
+		}
 	}
 }
 
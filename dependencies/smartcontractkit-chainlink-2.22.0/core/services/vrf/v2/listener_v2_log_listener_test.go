@@ -16,6 +16,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
@@ -35,7 +36,9 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	vrf_mocks "github.com/smartcontractkit/chainlink/v2/core/services/vrf/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -1069,3 +1072,58 @@ func TestGetUnfulfilled_UnfulfilledNFulfilledVRFReqs(t *testing.T) {
 }
 
 /* Tests for getUnfulfilled: END */
+
+/* Tests for handleSubscriptionEvents: BEGIN */
+
+func TestListener_HandleSubscriptionEvents(t *testing.T) {
+	coordinator := vrf_mocks.NewVRFCoordinatorV2Interface(t)
+	lsn := &listenerV2{
+		l:                     logger.Sugared(logger.TestLogger(t)),
+		coordinator:           NewCoordinatorV2(coordinator),
+		job:                   job.Job{ID: 1},
+		fulfillmentLogDeduper: vrfcommon.NewLogDeduper(100),
+		subscriptions:         vrfcommon.NewSubscriptionBalances(),
+	}
+	deferred := vrfcommon.SubscriptionStatus{JobID: 1, SubID: big.NewInt(1), Active: true, Balance: big.NewInt(10), ForecastCost: big.NewInt(60), DeferredRequestIDs: []string{"3"}}
+	underfunded := vrfcommon.SubscriptionStatus{JobID: 1, SubID: big.NewInt(2), Active: true, Balance: big.NewInt(10), ForecastCost: big.NewInt(60)}
+	lsn.subscriptions.SetJob(1, []vrfcommon.SubscriptionStatus{deferred, underfunded})
+	require.Len(t, lsn.subscriptions.AtRisk(), 2)
+
+	newLog := func(sig common.Hash, index int64) logpoller.Log {
+		return logpoller.Log{
+			LogIndex:    index,
+			BlockHash:   common.BigToHash(big.NewInt(1)),
+			BlockNumber: 1,
+			EventSig:    sig,
+			Topics:      [][]byte{sig.Bytes()},
+		}
+	}
+	isLog := func(index uint) any {
+		return mock.MatchedBy(func(l ethtypes.Log) bool { return l.Index == index })
+	}
+	fundedTopic := vrf_coordinator_v2.VRFCoordinatorV2SubscriptionFunded{}.Topic()
+	canceledTopic := vrf_coordinator_v2.VRFCoordinatorV2SubscriptionCanceled{}.Topic()
+	coordinator.On("ParseLog", isLog(0)).Once().Return(&vrf_coordinator_v2.VRFCoordinatorV2SubscriptionFunded{
+		SubId: 1, OldBalance: big.NewInt(10), NewBalance: big.NewInt(100),
+	}, nil)
+	coordinator.On("ParseLog", isLog(1)).Once().Return(&vrf_coordinator_v2.VRFCoordinatorV2SubscriptionCanceled{
+		SubId: 2, Amount: big.NewInt(10),
+	}, nil)
+
+	// fulfillments are handled separately, and each event is applied once
+	logs := []logpoller.Log{
+		newLog(fundedTopic, 0),
+		newLog(canceledTopic, 1),
+		newLog(vrf_coordinator_v2.VRFCoordinatorV2RandomWordsFulfilled{}.Topic(), 2),
+	}
+	lsn.handleSubscriptionEvents(logs)
+	lsn.handleSubscriptionEvents(logs)
+	assert.Empty(t, lsn.subscriptions.AtRisk())
+
+	// a later read of the balances replaces the applied events
+	lsn.subscriptions.SetJob(1, []vrfcommon.SubscriptionStatus{deferred})
+	lsn.handleSubscriptionEvents(logs)
+	assert.Len(t, lsn.subscriptions.AtRisk(), 1)
+}
+
+/* Tests for handleSubscriptionEvents: END */
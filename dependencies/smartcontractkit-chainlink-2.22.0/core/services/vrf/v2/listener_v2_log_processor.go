@@ -133,8 +133,10 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 
 	if len(confirmed) == 0 {
 		lsn.l.Infow("No pending requests ready for processing")
+		lsn.setSubscriptions(nil)
 		return
 	}
+	var statuses []vrfcommon.SubscriptionStatus
 	for subID, reqs := range confirmed {
 		l := lsn.l.With("subID", subID, "startTime", time.Now(), "numReqsForSub", len(reqs))
 		// Get the balance of the subscription and also it's active status.
@@ -188,6 +190,15 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 			return cmp.Compare(a.req.CallbackGasLimit(), b.req.CallbackGasLimit())
 		})
 
+		// Defer the requests the subscription can't pay for before spending
+		// any RPCs on simulating them.
+		var status vrfcommon.SubscriptionStatus
+		reqs, status = lsn.forecastFulfillments(ctx, l, sID, startLinkBalance, startEthBalance, subIsActive, reqs)
+		statuses = append(statuses, status)
+		if len(reqs) == 0 {
+			continue
+		}
+
 		p := lsn.processRequestsPerSub(ctx, sID, startLinkBalance, startEthBalance, reqs, subIsActive)
 		processedMu.Lock()
 		for reqID := range p {
@@ -195,6 +206,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context, pendingReq
 		}
 		processedMu.Unlock()
 	}
+	lsn.setSubscriptions(statuses)
 	lsn.pruneConfirmedRequestCounts()
 }
 
@@ -1072,21 +1084,30 @@ func (lsn *listenerV2) estimateFee(
 	}
 
 	// In the event we are using LINK we need to estimate the fee in juels
-	// Don't use up too much time to get this info, it's not critical for operating vrf.
-	callCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
-	defer cancel()
-	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: callCtx})
+	weiPerUnitLink, err := lsn.weiPerUnitLink(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get aggregator latestAnswer: %w", err)
+		return nil, err
 	}
 
 	return EstimateFeeJuels(
 		req.CallbackGasLimit(),
 		maxGasPriceWei.ToInt(),
-		roundData.Answer,
+		weiPerUnitLink,
 	)
 }
 
+// weiPerUnitLink returns the latest LINK/ETH price of the aggregator.
+func (lsn *listenerV2) weiPerUnitLink(ctx context.Context) (*big.Int, error) {
+	// Don't use up too much time to get this info, it's not critical for operating vrf.
+	callCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
+	defer cancel()
+	roundData, err := lsn.aggregator.LatestRoundData(&bind.CallOpts{Context: callCtx})
+	if err != nil {
+		return nil, fmt.Errorf("get aggregator latestAnswer: %w", err)
+	}
+	return roundData.Answer, nil
+}
+
 // Here we use the pipeline to parse the log, generate a vrf response
 // then simulate the transaction at the max gas price to determine its maximum link cost.
 func (lsn *listenerV2) simulateFulfillment(
@@ -50,6 +50,11 @@ var (
 		Help: "The number of times the VRF listener receives duplicate requests, which could indicate a reorg.",
 	}, []string{"job_name", "external_job_id", "vrf_version"})
 
+	MetricDeferredRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_deferred_request_count",
+		Help: "The number of times VRF requests were deferred because the balance of their subscription did not cover their forecast fulfillment cost.",
+	}, []string{"job_name", "external_job_id", "vrf_version"})
+
 	MetricTimeBetweenSims = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name: "vrf_request_time_between_sims",
 		Help: "How long a VRF request sits in the in-memory queue in between simulation attempts.",
@@ -89,6 +94,10 @@ func IncDroppedReqs(jobName string, extJobID uuid.UUID, vrfVersion Version, reas
 		jobName, extJobID.String(), string(vrfVersion), string(reason)).Inc()
 }
 
+func IncDeferredReqs(jobName string, extJobID uuid.UUID, vrfVersion Version, n int) {
+	MetricDeferredRequests.WithLabelValues(jobName, extJobID.String(), string(vrfVersion)).Add(float64(n))
+}
+
 func IncDupeReqs(jobName string, extJobID uuid.UUID, vrfVersion Version) {
 	MetricDupeRequests.WithLabelValues(jobName, extJobID.String(), string(vrfVersion)).Inc()
 }
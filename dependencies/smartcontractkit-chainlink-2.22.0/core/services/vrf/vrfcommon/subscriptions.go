@@ -0,0 +1,176 @@
+package vrfcommon
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SubscriptionStatus is the latest known balance of a VRF subscription served
+// by a job, along with the forecast cost of fulfilling its pending requests.
+type SubscriptionStatus struct {
+	JobID       int32
+	ChainID     string
+	Coordinator common.Address
+	Version     Version
+	SubID       *big.Int
+	// Active is false when the subscription no longer exists on chain.
+	Active bool
+	// Balance is the LINK balance of the subscription, in juels.
+	Balance *big.Int
+	// NativeBalance is the native balance of the subscription, in wei. It is
+	// nil for VRF V2 subscriptions.
+	NativeBalance *big.Int
+	// ForecastCost and ForecastNativeCost are the costs of fulfilling the
+	// pending LINK and native requests at the estimated gas price. They are
+	// nil when the cost could not be forecast.
+	ForecastCost       *big.Int
+	ForecastNativeCost *big.Int
+	PendingRequests    int
+	// DeferredRequestIDs are the requests which are not processed until the
+	// subscription is funded, as its balance does not cover their forecast cost.
+	DeferredRequestIDs []string
+	UpdatedAt          time.Time
+}
+
+// AtRisk returns whether the balance of an active subscription does not cover
+// the forecast cost of its pending requests.
+func (s SubscriptionStatus) AtRisk() bool {
+	if !s.Active {
+		return false
+	}
+	return len(s.DeferredRequestIDs) > 0 || short(s.Balance, s.ForecastCost) || short(s.NativeBalance, s.ForecastNativeCost)
+}
+
+// short returns whether balance does not cover cost. Nothing is short of a
+// zero cost, such as the native cost of a subscription without native
+// requests, which has no native balance in VRF V2.
+func short(balance, cost *big.Int) bool {
+	if cost == nil || cost.Sign() == 0 {
+		return false
+	}
+	return balance == nil || balance.Cmp(cost) < 0
+}
+
+// SubscriptionBalances keeps the status of the subscriptions with pending
+// requests of every VRF V2 and V2Plus job, as last seen by their listeners.
+//
+// Balances are read from the coordinator with GetSubscription on every round
+// of processing of pending requests, and replaced wholesale by SetJob. Between
+// rounds, the listeners apply the funding and cancellation events of the
+// subscriptions, and the payments of V2Plus fulfillments.
+type SubscriptionBalances struct {
+	mu   sync.RWMutex
+	jobs map[int32]map[string]SubscriptionStatus
+}
+
+// NewSubscriptionBalances returns an empty SubscriptionBalances.
+func NewSubscriptionBalances() *SubscriptionBalances {
+	return &SubscriptionBalances{jobs: make(map[int32]map[string]SubscriptionStatus)}
+}
+
+// SetJob replaces the statuses of the subscriptions of a job.
+func (b *SubscriptionBalances) SetJob(jobID int32, statuses []SubscriptionStatus) {
+	subs := make(map[string]SubscriptionStatus, len(statuses))
+	for _, s := range statuses {
+		subs[s.SubID.String()] = s
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.jobs[jobID] = subs
+}
+
+// RemoveJob forgets the subscriptions of a job.
+func (b *SubscriptionBalances) RemoveJob(jobID int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.jobs, jobID)
+}
+
+// RecordPayment subtracts the payment of a fulfillment from the known balance
+// of a subscription, so that it is kept up to date until it is read again from
+// the coordinator on the next round.
+func (b *SubscriptionBalances) RecordPayment(jobID int32, subID *big.Int, payment *big.Int, nativePayment bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.jobs[jobID][subID.String()]
+	if !ok {
+		return
+	}
+	balance := &s.Balance
+	if nativePayment {
+		balance = &s.NativeBalance
+	}
+	if *balance == nil {
+		return
+	}
+	*balance = new(big.Int).Sub(*balance, payment)
+	s.UpdatedAt = time.Now()
+	b.jobs[jobID][subID.String()] = s
+}
+
+// RecordFunding sets the balance of a funded subscription to its new balance,
+// in LINK or in native tokens. The deferred requests of a subscription whose
+// balances now cover the forecast cost of its pending requests are no longer
+// reported, as they are processed on the next round.
+func (b *SubscriptionBalances) RecordFunding(jobID int32, subID *big.Int, newBalance *big.Int, native bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.jobs[jobID][subID.String()]
+	if !ok {
+		return
+	}
+	if native {
+		s.NativeBalance = newBalance
+	} else {
+		s.Balance = newBalance
+	}
+	if !short(s.Balance, s.ForecastCost) && !short(s.NativeBalance, s.ForecastNativeCost) {
+		s.DeferredRequestIDs = nil
+	}
+	s.UpdatedAt = time.Now()
+	b.jobs[jobID][subID.String()] = s
+}
+
+// RecordCancellation marks a cancelled subscription as no longer active, its
+// remaining balances having been refunded.
+func (b *SubscriptionBalances) RecordCancellation(jobID int32, subID *big.Int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.jobs[jobID][subID.String()]
+	if !ok {
+		return
+	}
+	s.Active = false
+	s.Balance = big.NewInt(0)
+	if s.NativeBalance != nil {
+		s.NativeBalance = big.NewInt(0)
+	}
+	s.UpdatedAt = time.Now()
+	b.jobs[jobID][subID.String()] = s
+}
+
+// AtRisk returns the subscriptions whose balance does not cover the forecast
+// cost of their pending requests, ordered by job and subscription ID.
+func (b *SubscriptionBalances) AtRisk() []SubscriptionStatus {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var atRisk []SubscriptionStatus
+	for _, subs := range b.jobs {
+		for _, s := range subs {
+			if s.AtRisk() {
+				atRisk = append(atRisk, s)
+			}
+		}
+	}
+	sort.Slice(atRisk, func(i, j int) bool {
+		if atRisk[i].JobID != atRisk[j].JobID {
+			return atRisk[i].JobID < atRisk[j].JobID
+		}
+		return atRisk[i].SubID.Cmp(atRisk[j].SubID) < 0
+	})
+	return atRisk
+}
@@ -0,0 +1,71 @@
+package vrfcommon
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionBalances(t *testing.T) {
+	b := NewSubscriptionBalances()
+	funded := SubscriptionStatus{JobID: 1, SubID: big.NewInt(1), Active: true, Balance: big.NewInt(100), ForecastCost: big.NewInt(60)}
+	underfunded := SubscriptionStatus{JobID: 1, SubID: big.NewInt(2), Active: true, Balance: big.NewInt(10), NativeBalance: big.NewInt(10), ForecastCost: big.NewInt(5), ForecastNativeCost: big.NewInt(20), DeferredRequestIDs: []string{"7"}}
+	cancelled := SubscriptionStatus{JobID: 2, SubID: big.NewInt(1), Balance: big.NewInt(0), ForecastCost: big.NewInt(60)}
+	// V2 subscriptions have no native balance, and a zero native cost
+	fundedV2 := SubscriptionStatus{JobID: 2, SubID: big.NewInt(2), Active: true, Balance: big.NewInt(100), ForecastCost: big.NewInt(60), ForecastNativeCost: big.NewInt(0), PendingRequests: 1}
+	b.SetJob(1, []SubscriptionStatus{underfunded, funded})
+	b.SetJob(2, []SubscriptionStatus{cancelled, fundedV2})
+
+	assert.Equal(t, []SubscriptionStatus{underfunded}, b.AtRisk())
+
+	t.Run("payments reduce the known balance", func(t *testing.T) {
+		b.RecordPayment(1, big.NewInt(1), big.NewInt(50), false)
+		atRisk := b.AtRisk()
+		require.Len(t, atRisk, 2)
+		assert.Equal(t, big.NewInt(1), atRisk[0].SubID)
+		assert.Equal(t, big.NewInt(50), atRisk[0].Balance)
+		assert.Equal(t, big.NewInt(2), atRisk[1].SubID)
+
+		// native payments of V2 subscriptions and unknown subscriptions are ignored
+		b.RecordPayment(1, big.NewInt(1), big.NewInt(50), true)
+		b.RecordPayment(3, big.NewInt(1), big.NewInt(50), false)
+		assert.Equal(t, big.NewInt(50), b.AtRisk()[0].Balance)
+	})
+
+	t.Run("funding sets the known balance", func(t *testing.T) {
+		b.RecordFunding(1, big.NewInt(1), big.NewInt(150), false)
+		atRisk := b.AtRisk()
+		require.Len(t, atRisk, 1)
+		assert.Equal(t, big.NewInt(2), atRisk[0].SubID)
+
+		// native funding covers the native cost, and releases deferred requests
+		b.RecordFunding(1, big.NewInt(2), big.NewInt(30), true)
+		assert.Empty(t, b.AtRisk())
+
+		// unknown subscriptions are ignored
+		b.RecordFunding(3, big.NewInt(1), big.NewInt(30), false)
+		assert.Empty(t, b.AtRisk())
+	})
+
+	t.Run("cancelled subscriptions are no longer at risk", func(t *testing.T) {
+		b.SetJob(1, []SubscriptionStatus{underfunded})
+		require.Len(t, b.AtRisk(), 1)
+
+		b.RecordCancellation(1, big.NewInt(2))
+		assert.Empty(t, b.AtRisk())
+
+		b.RecordCancellation(2, big.NewInt(2))
+		assert.Empty(t, b.AtRisk())
+	})
+
+	t.Run("jobs are replaced and removed", func(t *testing.T) {
+		b.SetJob(1, []SubscriptionStatus{funded})
+		assert.Empty(t, b.AtRisk())
+
+		b.SetJob(1, []SubscriptionStatus{underfunded})
+		b.RemoveJob(1)
+		assert.Empty(t, b.AtRisk())
+	})
+}
@@ -26,6 +26,7 @@ type Delegate struct {
 	store          store.Store
 	ratelimiter    *ratelimiter.RateLimiter
 	workflowLimits *syncerlimiter.Limits
+	executions     *ExecutionHistory
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -71,13 +72,14 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		WorkflowName: defaultName{
 			name: spec.WorkflowSpec.WorkflowName,
 		},
-		Registry:       d.registry,
-		Store:          d.store,
-		Config:         config,
-		Binary:         binary,
-		SecretsFetcher: d.secretsFetcher,
-		RateLimiter:    d.ratelimiter,
-		WorkflowLimits: d.workflowLimits,
+		Registry:         d.registry,
+		Store:            d.store,
+		Config:           config,
+		Binary:           binary,
+		SecretsFetcher:   d.secretsFetcher,
+		RateLimiter:      d.ratelimiter,
+		WorkflowLimits:   d.workflowLimits,
+		ExecutionHistory: d.executions,
 	}
 	engine, err := NewEngine(ctx, cfg)
 	if err != nil {
@@ -103,6 +105,7 @@ func NewDelegate(
 	store store.Store,
 	ratelimiter *ratelimiter.RateLimiter,
 	workflowLimits *syncerlimiter.Limits,
+	executions *ExecutionHistory,
 ) *Delegate {
 	return &Delegate{
 		logger:         logger,
@@ -111,6 +114,7 @@ func NewDelegate(
 		store:          store,
 		ratelimiter:    ratelimiter,
 		workflowLimits: workflowLimits,
+		executions:     executions,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/exec"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
@@ -33,11 +35,18 @@ const (
 	fifteenMinutesSec            = 15 * 60
 	reservedFieldNameStepTimeout = "cre_step_timeout"
 	maxStepTimeoutOverrideSec    = 10 * 60 // 10 minutes
+	// reservedFieldNameMaxExecutionDuration can be set in the config of a
+	// trigger to override the max execution duration of the workflow.
+	reservedFieldNameMaxExecutionDuration = "cre_max_execution_duration"
+	maxExecutionDurationOverrideSec       = 60 * 60 // 1 hour
 )
 
 var (
 	errGlobalWorkflowCountLimitReached   = errors.New("global workflow count limit reached")
 	errPerOwnerWorkflowCountLimitReached = errors.New("per owner workflow count limit reached")
+
+	ErrExecutionTimedOut  = errors.New("execution timed out")
+	ErrExecutionCancelled = errors.New("execution cancelled")
 )
 
 type stepRequest struct {
@@ -48,6 +57,14 @@ type stepRequest struct {
 type stepUpdateChannel struct {
 	executionID string
 	ch          chan store.WorkflowExecutionStep
+
+	// ctx is cancelled once the execution is finished, times out or is
+	// cancelled; the steps of the execution are run with it.
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	// meterReport holds the spend of the steps of the execution.
+	meterReport *MeteringReport
 }
 
 type stepUpdateManager struct {
@@ -68,12 +85,50 @@ func (sucm *stepUpdateManager) add(executionID string, ch stepUpdateChannel) (ad
 func (sucm *stepUpdateManager) remove(executionID string) {
 	sucm.mu.Lock()
 	defer sucm.mu.Unlock()
-	if _, ok := sucm.m[executionID]; ok {
-		close(sucm.m[executionID].ch)
+	if suc, ok := sucm.m[executionID]; ok {
+		if suc.cancel != nil {
+			suc.cancel(context.Canceled)
+		}
+		close(suc.ch)
 		delete(sucm.m, executionID)
 	}
 }
 
+// ctxFor returns the context of a running execution.
+func (sucm *stepUpdateManager) ctxFor(executionID string) (context.Context, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	suc, ok := sucm.m[executionID]
+	if !ok || suc.ctx == nil {
+		return nil, false
+	}
+	return suc.ctx, true
+}
+
+// meterReportFor returns the metering report of a running execution.
+func (sucm *stepUpdateManager) meterReportFor(executionID string) (*MeteringReport, bool) {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	suc, ok := sucm.m[executionID]
+	if !ok || suc.meterReport == nil {
+		return nil, false
+	}
+	return suc.meterReport, true
+}
+
+// cancel cancels the context of a running execution with the given cause,
+// and reports whether the execution was running.
+func (sucm *stepUpdateManager) cancel(executionID string, cause error) bool {
+	sucm.mu.RLock()
+	defer sucm.mu.RUnlock()
+	suc, ok := sucm.m[executionID]
+	if !ok || suc.cancel == nil {
+		return false
+	}
+	suc.cancel(cause)
+	return true
+}
+
 func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, stepUpdate store.WorkflowExecutionStep) error {
 	sucm.mu.Lock()
 	defer sucm.mu.Unlock()
@@ -83,9 +138,16 @@ func (sucm *stepUpdateManager) send(ctx context.Context, executionID string, ste
 		return fmt.Errorf("step update channel not found for execution %s, dropping step update", executionID)
 	}
 
+	var executionDone <-chan struct{}
+	if stepUpdateCh.ctx != nil {
+		executionDone = stepUpdateCh.ctx.Done()
+	}
+
 	select {
 	case <-ctx.Done():
 		return fmt.Errorf("context canceled before step update could be issued: %w", context.Cause(ctx))
+	case <-executionDone:
+		return fmt.Errorf("execution %s is no longer running, dropping step update: %w", executionID, context.Cause(stepUpdateCh.ctx))
 	case stepUpdateCh.ch <- stepUpdate:
 		return nil
 	}
@@ -143,7 +205,7 @@ type Engine struct {
 	clock          clockwork.Clock
 	ratelimiter    *ratelimiter.RateLimiter
 	workflowLimits *syncerlimiter.Limits
-	meterReport    *MeteringReport
+	executions     *ExecutionHistory
 }
 
 func (e *Engine) Start(_ context.Context) error {
@@ -178,6 +240,10 @@ func (e *Engine) Start(_ context.Context) error {
 		e.wg.Add(1)
 		go e.heartbeat(ctx)
 
+		if e.executions != nil {
+			e.executions.register(e)
+		}
+
 		return nil
 	})
 }
@@ -423,15 +489,14 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 			}
 
 			for _, sd := range sds {
-				ch := make(chan store.WorkflowExecutionStep)
-				added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
-					ch:          ch,
-					executionID: execution.ExecutionID,
-				})
+				suc := newStepUpdateChannel(ctx, execution.ExecutionID)
+				added := e.stepUpdatesChMap.add(execution.ExecutionID, suc)
 				if added {
 					// We trigger the `stepUpdateLoop` for this execution, since the loop is not running atm.
 					e.wg.Add(1)
-					go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
+					go e.stepUpdateLoop(ctx, suc, execution.CreatedAt)
+				} else {
+					suc.cancel(context.Canceled)
 				}
 				e.queueIfReady(execution, sd)
 			}
@@ -522,16 +587,40 @@ func (e *Engine) registerTrigger(ctx context.Context, t *triggerCapability, trig
 // This is important to avoid data races, and any accesses of `executionState` by any other
 // goroutine should happen via a `stepRequest` message containing a copy of the latest
 // `executionState`.
-func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpdateCh chan store.WorkflowExecutionStep, workflowCreatedAt *time.Time) {
+//
+// The loop also enforces the max execution duration: once it is exceeded, or if
+// the execution is cancelled, the in-flight steps are cancelled and the
+// execution is finished right away.
+func (e *Engine) stepUpdateLoop(ctx context.Context, suc stepUpdateChannel, workflowCreatedAt *time.Time) {
 	defer e.wg.Done()
+	executionID := suc.executionID
 	lggr := e.logger.With(platform.KeyWorkflowExecutionID, executionID)
 	e.logger.Debugf("running stepUpdateLoop for execution %s", executionID)
+
+	var timeout <-chan time.Time
+	if workflowCreatedAt != nil {
+		timer := e.clock.NewTimer(e.maxExecutionDuration - e.clock.Since(*workflowCreatedAt))
+		defer timer.Stop()
+		timeout = timer.Chan()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			lggr.Debug("shutting down stepUpdateLoop")
 			return
-		case stepUpdate, open := <-stepUpdateCh:
+		case <-timeout:
+			timeout = nil
+			// a no-op if the execution has already finished
+			suc.cancel(ErrExecutionTimedOut)
+		case <-suc.ctx.Done():
+			cause := context.Cause(suc.ctx)
+			if ctx.Err() == nil && (errors.Is(cause, ErrExecutionTimedOut) || errors.Is(cause, ErrExecutionCancelled)) {
+				e.abortExecution(ctx, executionID, cause)
+			}
+			lggr.Debugf("execution done (%v), shutting down stepUpdateLoop", cause)
+			return
+		case stepUpdate, open := <-suc.ch:
 			if !open {
 				lggr.Debug("stepUpdate channel closed, shutting down stepUpdateLoop")
 				return
@@ -548,6 +637,36 @@ func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpd
 	}
 }
 
+// abortExecution finishes an execution that timed out or was cancelled while
+// some of its steps were still running.
+func (e *Engine) abortExecution(ctx context.Context, executionID string, cause error) {
+	l := e.logger.With(platform.KeyWorkflowExecutionID, executionID)
+	cma := e.cma.With(platform.KeyWorkflowExecutionID, executionID)
+
+	status := store.StatusCancelled
+	if errors.Is(cause, ErrExecutionTimedOut) {
+		status = store.StatusTimeout
+		l.Infof("execution exceeded max duration of %s", e.maxExecutionDuration)
+	}
+	logCustMsg(ctx, cma, "execution status: "+status, l)
+	if err := e.finishExecution(ctx, cma, executionID, status); err != nil {
+		l.Errorf("failed to finish execution: %v", err)
+	}
+}
+
+// newStepUpdateChannel returns the step update channel of an execution, with a
+// context derived from ctx for the steps of the execution.
+func newStepUpdateChannel(ctx context.Context, executionID string) stepUpdateChannel {
+	execCtx, cancel := context.WithCancelCause(ctx)
+	return stepUpdateChannel{
+		executionID: executionID,
+		ch:          make(chan store.WorkflowExecutionStep),
+		ctx:         execCtx,
+		cancel:      cancel,
+		meterReport: NewMeteringReport(),
+	}
+}
+
 func generateExecutionID(workflowID, eventID string) (string, error) {
 	s := sha256.New()
 	_, err := s.Write([]byte(workflowID))
@@ -565,8 +684,6 @@ func generateExecutionID(workflowID, eventID string) (string, error) {
 
 // startExecution kicks off a new workflow execution when a trigger event is received.
 func (e *Engine) startExecution(ctx context.Context, executionID string, event *values.Map) error {
-	e.meterReport = NewMeteringReport()
-
 	lggr := e.logger.With("event", event, platform.KeyWorkflowExecutionID, executionID)
 	lggr.Debug("executing on a trigger event")
 	ec := &store.WorkflowExecution{
@@ -598,18 +715,16 @@ func (e *Engine) startExecution(ctx context.Context, executionID string, event *
 		return err
 	}
 
-	ch := make(chan store.WorkflowExecutionStep)
-	added := e.stepUpdatesChMap.add(executionID, stepUpdateChannel{
-		ch:          ch,
-		executionID: executionID,
-	})
+	suc := newStepUpdateChannel(ctx, executionID)
+	added := e.stepUpdatesChMap.add(executionID, suc)
 	if !added {
+		suc.cancel(context.Canceled)
 		// skip this execution since there's already a stepUpdateLoop running for the execution ID
 		lggr.Debugf("won't start execution for execution %s, execution was already started", executionID)
 		return nil
 	}
 	e.wg.Add(1)
-	go e.stepUpdateLoop(ctx, executionID, ch, dbWex.CreatedAt)
+	go e.stepUpdateLoop(ctx, suc, dbWex.CreatedAt)
 
 	for _, td := range triggerDependents {
 		e.queueIfReady(*ec, td)
@@ -671,6 +786,151 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	return nil
 }
 
+// RerunFrom re-executes a failed execution of this workflow, starting from the
+// given step. The step and all of its transitive dependents are reset; steps
+// that don't depend on it keep their previous results.
+func (e *Engine) RerunFrom(ctx context.Context, executionID string, stepRef string) error {
+	if err := e.Ready(); err != nil {
+		return err
+	}
+	if stepRef == workflows.KeywordTrigger {
+		return errors.New("cannot re-run the trigger of an execution")
+	}
+
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return fmt.Errorf("execution %s does not belong to workflow %s", executionID, e.workflow.id)
+	}
+	if execution.Status != store.StatusErrored && execution.Status != store.StatusTimeout {
+		return fmt.Errorf("only errored or timed out executions can be re-run; execution %s is %s", executionID, execution.Status)
+	}
+
+	rerunStep, err := e.workflow.Vertex(stepRef)
+	if err != nil {
+		return fmt.Errorf("could not find step %s: %w", stepRef, err)
+	}
+	for _, dr := range rerunStep.Vertex.Dependencies {
+		dep, ok := execution.Steps[dr]
+		if !ok || dep.Status != store.StatusCompleted {
+			return fmt.Errorf("cannot re-run step %s: dependency %s is not completed", stepRef, dr)
+		}
+	}
+
+	var resetRefs []string
+	err = e.workflow.walkDo(stepRef, func(s *step) error {
+		resetRefs = append(resetRefs, s.Ref)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	loopCtx, cancel := e.stopCh.NewCtx()
+	suc := newStepUpdateChannel(loopCtx, executionID)
+	// suc.ctx is cancelled once the execution is done
+	context.AfterFunc(suc.ctx, cancel)
+	added := e.stepUpdatesChMap.add(executionID, suc)
+	if !added {
+		suc.cancel(context.Canceled)
+		return fmt.Errorf("execution %s is already running", executionID)
+	}
+
+	execution, err = e.executionStates.ResetSteps(ctx, executionID, resetRefs)
+	if err != nil {
+		e.stepUpdatesChMap.remove(executionID)
+		return err
+	}
+
+	e.logger.With(platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, stepRef, "resetSteps", resetRefs).Info("re-running execution")
+	logCustMsg(ctx, e.cma.With(platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, stepRef), "execution re-run", e.logger)
+
+	// The execution gets a fresh maximum duration from the moment it is re-run.
+	rerunAt := e.clock.Now()
+	e.wg.Add(1)
+	go e.stepUpdateLoop(loopCtx, suc, &rerunAt)
+	e.queueIfReady(execution, rerunStep)
+	return nil
+}
+
+// Cancel stops a running execution of this workflow. The steps of the
+// execution that are in flight are cancelled, and the execution finishes with
+// the cancelled status.
+func (e *Engine) Cancel(ctx context.Context, executionID string) error {
+	if err := e.Ready(); err != nil {
+		return err
+	}
+
+	execution, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	if execution.WorkflowID != e.workflow.id {
+		return fmt.Errorf("execution %s does not belong to workflow %s", executionID, e.workflow.id)
+	}
+	if execution.Status != store.StatusStarted {
+		return fmt.Errorf("only started executions can be cancelled; execution %s is %s", executionID, execution.Status)
+	}
+
+	e.logger.With(platform.KeyWorkflowExecutionID, executionID).Info("cancelling execution")
+	if e.stepUpdatesChMap.cancel(executionID, ErrExecutionCancelled) {
+		return nil
+	}
+
+	// The execution isn't being processed by this engine, e.g. it was
+	// interrupted by a restart and hasn't been resumed, so finish it directly.
+	cma := e.cma.With(platform.KeyWorkflowExecutionID, executionID)
+	logCustMsg(ctx, cma, "execution status: "+store.StatusCancelled, e.logger)
+	return e.finishExecution(ctx, cma, executionID, store.StatusCancelled)
+}
+
+// Replay starts a new execution of this workflow with the trigger event of a
+// dead letter, and returns the ID of the new execution.
+func (e *Engine) Replay(ctx context.Context, deadLetterID int64) (string, error) {
+	if err := e.Ready(); err != nil {
+		return "", err
+	}
+
+	dl, err := e.executionStates.GetDeadLetter(ctx, deadLetterID)
+	if err != nil {
+		return "", err
+	}
+	if dl.WorkflowID != e.workflow.id {
+		return "", fmt.Errorf("dead letter %d does not belong to workflow %s", deadLetterID, e.workflow.id)
+	}
+	if dl.TriggerEvent == nil {
+		return "", fmt.Errorf("dead letter %d has no trigger event to replay", deadLetterID)
+	}
+
+	// Replays get a new execution ID, since the original execution is kept for
+	// inspection, and a dead letter may be replayed more than once.
+	executionID, err := generateExecutionID(e.workflow.id, fmt.Sprintf("%s_replay_%d", dl.ExecutionID, e.clock.Now().UnixNano()))
+	if err != nil {
+		return "", err
+	}
+
+	loopCtx, cancel := e.stopCh.NewCtx()
+	if err = e.startExecution(loopCtx, executionID, dl.TriggerEvent); err != nil {
+		cancel()
+		return "", fmt.Errorf("failed to start execution: %w", err)
+	}
+	// release loopCtx once the execution is done
+	if execCtx, ok := e.stepUpdatesChMap.ctxFor(executionID); ok {
+		context.AfterFunc(execCtx, cancel)
+	} else {
+		cancel()
+	}
+	if err = e.executionStates.MarkDeadLetterReplayed(ctx, dl.ID, executionID); err != nil {
+		return "", err
+	}
+
+	e.logger.With(platform.KeyWorkflowExecutionID, executionID, "deadLetterExecutionID", dl.ExecutionID).Info("replaying dead letter")
+	logCustMsg(ctx, e.cma.With(platform.KeyWorkflowExecutionID, executionID), "execution started from dead letter "+dl.ExecutionID, e.logger)
+	return executionID, nil
+}
+
 func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
@@ -717,8 +977,17 @@ func (e *Engine) finishExecution(ctx context.Context, cma custmsg.MessageEmitter
 		return err
 	}
 
+	if report, ok := e.stepUpdatesChMap.meterReportFor(executionID); ok {
+		if spend := report.MedianSpend(); len(spend) > 0 {
+			l.Infow("execution spend", "spend", spend)
+		}
+	}
 	e.stepUpdatesChMap.remove(executionID)
 
+	if status == store.StatusErrored || status == store.StatusTimeout {
+		e.addDeadLetter(ctx, execState)
+	}
+
 	executionDuration := int64(execState.FinishedAt.Sub(*execState.CreatedAt).Seconds())
 	switch status {
 	case store.StatusCompleted:
@@ -743,6 +1012,61 @@ func (e *Engine) finishExecution(ctx context.Context, cma custmsg.MessageEmitter
 	return nil
 }
 
+// meterComputeStep adds the resources used by a custom compute step to the
+// metering report of its execution.
+func (e *Engine) meterComputeStep(executionID string, ref string, usage compute.Usage) {
+	report, ok := e.stepUpdatesChMap.meterReportFor(executionID)
+	if !ok {
+		e.logger.Debugw("execution is no longer running, dropping compute usage", platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, ref)
+		return
+	}
+
+	peerID := ""
+	if e.localNode.PeerID != nil {
+		peerID = e.localNode.PeerID.String()
+	}
+	for _, step := range ComputeUsageSteps(peerID, usage) {
+		if err := report.AddStep(MeteringReportStepRef(ref), step); err != nil {
+			e.logger.Errorw("failed to add compute usage to metering report", platform.KeyWorkflowExecutionID, executionID, platform.KeyStepRef, ref, "err", err)
+		}
+	}
+}
+
+// addDeadLetter records a failed execution along with its trigger event, so
+// that it can be replayed later on.
+func (e *Engine) addDeadLetter(ctx context.Context, execState store.WorkflowExecution) {
+	dl := store.DeadLetter{
+		WorkflowID:  execState.WorkflowID,
+		ExecutionID: execState.ExecutionID,
+		Status:      execState.Status,
+	}
+	if trigger, ok := execState.Steps[workflows.KeywordTrigger]; ok {
+		if event, ok := trigger.Outputs.Value.(*values.Map); ok {
+			dl.TriggerEvent = event
+		}
+	}
+
+	if execState.Status == store.StatusTimeout {
+		dl.Error = ErrExecutionTimedOut.Error()
+	} else {
+		refs := make([]string, 0, len(execState.Steps))
+		for ref := range execState.Steps {
+			refs = append(refs, ref)
+		}
+		sort.Strings(refs)
+		for _, ref := range refs {
+			if s := execState.Steps[ref]; s.Status == store.StatusErrored && s.Outputs.Err != nil {
+				dl.Error = fmt.Sprintf("step %s: %s", ref, s.Outputs.Err)
+				break
+			}
+		}
+	}
+
+	if err := e.executionStates.AddDeadLetter(ctx, dl); err != nil {
+		e.logger.With(platform.KeyWorkflowExecutionID, execState.ExecutionID).Errorf("failed to add dead letter: %v", err)
+	}
+}
+
 // worker is responsible for:
 //   - handling a `pendingStepRequests`
 //   - starting a new execution when a trigger emits a message on `triggerEvents`
@@ -822,16 +1146,31 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		Ref:         msg.stepRef,
 	}
 
+	// Steps are run with the context of their execution, so that they are
+	// cancelled along with it.
+	execCtx, ok := e.stepUpdatesChMap.ctxFor(msg.state.ExecutionID)
+	if !ok {
+		execCtx = ctx
+	}
+	if execCtx.Err() != nil {
+		l.Debugf("execution is no longer running, not executing step: %v", context.Cause(execCtx))
+		return
+	}
+
 	logCustMsg(ctx, cma, "executing step", l)
 
-	stepExecutionStartTime := time.Now()
-	inputs, outputs, err := e.executeStep(ctx, l, msg)
-	stepExecutionDuration := time.Since(stepExecutionStartTime).Seconds()
+	stepExecutionStartTime := e.clock.Now()
+	inputs, outputs, err := e.executeStep(execCtx, l, msg)
+	stepExecutionEndTime := e.clock.Now()
+	stepExecutionDuration := stepExecutionEndTime.Sub(stepExecutionStartTime).Seconds()
+	stepState.StartedAt = &stepExecutionStartTime
+	stepState.UpdatedAt = &stepExecutionEndTime
 
 	curStepID := "UNSET"
 	curStep, verr := e.workflow.Vertex(msg.stepRef)
 	if verr == nil {
 		curStepID = curStep.ID
+		stepState.CapabilityID = curStep.ID
 	} else {
 		l.Errorf("failed to resolve step in workflow; error %v", verr)
 	}
@@ -1182,6 +1521,9 @@ func (e *Engine) Close() error {
 	return e.StopOnce("Engine", func() error {
 		e.logger.Info("shutting down engine")
 		ctx := context.Background()
+		if e.executions != nil {
+			e.executions.unregister(e)
+		}
 		// To shut down the engine, we'll start by deregistering
 		// any triggers to ensure no new executions are triggered,
 		// then we'll close down any background goroutines,
@@ -1275,6 +1617,9 @@ type Config struct {
 	StepTimeout          time.Duration
 	RateLimiter          *ratelimiter.RateLimiter
 	WorkflowLimits       *syncerlimiter.Limits
+	// ExecutionHistory, if set, gets the engine registered while it is running,
+	// so that its failed executions can be re-run.
+	ExecutionHistory *ExecutionHistory
 
 	// For testing purposes only
 	maxRetries          int
@@ -1388,6 +1733,10 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 	workflow.owner = cfg.WorkflowOwner
 	workflow.name = cfg.WorkflowName
 
+	if d, ok := maxExecutionDurationOverride(cfg.Lggr, workflow); ok {
+		cfg.MaxExecutionDuration = d
+	}
+
 	engine = &Engine{
 		cma:            cma,
 		logger:         cfg.Lggr.Named("WorkflowEngine").With("workflowID", cfg.WorkflowID),
@@ -1417,11 +1766,45 @@ func NewEngine(ctx context.Context, cfg Config) (engine *Engine, err error) {
 		clock:                cfg.clock,
 		ratelimiter:          cfg.RateLimiter,
 		workflowLimits:       cfg.WorkflowLimits,
+		executions:           cfg.ExecutionHistory,
 	}
 
 	return engine, nil
 }
 
+// maxExecutionDurationOverride returns the max execution duration set by a
+// workflow in the config of its triggers, if any. The reserved field is removed
+// from the trigger config so that it isn't passed on to the trigger.
+func maxExecutionDurationOverride(lggr logger.Logger, wf *workflow) (time.Duration, bool) {
+	var (
+		d     time.Duration
+		found bool
+	)
+	for _, t := range wf.triggers {
+		override, ok := t.Config[reservedFieldNameMaxExecutionDuration]
+		if !ok {
+			continue
+		}
+		delete(t.Config, reservedFieldNameMaxExecutionDuration)
+
+		var desired int64
+		v, err := values.Wrap(override)
+		if err == nil {
+			err = v.UnwrapTo(&desired)
+		}
+		if err != nil || desired <= 0 {
+			lggr.Warnw("couldn't decode max execution duration override, ignoring it", "error", err, "value", override)
+			continue
+		}
+		if desired > maxExecutionDurationOverrideSec {
+			lggr.Warnw("desired max execution duration is too large, limiting to max value", "maxValue", maxExecutionDurationOverrideSec)
+			desired = maxExecutionDurationOverrideSec
+		}
+		d, found = time.Duration(desired)*time.Second, true
+	}
+	return d, found
+}
+
 type workflowError struct {
 	labels map[string]string
 	// err is the underlying error that caused this error
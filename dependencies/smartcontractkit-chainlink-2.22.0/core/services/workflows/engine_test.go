@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -755,6 +757,200 @@ func TestEngine_ErrorsTheWorkflowIfAStepErrors(t *testing.T) {
 	assert.Equal(t, state.Steps["evm_median"].Status, store.StatusErrored)
 }
 
+func TestEngine_RerunFrom(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+
+	// consensus fails on the first execution only
+	consensus := mockConsensus("")
+	succeed := consensus.transform
+	var failed atomic.Bool
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if failed.CompareAndSwap(false, true) {
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return succeed(req)
+	}
+	require.NoError(t, reg.Add(ctx, consensus))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	var executions *ExecutionHistory
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = newTestDBStore(t, c.clock)
+		executions = NewExecutionHistory(c.Store)
+		c.ExecutionHistory = executions
+	})
+	servicetest.Run(t, eng)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := executions.Get(ctx, eid)
+	require.NoError(t, err)
+	require.Equal(t, store.StatusErrored, state.Status)
+	failedStep := state.Steps["evm_median"]
+	assert.Equal(t, "offchain_reporting@1.0.0", failedStep.CapabilityID)
+	assert.NotNil(t, failedStep.StartedAt)
+	assert.NotNil(t, failedStep.UpdatedAt)
+
+	list, count, err := executions.List(ctx, store.ListFilter{WorkflowID: testWorkflowID, Status: store.StatusErrored})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, eid, list[0].ExecutionID)
+
+	require.ErrorContains(t, executions.Rerun(ctx, eid, workflows.KeywordTrigger), "cannot re-run the trigger")
+	require.ErrorContains(t, executions.Rerun(ctx, eid, "write_polygon-testnet-mumbai"), "dependency evm_median is not completed")
+
+	require.NoError(t, executions.Rerun(ctx, eid, "evm_median"))
+	require.Equal(t, eid, getExecutionID(t, eng, hooks))
+	state, err = executions.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["write_polygon-testnet-mumbai"].Status)
+
+	// only failed executions can be re-run
+	require.ErrorContains(t, executions.Rerun(ctx, eid, "evm_median"), "only errored or timed out executions can be re-run")
+}
+
+// hangingTarget is a target that blocks until its request is cancelled, for
+// as long as hang is set.
+type hangingTarget struct {
+	*mockCapability
+	hang      atomic.Bool
+	executing chan struct{}
+}
+
+func newHangingTarget() *hangingTarget {
+	h := &hangingTarget{mockCapability: mockTarget(""), executing: make(chan struct{}, 10)}
+	h.hang.Store(true)
+	return h
+}
+
+func (h *hangingTarget) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	if !h.hang.Load() {
+		return h.mockCapability.Execute(ctx, req)
+	}
+	h.executing <- struct{}{}
+	<-ctx.Done()
+	return capabilities.CapabilityResponse{}, context.Cause(ctx)
+}
+
+func TestEngine_Cancel(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, tr := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	target := newHangingTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	var executions *ExecutionHistory
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.Store = newTestDBStore(t, c.clock)
+		executions = NewExecutionHistory(c.Store)
+		c.ExecutionHistory = executions
+	})
+	servicetest.Run(t, eng)
+
+	<-target.executing
+	eid, err := generateExecutionID(testWorkflowID, tr.Event.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, executions.Cancel(ctx, eid))
+	require.Equal(t, eid, getExecutionID(t, eng, hooks))
+	state, err := executions.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCancelled, state.Status)
+
+	// cancelled executions aren't dead lettered
+	_, count, err := executions.ListDeadLetters(ctx, testWorkflowID, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	require.ErrorContains(t, executions.Cancel(ctx, eid), "only started executions can be cancelled")
+}
+
+func TestEngine_TimesOutHungExecutionAndReplaysIt(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, tr := mockTrigger(t)
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	target := newHangingTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	clock := clockwork.NewFakeClock()
+	var executions *ExecutionHistory
+	eng, hooks := newTestEngineWithYAMLSpec(t, reg, simpleWorkflow, func(c *Config) {
+		c.clock = clock
+		c.MaxExecutionDuration = time.Minute
+		c.Store = newTestDBStore(t, clock)
+		executions = NewExecutionHistory(c.Store)
+		c.ExecutionHistory = executions
+	})
+	servicetest.Run(t, eng)
+
+	<-target.executing
+	clock.Advance(2 * time.Minute)
+
+	eid := getExecutionID(t, eng, hooks)
+	state, err := executions.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusTimeout, state.Status)
+
+	deadLetters, count, err := executions.ListDeadLetters(ctx, testWorkflowID, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	dl := deadLetters[0]
+	assert.Equal(t, eid, dl.ExecutionID)
+	assert.Equal(t, store.StatusTimeout, dl.Status)
+	assert.Equal(t, ErrExecutionTimedOut.Error(), dl.Error)
+	assert.True(t, proto.Equal(values.Proto(tr.Event.Outputs), values.Proto(dl.TriggerEvent)))
+
+	target.hang.Store(false)
+	replayID, err := executions.Replay(ctx, dl.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, eid, replayID)
+	require.Equal(t, replayID, getExecutionID(t, eng, hooks))
+
+	state, err = executions.Get(ctx, replayID)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	deadLetters, _, err = executions.ListDeadLetters(ctx, testWorkflowID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, replayID, deadLetters[0].ReplayExecutionID)
+	assert.NotNil(t, deadLetters[0].ReplayedAt)
+}
+
+func TestEngine_MaxExecutionDurationOverride(t *testing.T) {
+	t.Parallel()
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	withOverride := func(seconds string) string {
+		return strings.Replace(simpleWorkflow, "    config:\n", "    config:\n      "+reservedFieldNameMaxExecutionDuration+": "+seconds+"\n", 1)
+	}
+
+	eng, _ := newTestEngineWithYAMLSpec(t, reg, withOverride("120"))
+	assert.Equal(t, 2*time.Minute, eng.maxExecutionDuration)
+	// the reserved field isn't passed on to the trigger
+	assert.NotContains(t, eng.workflow.triggers[0].Config, reservedFieldNameMaxExecutionDuration)
+
+	eng, _ = newTestEngineWithYAMLSpec(t, reg, withOverride("86400"))
+	assert.Equal(t, time.Duration(maxExecutionDurationOverrideSec)*time.Second, eng.maxExecutionDuration)
+
+	eng, _ = newTestEngineWithYAMLSpec(t, reg, withOverride(`"soon"`))
+	assert.Equal(t, defaultMaxExecutionDuration, eng.maxExecutionDuration)
+}
+
 func TestEngine_GracefulEarlyTermination(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -0,0 +1,130 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+var ErrWorkflowNotRunning = errors.New("workflow is not running on this node")
+
+var _ compute.UsageReporter = (*ExecutionHistory)(nil)
+
+// ExecutionHistory gives access to the executions of the workflows run by this
+// node, and re-runs, cancels or replays executions on the engine of their
+// workflow.
+// Engines register themselves while they are running.
+type ExecutionHistory struct {
+	store store.Store
+
+	mu      sync.RWMutex
+	engines map[string]*Engine
+}
+
+func NewExecutionHistory(s store.Store) *ExecutionHistory {
+	return &ExecutionHistory{
+		store:   s,
+		engines: map[string]*Engine{},
+	}
+}
+
+func (h *ExecutionHistory) register(e *Engine) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.engines[e.workflow.id] = e
+}
+
+func (h *ExecutionHistory) unregister(e *Engine) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// a newer engine for the same workflow may have replaced this one already
+	if h.engines[e.workflow.id] == e {
+		delete(h.engines, e.workflow.id)
+	}
+}
+
+// List returns the executions matching the filter, most recent first, and the
+// total number of matching executions.
+func (h *ExecutionHistory) List(ctx context.Context, filter store.ListFilter) ([]store.WorkflowExecution, int, error) {
+	return h.store.List(ctx, filter)
+}
+
+// Get returns an execution along with all of its steps.
+func (h *ExecutionHistory) Get(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	return h.store.Get(ctx, executionID)
+}
+
+// Rerun re-executes a failed execution starting from the given step. The
+// workflow of the execution must be running on this node.
+func (h *ExecutionHistory) Rerun(ctx context.Context, executionID string, stepRef string) error {
+	execution, err := h.store.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+
+	engine, ok := h.engineFor(execution.WorkflowID)
+	if !ok {
+		return fmt.Errorf("cannot re-run execution %s: %w", executionID, ErrWorkflowNotRunning)
+	}
+	return engine.RerunFrom(ctx, executionID, stepRef)
+}
+
+// Cancel stops a running execution. The workflow of the execution must be
+// running on this node.
+func (h *ExecutionHistory) Cancel(ctx context.Context, executionID string) error {
+	execution, err := h.store.Get(ctx, executionID)
+	if err != nil {
+		return err
+	}
+
+	engine, ok := h.engineFor(execution.WorkflowID)
+	if !ok {
+		return fmt.Errorf("cannot cancel execution %s: %w", executionID, ErrWorkflowNotRunning)
+	}
+	return engine.Cancel(ctx, executionID)
+}
+
+// ListDeadLetters returns the failed executions of a workflow that can be
+// replayed, most recent first, and the total number of them.
+func (h *ExecutionHistory) ListDeadLetters(ctx context.Context, workflowID string, offset, limit int) ([]store.DeadLetter, int, error) {
+	return h.store.ListDeadLetters(ctx, workflowID, offset, limit)
+}
+
+// Replay starts a new execution with the trigger event of a dead letter, and
+// returns the ID of the new execution. The workflow of the dead letter must be
+// running on this node.
+func (h *ExecutionHistory) Replay(ctx context.Context, deadLetterID int64) (string, error) {
+	dl, err := h.store.GetDeadLetter(ctx, deadLetterID)
+	if err != nil {
+		return "", err
+	}
+
+	engine, ok := h.engineFor(dl.WorkflowID)
+	if !ok {
+		return "", fmt.Errorf("cannot replay dead letter %d: %w", deadLetterID, ErrWorkflowNotRunning)
+	}
+	return engine.Replay(ctx, deadLetterID)
+}
+
+// ReportComputeUsage adds the resources used by a custom compute step to the
+// metering report of its execution.
+func (h *ExecutionHistory) ReportComputeUsage(md capabilities.RequestMetadata, usage compute.Usage) {
+	engine, ok := h.engineFor(md.WorkflowID)
+	if !ok {
+		return
+	}
+	engine.meterComputeStep(md.WorkflowExecutionID, md.ReferenceID, usage)
+}
+
+func (h *ExecutionHistory) engineFor(workflowID string) (*Engine, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	engine, ok := h.engines[workflowID]
+	return engine, ok
+}
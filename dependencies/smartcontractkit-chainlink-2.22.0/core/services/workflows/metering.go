@@ -5,12 +5,21 @@ import (
 	"sync"
 
 	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 )
 
 type MeteringReportStepRef string
 
 type MeteringSpendUnit string
 
+// The spend units of the resources used by custom compute steps.
+const (
+	ComputeExecutionTimeUnit MeteringSpendUnit = "COMPUTE_EXECUTION_MS"
+	ComputeFetchRequestsUnit MeteringSpendUnit = "COMPUTE_FETCH_REQUESTS"
+	ComputeFetchBytesUnit    MeteringSpendUnit = "COMPUTE_FETCH_BYTES"
+)
+
 func (s MeteringSpendUnit) String() string {
 	return string(s)
 }
@@ -56,14 +65,24 @@ type MeteringReportStep struct {
 	SpendValue  MeteringSpendValue
 }
 
+// ComputeUsageSteps returns the metering report steps of the resources used by
+// a custom compute step.
+func ComputeUsageSteps(peerID string, usage compute.Usage) []MeteringReportStep {
+	return []MeteringReportStep{
+		{peerID, ComputeExecutionTimeUnit, ComputeExecutionTimeUnit.IntToSpendValue(usage.ExecutionTime.Milliseconds())},
+		{peerID, ComputeFetchRequestsUnit, ComputeFetchRequestsUnit.IntToSpendValue(int64(usage.FetchRequests))},
+		{peerID, ComputeFetchBytesUnit, ComputeFetchBytesUnit.DecimalToSpendValue(decimal.NewFromUint64(usage.FetchBytes))},
+	}
+}
+
 type MeteringReport struct {
 	mu    sync.RWMutex
-	steps map[MeteringReportStepRef]MeteringReportStep
+	steps map[MeteringReportStepRef][]MeteringReportStep
 }
 
 func NewMeteringReport() *MeteringReport {
 	return &MeteringReport{
-		steps: make(map[MeteringReportStepRef]MeteringReportStep),
+		steps: make(map[MeteringReportStepRef][]MeteringReportStep),
 	}
 }
 
@@ -74,13 +93,15 @@ func (r *MeteringReport) MedianSpend() map[MeteringSpendUnit]MeteringSpendValue
 	values := map[MeteringSpendUnit][]MeteringSpendValue{}
 	medians := map[MeteringSpendUnit]MeteringSpendValue{}
 
-	for _, step := range r.steps {
-		vals, ok := values[step.SpendUnit]
-		if !ok {
-			vals = []MeteringSpendValue{}
-		}
+	for _, steps := range r.steps {
+		for _, step := range steps {
+			vals, ok := values[step.SpendUnit]
+			if !ok {
+				vals = []MeteringSpendValue{}
+			}
 
-		values[step.SpendUnit] = append(vals, step.SpendValue)
+			values[step.SpendUnit] = append(vals, step.SpendValue)
+		}
 	}
 
 	for unit, set := range values {
@@ -100,11 +121,13 @@ func (r *MeteringReport) MedianSpend() map[MeteringSpendUnit]MeteringSpendValue
 	return medians
 }
 
+// AddStep adds the spend of a step to the report. A step can spend several
+// units, and each of them is added separately.
 func (r *MeteringReport) AddStep(ref MeteringReportStepRef, step MeteringReportStep) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.steps[ref] = step
+	r.steps[ref] = append(r.steps[ref], step)
 
 	return nil
 }
@@ -3,12 +3,14 @@ package workflows_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
 )
 
@@ -126,4 +128,25 @@ func TestMeteringReport(t *testing.T) {
 
 		assert.Equal(t, expected[testUnitA].String(), median[testUnitA].String())
 	})
+
+	t.Run("MedianSpend includes all spend units of a step", func(t *testing.T) {
+		t.Parallel()
+
+		report := workflows.NewMeteringReport()
+		usage := compute.Usage{
+			ExecutionTime: 1500 * time.Millisecond,
+			FetchRequests: 2,
+			FetchBytes:    1024,
+		}
+		for _, step := range workflows.ComputeUsageSteps("abc", usage) {
+			require.NoError(t, report.AddStep("compute", step))
+		}
+
+		median := report.MedianSpend()
+
+		require.Len(t, median, 3)
+		assert.Equal(t, workflows.ComputeExecutionTimeUnit.IntToSpendValue(1500).String(), median[workflows.ComputeExecutionTimeUnit].String())
+		assert.Equal(t, workflows.ComputeFetchRequestsUnit.IntToSpendValue(2).String(), median[workflows.ComputeFetchRequestsUnit].String())
+		assert.Equal(t, workflows.ComputeFetchBytesUnit.IntToSpendValue(1024).String(), median[workflows.ComputeFetchBytesUnit].String())
+	})
 }
@@ -15,6 +15,7 @@ const (
 	StatusTimeout            = "timeout"
 	StatusCompleted          = "completed"
 	StatusCompletedEarlyExit = "completed_early_exit"
+	StatusCancelled          = "cancelled"
 )
 
 var ValidStatuses = map[string]bool{
@@ -23,6 +24,7 @@ var ValidStatuses = map[string]bool{
 	StatusTimeout:            true,
 	StatusCompleted:          true,
 	StatusCompletedEarlyExit: true,
+	StatusCancelled:          true,
 }
 
 type StepOutput struct {
@@ -31,13 +33,15 @@ type StepOutput struct {
 }
 
 type WorkflowExecutionStep struct {
-	ExecutionID string
-	Ref         string
-	Status      string
+	ExecutionID  string
+	Ref          string
+	Status       string
+	CapabilityID string
 
 	Inputs  *values.Map
 	Outputs StepOutput
 
+	StartedAt *time.Time
 	UpdatedAt *time.Time
 }
 
@@ -66,3 +70,28 @@ func (w WorkflowExecution) ResultForStep(s string) (*exec.Result, bool) {
 }
 
 var _ exec.Results = WorkflowExecution{}
+
+// ListFilter selects workflow executions to list. Zero fields are ignored.
+type ListFilter struct {
+	WorkflowID    string
+	Status        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Offset        int
+	Limit         int
+}
+
+// DeadLetter is a failed workflow execution, kept along with the trigger event
+// that started it so that it can be replayed.
+type DeadLetter struct {
+	ID           int64
+	WorkflowID   string
+	ExecutionID  string
+	Status       string
+	Error        string
+	TriggerEvent *values.Map
+
+	CreatedAt         time.Time
+	ReplayedAt        *time.Time
+	ReplayExecutionID string
+}
@@ -2,14 +2,32 @@ package store
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrNotFound is returned when there is no workflow execution with the given ID.
+var ErrNotFound = errors.New("workflow execution not found")
+
 type Store interface {
 	Add(ctx context.Context, state *WorkflowExecution) (WorkflowExecution, error)
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	// List returns executions matching the filter, newest first, without their
+	// steps, along with the total number of matching executions.
+	List(ctx context.Context, filter ListFilter) ([]WorkflowExecution, int, error)
+	// ResetSteps deletes the given steps of an execution and marks it as started again.
+	ResetSteps(ctx context.Context, executionID string, stepRefs []string) (WorkflowExecution, error)
+	// AddDeadLetter records a failed execution. A dead letter that already exists
+	// for the execution is replaced, keeping its trigger event.
+	AddDeadLetter(ctx context.Context, deadLetter DeadLetter) error
+	// ListDeadLetters returns the dead letters of a workflow, newest first, along
+	// with the total number of dead letters of the workflow.
+	ListDeadLetters(ctx context.Context, workflowID string, offset, limit int) ([]DeadLetter, int, error)
+	GetDeadLetter(ctx context.Context, id int64) (DeadLetter, error)
+	// MarkDeadLetterReplayed records the execution that replayed a dead letter.
+	MarkDeadLetterReplayed(ctx context.Context, id int64, replayExecutionID string) error
 }
 
 var _ Store = (*DBStore)(nil)
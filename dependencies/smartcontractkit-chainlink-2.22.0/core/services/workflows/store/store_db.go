@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -59,9 +61,11 @@ type workflowStepRow struct {
 	WorkflowExecutionID string `db:"workflow_execution_id"`
 	Ref                 string
 	Status              string
+	CapabilityID        *string `db:"capability_id"`
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	StartedAt           *time.Time `db:"started_at"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -71,9 +75,11 @@ type workflowExecutionWithStep struct {
 	WSWorkflowExecutionID string     `db:"ws_workflow_execution_id"`
 	WSRef                 string     `db:"ws_ref"`
 	WSStatus              string     `db:"ws_status"`
+	WSCapabilityID        *string    `db:"ws_capability_id"`
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSStartedAt           *time.Time `db:"ws_started_at"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -175,9 +181,11 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 			workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
 			workflow_steps.ref AS ws_ref,
 			workflow_steps.status AS ws_status,
+			workflow_steps.capability_id AS ws_capability_id,
 			workflow_steps.inputs AS ws_inputs,
 			workflow_steps.output_err AS ws_output_err,
 			workflow_steps.output_value AS ws_output_value,
+			workflow_steps.started_at AS ws_started_at,
 			workflow_steps.updated_at AS ws_updated_at
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
@@ -194,7 +202,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrNotFound)
 	}
 	return *state, nil
 }
@@ -225,6 +233,8 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
+			CapabilityID:        jr.WSCapabilityID,
+			StartedAt:           jr.WSStartedAt,
 			UpdatedAt:           jr.WSUpdatedAt,
 		})
 		if err != nil {
@@ -275,15 +285,23 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		}
 	}
 
+	var capabilityID string
+	if step.CapabilityID != nil {
+		capabilityID = *step.CapabilityID
+	}
+
 	return &WorkflowExecutionStep{
-		ExecutionID: step.WorkflowExecutionID,
-		Ref:         step.Ref,
-		Status:      step.Status,
-		Inputs:      inputs,
+		ExecutionID:  step.WorkflowExecutionID,
+		Ref:          step.Ref,
+		Status:       step.Status,
+		CapabilityID: capabilityID,
+		Inputs:       inputs,
 		Outputs: StepOutput{
 			Err:   outputErr,
 			Value: outputs,
 		},
+		StartedAt: step.StartedAt,
+		UpdatedAt: step.UpdatedAt,
 	}, nil
 }
 
@@ -303,6 +321,11 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		Ref:                 state.Ref,
 		Status:              state.Status,
 		Inputs:              inpb,
+		StartedAt:           state.StartedAt,
+		UpdatedAt:           state.UpdatedAt,
+	}
+	if state.CapabilityID != "" {
+		wsr.CapabilityID = &state.CapabilityID
 	}
 
 	if state.Outputs.Value != nil {
@@ -382,16 +405,18 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, capability_id, inputs, output_err, output_value, started_at, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :capability_id, :inputs, :output_err, :output_value, :started_at, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
 		ref = EXCLUDED.ref,
 		status = EXCLUDED.status,
+		capability_id = EXCLUDED.capability_id,
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
+		started_at = EXCLUDED.started_at,
 		updated_at = EXCLUDED.updated_at;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
@@ -432,9 +457,11 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 		workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
 		workflow_steps.ref AS ws_ref,
 		workflow_steps.status AS ws_status,
+		workflow_steps.capability_id AS ws_capability_id,
 		workflow_steps.inputs AS ws_inputs,
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.started_at AS ws_started_at,
 		workflow_steps.updated_at AS ws_updated_at,
 		workflow_executions.id AS we_id,
 		workflow_executions.workflow_id AS we_workflow_id,
@@ -470,6 +497,209 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 	return states, nil
 }
 
+// List returns the workflow executions matching the filter, most recent first,
+// without their steps. The total number of matching executions is returned
+// alongside for pagination.
+func (d *DBStore) List(ctx context.Context, filter ListFilter) ([]WorkflowExecution, int, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.WorkflowID != "" {
+		addCond("workflow_id = $%d", filter.WorkflowID)
+	}
+	if filter.Status != "" {
+		addCond("status = $%d", filter.Status)
+	}
+	if filter.CreatedAfter != nil {
+		addCond("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCond("created_at < $%d", *filter.CreatedBefore)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, "SELECT count(*) FROM workflow_executions "+where, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = count
+	}
+	sql := fmt.Sprintf(`SELECT * FROM workflow_executions %s ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+	var rows []workflowExecutionRow
+	err = d.db.SelectContext(ctx, &rows, sql, append(args, limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	executions := make([]WorkflowExecution, 0, len(rows))
+	for _, row := range rows {
+		var workflowID string
+		if row.WorkflowID != nil {
+			workflowID = *row.WorkflowID
+		}
+		executions = append(executions, WorkflowExecution{
+			ExecutionID: row.ID,
+			WorkflowID:  workflowID,
+			Status:      row.Status,
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			FinishedAt:  row.FinishedAt,
+		})
+	}
+	return executions, count, nil
+}
+
+// ResetSteps deletes the given steps of a finished execution and marks the
+// execution as started again, so that the steps can be re-executed.
+func (d *DBStore) ResetSteps(ctx context.Context, executionID string, stepRefs []string) (WorkflowExecution, error) {
+	var execution WorkflowExecution
+	err := d.transact(ctx, func(db *DBStore) error {
+		_, err := db.db.ExecContext(ctx, `DELETE FROM workflow_steps WHERE workflow_execution_id = $1 AND ref = ANY($2)`, executionID, pq.Array(stepRefs))
+		if err != nil {
+			return fmt.Errorf("could not delete steps of workflow execution %s: %w", executionID, err)
+		}
+		res, err := db.db.ExecContext(ctx, `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = NULL WHERE id = $3`, StatusStarted, d.clock.Now(), executionID)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrNotFound)
+		}
+		execution, err = db.Get(ctx, executionID)
+		return err
+	})
+	return execution, err
+}
+
+// `workflowDeadLetterRow` describes a row
+// of the `workflow_dead_letters` table
+type workflowDeadLetterRow struct {
+	ID                int64      `db:"id"`
+	WorkflowID        string     `db:"workflow_id"`
+	ExecutionID       string     `db:"workflow_execution_id"`
+	Status            string     `db:"status"`
+	Error             *string    `db:"error"`
+	TriggerEvent      []byte     `db:"trigger_event"`
+	CreatedAt         time.Time  `db:"created_at"`
+	ReplayedAt        *time.Time `db:"replayed_at"`
+	ReplayExecutionID *string    `db:"replay_execution_id"`
+}
+
+func (r workflowDeadLetterRow) toDeadLetter() (DeadLetter, error) {
+	dl := DeadLetter{
+		ID:          r.ID,
+		WorkflowID:  r.WorkflowID,
+		ExecutionID: r.ExecutionID,
+		Status:      r.Status,
+		CreatedAt:   r.CreatedAt,
+		ReplayedAt:  r.ReplayedAt,
+	}
+	if r.Error != nil {
+		dl.Error = *r.Error
+	}
+	if r.ReplayExecutionID != nil {
+		dl.ReplayExecutionID = *r.ReplayExecutionID
+	}
+	if len(r.TriggerEvent) > 0 {
+		vmProto := &valuespb.Map{}
+		if err := proto.Unmarshal(r.TriggerEvent, vmProto); err != nil {
+			return DeadLetter{}, err
+		}
+		event, err := values.FromMapValueProto(vmProto)
+		if err != nil {
+			return DeadLetter{}, err
+		}
+		dl.TriggerEvent = event
+	}
+	return dl, nil
+}
+
+// AddDeadLetter records a failed execution along with its trigger event.
+func (d *DBStore) AddDeadLetter(ctx context.Context, deadLetter DeadLetter) error {
+	var event []byte
+	if deadLetter.TriggerEvent != nil {
+		b, err := proto.Marshal(values.Proto(deadLetter.TriggerEvent).GetMapValue())
+		if err != nil {
+			return err
+		}
+		event = b
+	}
+	var errStr *string
+	if deadLetter.Error != "" {
+		errStr = &deadLetter.Error
+	}
+
+	_, err := d.db.ExecContext(ctx, `
+	INSERT INTO workflow_dead_letters(workflow_id, workflow_execution_id, status, error, trigger_event, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (workflow_execution_id)
+	DO UPDATE SET
+		status = EXCLUDED.status,
+		error = EXCLUDED.error,
+		trigger_event = COALESCE(EXCLUDED.trigger_event, workflow_dead_letters.trigger_event),
+		created_at = EXCLUDED.created_at`,
+		deadLetter.WorkflowID, deadLetter.ExecutionID, deadLetter.Status, errStr, event, d.clock.Now())
+	return err
+}
+
+// ListDeadLetters returns the dead letters of a workflow, newest first.
+func (d *DBStore) ListDeadLetters(ctx context.Context, workflowID string, offset, limit int) ([]DeadLetter, int, error) {
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_dead_letters WHERE workflow_id = $1`, workflowID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if limit <= 0 {
+		limit = count
+	}
+
+	var rows []workflowDeadLetterRow
+	err = d.db.SelectContext(ctx, &rows, `SELECT * FROM workflow_dead_letters WHERE workflow_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3`, workflowID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	deadLetters := make([]DeadLetter, 0, len(rows))
+	for _, row := range rows {
+		dl, err := row.toDeadLetter()
+		if err != nil {
+			return nil, 0, err
+		}
+		deadLetters = append(deadLetters, dl)
+	}
+	return deadLetters, count, nil
+}
+
+// GetDeadLetter fetches a dead letter by ID.
+func (d *DBStore) GetDeadLetter(ctx context.Context, id int64) (DeadLetter, error) {
+	var row workflowDeadLetterRow
+	err := d.db.GetContext(ctx, &row, `SELECT * FROM workflow_dead_letters WHERE id = $1`, id)
+	if err != nil {
+		return DeadLetter{}, fmt.Errorf("could not find workflow dead letter with id %d: %w", id, err)
+	}
+	return row.toDeadLetter()
+}
+
+// MarkDeadLetterReplayed records the execution that replayed a dead letter.
+func (d *DBStore) MarkDeadLetterReplayed(ctx context.Context, id int64, replayExecutionID string) error {
+	_, err := d.db.ExecContext(ctx, `UPDATE workflow_dead_letters SET replayed_at = $1, replay_execution_id = $2 WHERE id = $3`, d.clock.Now(), replayExecutionID, id)
+	return err
+}
+
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
 	return &DBStore{db: ds, lggr: lggr.Named("WorkflowDBStore"), clock: clock, chStop: make(chan struct{})}
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -313,3 +314,144 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_List(t *testing.T) {
+	store := newTestDBStore(t)
+	clock := clockwork.NewFakeClock()
+	store.clock = clock
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	var ids []string
+	for _, status := range []string{StatusCompleted, StatusErrored, StatusErrored} {
+		id := randomID()
+		ids = append(ids, id)
+		_, err := store.Add(tests.Context(t), &WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+			},
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      status,
+		})
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+
+	executions, count, err := store.List(tests.Context(t), ListFilter{WorkflowID: wid})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 3)
+	// most recent first
+	assert.Equal(t, ids[2], executions[0].ExecutionID)
+	assert.Equal(t, ids[0], executions[2].ExecutionID)
+
+	executions, count, err = store.List(tests.Context(t), ListFilter{WorkflowID: wid, Status: StatusErrored, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, executions, 1)
+	assert.Equal(t, ids[2], executions[0].ExecutionID)
+
+	before := clock.Now().Add(-2 * time.Minute)
+	executions, count, err = store.List(tests.Context(t), ListFilter{WorkflowID: wid, CreatedBefore: &before})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, ids[0], executions[0].ExecutionID)
+}
+
+func Test_StoreDB_ResetSteps(t *testing.T) {
+	store := newTestDBStore(t)
+
+	id := randomID()
+	startedAt := store.clock.Now()
+	_, err := store.Add(tests.Context(t), &WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted, CapabilityID: "consensus@1.0.0", StartedAt: &startedAt},
+			"step2": {ExecutionID: id, Ref: "step2", Status: StatusErrored, Outputs: StepOutput{Err: errors.New("step failed")}},
+		},
+		ExecutionID: id,
+		Status:      StatusStarted,
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateStatus(tests.Context(t), id, StatusErrored))
+
+	es, err := store.ResetSteps(tests.Context(t), id, []string{"step2"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusStarted, es.Status)
+	assert.Nil(t, es.FinishedAt)
+	require.Len(t, es.Steps, 1)
+	assert.Equal(t, "consensus@1.0.0", es.Steps["step1"].CapabilityID)
+	assert.True(t, startedAt.Equal(*es.Steps["step1"].StartedAt))
+
+	_, err = store.ResetSteps(tests.Context(t), randomID(), []string{"step2"})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = store.Get(tests.Context(t), randomID())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func Test_StoreDB_DeadLetters(t *testing.T) {
+	store := newTestDBStore(t)
+	ctx := tests.Context(t)
+
+	wid := randomID()
+	event, err := values.NewMap(map[string]any{"fee": "100"})
+	require.NoError(t, err)
+
+	first := randomID()
+	require.NoError(t, store.AddDeadLetter(ctx, DeadLetter{
+		WorkflowID:   wid,
+		ExecutionID:  first,
+		Status:       StatusErrored,
+		Error:        "step failed",
+		TriggerEvent: event,
+	}))
+
+	// re-adding a dead letter keeps its trigger event
+	require.NoError(t, store.AddDeadLetter(ctx, DeadLetter{
+		WorkflowID:  wid,
+		ExecutionID: first,
+		Status:      StatusTimeout,
+		Error:       "execution timed out",
+	}))
+	require.NoError(t, store.AddDeadLetter(ctx, DeadLetter{
+		WorkflowID:  wid,
+		ExecutionID: randomID(),
+		Status:      StatusErrored,
+	}))
+	require.NoError(t, store.AddDeadLetter(ctx, DeadLetter{
+		WorkflowID:  randomID(),
+		ExecutionID: randomID(),
+		Status:      StatusErrored,
+	}))
+
+	dls, count, err := store.ListDeadLetters(ctx, wid, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, dls, 1)
+
+	dls, _, err = store.ListDeadLetters(ctx, wid, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, dls, 2)
+
+	var dl DeadLetter
+	for _, d := range dls {
+		if d.ExecutionID == first {
+			dl = d
+		}
+	}
+	assert.Equal(t, StatusTimeout, dl.Status)
+	assert.Equal(t, "execution timed out", dl.Error)
+	assert.Equal(t, event, dl.TriggerEvent)
+	assert.Nil(t, dl.ReplayedAt)
+
+	replayID := randomID()
+	require.NoError(t, store.MarkDeadLetterReplayed(ctx, dl.ID, replayID))
+	got, err := store.GetDeadLetter(ctx, dl.ID)
+	require.NoError(t, err)
+	assert.Equal(t, replayID, got.ReplayExecutionID)
+	assert.NotNil(t, got.ReplayedAt)
+
+	_, err = store.GetDeadLetter(ctx, dl.ID+100)
+	require.Error(t, err)
+}
@@ -0,0 +1,362 @@
+package syncer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	pkgworkflows "github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+const (
+	devModeBinaryExt      = ".wasm"
+	devModeTriggerFixture = ".trigger.json"
+)
+
+// devModeConfigExts are the extensions of the config file of a workflow, in
+// order of precedence.
+var devModeConfigExts = []string{".yaml", ".yml", ".json"}
+
+// devModeOwner owns all the workflows run in dev mode.
+var devModeOwner = make([]byte, 20)
+
+type DevModeConfig struct {
+	// Directory is watched for workflows. Each <name>.wasm file is a workflow
+	// binary, with an optional <name>.yaml, <name>.yml or <name>.json config and
+	// an optional <name>.trigger.json holding the outputs of its trigger events.
+	Directory string
+	// PollInterval is the interval at which Directory is checked for changes.
+	PollInterval time.Duration
+	// TriggerInterval is the interval at which the dev mode triggers fire.
+	TriggerInterval time.Duration
+}
+
+// devModeArtifacts are the files of a workflow found in the dev mode directory.
+type devModeArtifacts struct {
+	binary  []byte
+	config  []byte
+	outputs map[string]any
+	version [32]byte
+}
+
+// devModeWorkflow is a workflow loaded by the DevModeWatcher.
+type devModeWorkflow struct {
+	id      string
+	version [32]byte
+}
+
+var _ services.Service = (*DevModeWatcher)(nil)
+
+// DevModeWatcher runs the workflows found in a local directory instead of the
+// ones registered in the WorkflowRegistry contract, so that workflows can be
+// developed fully offline. The directory is polled for changes and the engine
+// of a workflow is rebuilt whenever its binary, config or trigger fixture
+// changes. Triggers and targets that are not available in the capabilities
+// registry are replaced by in-process mocks.
+type DevModeWatcher struct {
+	services.StateMachine
+
+	// close stopCh to stop the DevModeWatcher.
+	stopCh services.StopChan
+
+	// all goroutines are waited on with wg.
+	wg sync.WaitGroup
+
+	lggr    logger.Logger
+	handler *eventHandler
+	cfg     DevModeConfig
+
+	// workflows are keyed by name, and only accessed from the poll loop.
+	workflows map[string]*devModeWorkflow
+
+	mu      sync.RWMutex
+	outputs map[string]map[string]any
+}
+
+// NewDevModeWatcher returns a new DevModeWatcher creating workflow engines
+// with the given event handler.
+func NewDevModeWatcher(lggr logger.Logger, handler *eventHandler, cfg DevModeConfig) *DevModeWatcher {
+	return &DevModeWatcher{
+		stopCh:    make(services.StopChan),
+		lggr:      lggr.Named("WorkflowDevMode"),
+		handler:   handler,
+		cfg:       cfg,
+		workflows: map[string]*devModeWorkflow{},
+		outputs:   map[string]map[string]any{},
+	}
+}
+
+func (w *DevModeWatcher) Start(_ context.Context) error {
+	return w.StartOnce(w.Name(), func() error {
+		w.lggr.Warnw("Workflow dev mode is enabled, workflows are loaded from disk and use mock triggers and targets", "directory", w.cfg.Directory)
+
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			ctx, cancel := w.stopCh.NewCtx()
+			defer cancel()
+
+			ticker := time.NewTicker(w.cfg.PollInterval)
+			defer ticker.Stop()
+			for {
+				w.sync(ctx)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+func (w *DevModeWatcher) Close() error {
+	return w.StopOnce(w.Name(), func() error {
+		close(w.stopCh)
+		w.wg.Wait()
+
+		var err error
+		for _, wf := range w.workflows {
+			err = errors.Join(err, w.handler.tryEngineCleanup(wf.id))
+		}
+		return err
+	})
+}
+
+func (w *DevModeWatcher) Ready() error {
+	return nil
+}
+
+func (w *DevModeWatcher) HealthReport() map[string]error {
+	return map[string]error{w.Name(): w.Healthy()}
+}
+
+func (w *DevModeWatcher) Name() string {
+	return w.lggr.Name()
+}
+
+// sync brings the running workflows in line with the contents of the directory.
+func (w *DevModeWatcher) sync(ctx context.Context) {
+	found, err := w.scan()
+	if err != nil {
+		w.lggr.Errorw("Failed to read workflows directory", "directory", w.cfg.Directory, "err", err)
+		return
+	}
+
+	for name, wf := range w.workflows {
+		if _, ok := found[name]; ok {
+			continue
+		}
+		w.lggr.Infow("Workflow removed, stopping it", "workflowName", name, "workflowID", wf.id)
+		w.stop(ctx, name, wf)
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		artifacts := found[name]
+		if wf, ok := w.workflows[name]; ok && wf.version == artifacts.version {
+			continue
+		}
+		if err := w.load(ctx, name, artifacts); err != nil {
+			w.lggr.Errorw("Failed to load workflow", "workflowName", name, "err", err)
+		}
+	}
+}
+
+// scan reads the artifacts of all the workflows in the directory.
+func (w *DevModeWatcher) scan() (map[string]devModeArtifacts, error) {
+	entries, err := os.ReadDir(w.cfg.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]devModeArtifacts{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != devModeBinaryExt {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), devModeBinaryExt)
+		artifacts, err := w.readArtifacts(name)
+		if err != nil {
+			w.lggr.Errorw("Failed to read workflow artifacts", "workflowName", name, "err", err)
+			continue
+		}
+		found[name] = artifacts
+	}
+	return found, nil
+}
+
+func (w *DevModeWatcher) readArtifacts(name string) (a devModeArtifacts, err error) {
+	base := filepath.Join(w.cfg.Directory, name)
+	if a.binary, err = os.ReadFile(base + devModeBinaryExt); err != nil {
+		return a, err
+	}
+
+	for _, ext := range devModeConfigExts {
+		a.config, err = os.ReadFile(base + ext)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return a, err
+		}
+	}
+
+	fixture, err := os.ReadFile(base + devModeTriggerFixture)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return a, err
+	}
+	if len(fixture) > 0 {
+		if err = json.Unmarshal(fixture, &a.outputs); err != nil {
+			return a, fmt.Errorf("invalid trigger fixture: %w", err)
+		}
+	}
+
+	h := sha256.New()
+	for _, b := range [][]byte{a.binary, a.config, fixture} {
+		_, _ = h.Write(b)
+		_, _ = h.Write([]byte{0})
+	}
+	copy(a.version[:], h.Sum(nil))
+	return a, nil
+}
+
+// load (re)starts the engine of a workflow with the given artifacts.
+func (w *DevModeWatcher) load(ctx context.Context, name string, a devModeArtifacts) error {
+	if wf, ok := w.workflows[name]; ok {
+		w.lggr.Infow("Workflow changed, reloading it", "workflowName", name, "workflowID", wf.id)
+		if err := w.handler.tryEngineCleanup(wf.id); err != nil {
+			return err
+		}
+		w.setOutputs(wf.id, nil)
+	}
+
+	hash, err := pkgworkflows.GenerateWorkflowID(devModeOwner, name, a.binary, a.config, "")
+	if err != nil {
+		return fmt.Errorf("failed to generate workflow id: %w", err)
+	}
+	wfID := hex.EncodeToString(hash[:])
+	owner := hex.EncodeToString(devModeOwner)
+
+	// remember the version even if loading fails, so that it is only retried
+	// once the files change
+	w.workflows[name] = &devModeWorkflow{id: wfID, version: a.version}
+
+	if err = w.addMockCapabilities(ctx, a); err != nil {
+		return err
+	}
+
+	entry := &job.WorkflowSpec{
+		Workflow:      hex.EncodeToString(a.binary),
+		Config:        string(a.config),
+		WorkflowID:    wfID,
+		Status:        job.WorkflowSpecStatusActive,
+		WorkflowOwner: owner,
+		WorkflowName:  name,
+		SpecType:      job.WASMFile,
+	}
+	if _, err = w.handler.orm.UpsertWorkflowSpec(ctx, entry); err != nil {
+		return fmt.Errorf("failed to upsert workflow spec: %w", err)
+	}
+
+	w.setOutputs(wfID, a.outputs)
+	engine, err := w.handler.engineFactory(ctx, wfID, owner, workflowName{name: name}, a.config, a.binary)
+	if err != nil {
+		return fmt.Errorf("failed to create workflow engine: %w", err)
+	}
+	if err = engine.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start workflow engine: %w", err)
+	}
+	if err = w.handler.engineRegistry.Add(wfID, engine); err != nil {
+		return errors.Join(err, engine.Close())
+	}
+
+	w.lggr.Infow("Workflow loaded", "workflowName", name, "workflowID", wfID)
+	return nil
+}
+
+func (w *DevModeWatcher) stop(ctx context.Context, name string, wf *devModeWorkflow) {
+	delete(w.workflows, name)
+	w.setOutputs(wf.id, nil)
+	if err := w.handler.tryEngineCleanup(wf.id); err != nil {
+		w.lggr.Errorw("Failed to stop workflow engine", "workflowName", name, "err", err)
+	}
+	if err := w.handler.orm.DeleteWorkflowSpec(ctx, hex.EncodeToString(devModeOwner), name); err != nil {
+		w.lggr.Errorw("Failed to delete workflow spec", "workflowName", name, "err", err)
+	}
+}
+
+// addMockCapabilities registers an in-process mock for every trigger and
+// target of the workflow that is missing from the capabilities registry.
+func (w *DevModeWatcher) addMockCapabilities(ctx context.Context, a devModeArtifacts) error {
+	moduleConfig := &host.ModuleConfig{Logger: w.lggr, Labeler: w.handler.emitter}
+	sdkSpec, err := host.GetWorkflowSpec(ctx, moduleConfig, a.binary, a.config)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow sdk spec: %w", err)
+	}
+
+	for _, t := range sdkSpec.Triggers {
+		if _, gerr := w.handler.capRegistry.Get(ctx, t.ID); gerr == nil {
+			continue
+		}
+		trigger, err := newDevModeTrigger(w.lggr, t.ID, w.cfg.TriggerInterval, w.getOutputs, w.stopCh, &w.wg)
+		if err != nil {
+			return fmt.Errorf("failed to create mock trigger %s: %w", t.ID, err)
+		}
+		if err = w.handler.capRegistry.Add(ctx, trigger); err != nil {
+			return fmt.Errorf("failed to add mock trigger %s: %w", t.ID, err)
+		}
+		w.lggr.Infow("Added mock trigger", "capabilityID", t.ID)
+	}
+
+	for _, t := range sdkSpec.Targets {
+		if _, gerr := w.handler.capRegistry.Get(ctx, t.ID); gerr == nil {
+			continue
+		}
+		target, err := newDevModeTarget(w.lggr, t.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create mock target %s: %w", t.ID, err)
+		}
+		if err = w.handler.capRegistry.Add(ctx, target); err != nil {
+			return fmt.Errorf("failed to add mock target %s: %w", t.ID, err)
+		}
+		w.lggr.Infow("Added mock target", "capabilityID", t.ID)
+	}
+	return nil
+}
+
+func (w *DevModeWatcher) setOutputs(workflowID string, outputs map[string]any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if outputs == nil {
+		delete(w.outputs, workflowID)
+		return
+	}
+	w.outputs[workflowID] = outputs
+}
+
+func (w *DevModeWatcher) getOutputs(workflowID string) map[string]any {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.outputs[workflowID]
+}
@@ -0,0 +1,173 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var _ capabilities.TriggerCapability = (*devModeTrigger)(nil)
+
+// devModeTrigger is an in-process trigger that fires every interval for each
+// workflow registered to it. The outputs of an event are read from the trigger
+// fixture of the workflow, if any, or otherwise only contain the timestamp.
+type devModeTrigger struct {
+	capabilities.CapabilityInfo
+	lggr     logger.Logger
+	interval time.Duration
+	outputs  func(workflowID string) map[string]any
+	stopCh   services.StopChan
+	wg       *sync.WaitGroup
+
+	mu            sync.Mutex
+	registrations map[string]chan struct{}
+}
+
+func newDevModeTrigger(
+	lggr logger.Logger,
+	id string,
+	interval time.Duration,
+	outputs func(workflowID string) map[string]any,
+	stopCh services.StopChan,
+	wg *sync.WaitGroup,
+) (*devModeTrigger, error) {
+	info, err := capabilities.NewCapabilityInfo(id, capabilities.CapabilityTypeTrigger, "dev mode trigger")
+	if err != nil {
+		return nil, err
+	}
+	return &devModeTrigger{
+		CapabilityInfo: info,
+		lggr:           lggr.Named("DevModeTrigger").With("capabilityID", id),
+		interval:       interval,
+		outputs:        outputs,
+		stopCh:         stopCh,
+		wg:             wg,
+		registrations:  map[string]chan struct{}{},
+	}, nil
+}
+
+func (t *devModeTrigger) RegisterTrigger(_ context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.registrations[req.TriggerID]; ok {
+		return nil, fmt.Errorf("trigger %s is already registered", req.TriggerID)
+	}
+	done := make(chan struct{})
+	t.registrations[req.TriggerID] = done
+
+	ch := make(chan capabilities.TriggerResponse)
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer close(ch)
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			resp, err := t.newEvent(req)
+			if err != nil {
+				t.lggr.Errorw("failed to build trigger event", "triggerID", req.TriggerID, "err", err)
+			} else {
+				select {
+				case ch <- resp:
+				case <-done:
+					return
+				case <-t.stopCh:
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			case <-t.stopCh:
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (t *devModeTrigger) UnregisterTrigger(_ context.Context, req capabilities.TriggerRegistrationRequest) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	done, ok := t.registrations[req.TriggerID]
+	if !ok {
+		return fmt.Errorf("trigger %s is not registered", req.TriggerID)
+	}
+	close(done)
+	delete(t.registrations, req.TriggerID)
+	return nil
+}
+
+func (t *devModeTrigger) newEvent(req capabilities.TriggerRegistrationRequest) (capabilities.TriggerResponse, error) {
+	now := time.Now()
+	outputs := t.outputs(req.Metadata.WorkflowID)
+	if outputs == nil {
+		outputs = map[string]any{"timestamp": now.Unix()}
+	}
+	m, err := values.NewMap(outputs)
+	if err != nil {
+		return capabilities.TriggerResponse{}, err
+	}
+	return capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: t.ID,
+			ID:          fmt.Sprintf("%s_%d", req.TriggerID, now.UnixNano()),
+			Outputs:     m,
+		},
+	}, nil
+}
+
+var _ capabilities.TargetCapability = (*devModeTarget)(nil)
+
+// devModeTarget is an in-process target that logs the inputs it receives
+// instead of writing them anywhere.
+type devModeTarget struct {
+	capabilities.CapabilityInfo
+	lggr logger.Logger
+}
+
+func newDevModeTarget(lggr logger.Logger, id string) (*devModeTarget, error) {
+	info, err := capabilities.NewCapabilityInfo(id, capabilities.CapabilityTypeTarget, "dev mode target")
+	if err != nil {
+		return nil, err
+	}
+	return &devModeTarget{
+		CapabilityInfo: info,
+		lggr:           lggr.Named("DevModeTarget").With("capabilityID", id),
+	}, nil
+}
+
+func (t *devModeTarget) Execute(_ context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	var inputs any
+	if req.Inputs != nil {
+		unwrapped, err := req.Inputs.Unwrap()
+		if err != nil {
+			return capabilities.CapabilityResponse{}, fmt.Errorf("failed to unwrap inputs: %w", err)
+		}
+		inputs = unwrapped
+	}
+	t.lggr.Infow("Target executed", "workflowID", req.Metadata.WorkflowID, "executionID", req.Metadata.WorkflowExecutionID, "inputs", inputs)
+
+	value, err := values.NewMap(map[string]any{})
+	if err != nil {
+		return capabilities.CapabilityResponse{}, err
+	}
+	return capabilities.CapabilityResponse{Value: value}, nil
+}
+
+func (t *devModeTarget) RegisterToWorkflow(_ context.Context, _ capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (t *devModeTarget) UnregisterFromWorkflow(_ context.Context, _ capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
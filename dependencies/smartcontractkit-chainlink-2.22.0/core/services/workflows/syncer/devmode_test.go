@@ -0,0 +1,169 @@
+package syncer
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commoncap "github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/custmsg"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	pkgworkflows "github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/workflowkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/ratelimiter"
+	wfstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncerlimiter"
+)
+
+func Test_DevModeWatcher(t *testing.T) {
+	var (
+		ctx      = testutils.Context(t)
+		lggr     = logger.TestLogger(t)
+		db       = pgtest.NewSqlxDB(t)
+		orm      = NewWorkflowRegistryDS(db, lggr)
+		registry = capabilities.NewRegistry(lggr)
+		binary   = wasmtest.CreateTestBinary(binaryCmd, binaryLocation, true, t)
+		dir      = t.TempDir()
+		owner    = hex.EncodeToString(devModeOwner)
+	)
+	registry.SetLocalRegistry(&capabilities.TestMetadataRegistry{})
+
+	var (
+		mu      sync.Mutex
+		created []string
+	)
+	engineFactory := func(ctx context.Context, wfid string, owner string, name workflows.WorkflowNamer, config []byte, binary []byte) (services.Service, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		created = append(created, wfid)
+		return &mockEngine{}, nil
+	}
+	lastCreated := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(created) == 0 {
+			return ""
+		}
+		return created[len(created)-1]
+	}
+
+	rl, err := ratelimiter.NewRateLimiter(rlConfig)
+	require.NoError(t, err)
+	workflowLimits, err := syncerlimiter.NewWorkflowLimits(syncerlimiter.Config{Global: 200, PerOwner: 200})
+	require.NoError(t, err)
+	er := NewEngineRegistry()
+	h := NewEventHandler(lggr, orm, nil, wfstore.NewDBStore(db, lggr, clockwork.NewFakeClock()), registry, custmsg.NewLabeler(),
+		clockwork.NewFakeClock(), workflowkey.Key{}, rl, workflowLimits, WithEngineRegistry(er), WithEngineFactoryFn(engineFactory))
+
+	w := NewDevModeWatcher(lggr, h, DevModeConfig{
+		Directory:       dir,
+		PollInterval:    100 * time.Millisecond,
+		TriggerInterval: time.Second,
+	})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fees.wasm"), binary, 0600))
+	servicetest.Run(t, w)
+
+	firstID, err := pkgworkflows.GenerateWorkflowID(devModeOwner, "fees", binary, nil, "")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return er.IsRunning(hex.EncodeToString(firstID[:]))
+	}, testutils.WaitTimeout(t), 50*time.Millisecond)
+
+	spec, err := orm.GetWorkflowSpec(ctx, owner, "fees")
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(firstID[:]), spec.WorkflowID)
+
+	caps, err := registry.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, caps, 1)
+	info, err := caps[0].Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, commoncap.CapabilityTypeTrigger, info.CapabilityType)
+
+	t.Run("reloads the workflow when its config changes", func(t *testing.T) {
+		config := []byte("fee: 1")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "fees.yaml"), config, 0600))
+
+		secondID, err := pkgworkflows.GenerateWorkflowID(devModeOwner, "fees", binary, config, "")
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return lastCreated() == hex.EncodeToString(secondID[:]) && er.IsRunning(hex.EncodeToString(secondID[:]))
+		}, testutils.WaitTimeout(t), 50*time.Millisecond)
+		assert.False(t, er.IsRunning(hex.EncodeToString(firstID[:])))
+
+		// the mock trigger is only added once
+		caps, err := registry.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, caps, 1)
+	})
+
+	t.Run("stops the workflow when its binary is removed", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "fees.wasm")))
+
+		require.Eventually(t, func() bool {
+			_, err := orm.GetWorkflowSpec(ctx, owner, "fees")
+			return err != nil
+		}, testutils.WaitTimeout(t), 50*time.Millisecond)
+		_, err := er.Get(lastCreated())
+		assert.Error(t, err)
+	})
+}
+
+func Test_devModeTrigger(t *testing.T) {
+	var (
+		ctx    = testutils.Context(t)
+		lggr   = logger.TestLogger(t)
+		stopCh = make(services.StopChan)
+		wg     sync.WaitGroup
+	)
+	defer func() {
+		close(stopCh)
+		wg.Wait()
+	}()
+
+	outputs := func(workflowID string) map[string]any {
+		if workflowID == "with-fixture" {
+			return map[string]any{"fee": "100"}
+		}
+		return nil
+	}
+	trigger, err := newDevModeTrigger(lggr, "cron-trigger@1.0.0", time.Hour, outputs, stopCh, &wg)
+	require.NoError(t, err)
+
+	req := commoncap.TriggerRegistrationRequest{
+		TriggerID: "trigger_0",
+		Metadata:  commoncap.RequestMetadata{WorkflowID: "with-fixture"},
+	}
+	ch, err := trigger.RegisterTrigger(ctx, req)
+	require.NoError(t, err)
+
+	_, err = trigger.RegisterTrigger(ctx, req)
+	require.Error(t, err)
+
+	resp := <-ch
+	assert.Equal(t, "cron-trigger@1.0.0", resp.Event.TriggerType)
+	var got map[string]string
+	require.NoError(t, resp.Event.Outputs.UnwrapTo(&got))
+	assert.Equal(t, map[string]string{"fee": "100"}, got)
+
+	require.NoError(t, trigger.UnregisterTrigger(ctx, req))
+	_, open := <-ch
+	assert.False(t, open)
+	require.Error(t, trigger.UnregisterTrigger(ctx, req))
+}
@@ -178,6 +178,7 @@ type eventHandler struct {
 	engineFactory            engineFactoryFn
 	ratelimiter              *ratelimiter.RateLimiter
 	workflowLimits           *syncerlimiter.Limits
+	executions               *workflows.ExecutionHistory
 }
 
 type Event interface {
@@ -199,6 +200,12 @@ func WithEngineFactoryFn(efn engineFactoryFn) func(*eventHandler) {
 	}
 }
 
+func WithExecutionHistory(eh *workflows.ExecutionHistory) func(*eventHandler) {
+	return func(e *eventHandler) {
+		e.executions = eh
+	}
+}
+
 func WithMaxArtifactSize(cfg ArtifactConfig) func(*eventHandler) {
 	return func(eh *eventHandler) {
 		eh.limits = &cfg
@@ -605,18 +612,19 @@ func (h *eventHandler) engineFactoryFn(ctx context.Context, id string, owner str
 	}
 
 	cfg := workflows.Config{
-		Lggr:           h.lggr,
-		Workflow:       *sdkSpec,
-		WorkflowID:     id,
-		WorkflowOwner:  owner, // this gets hex encoded in the engine.
-		WorkflowName:   name,
-		Registry:       h.capRegistry,
-		Store:          h.workflowStore,
-		Config:         config,
-		Binary:         binary,
-		SecretsFetcher: h,
-		RateLimiter:    h.ratelimiter,
-		WorkflowLimits: h.workflowLimits,
+		Lggr:             h.lggr,
+		Workflow:         *sdkSpec,
+		WorkflowID:       id,
+		WorkflowOwner:    owner, // this gets hex encoded in the engine.
+		WorkflowName:     name,
+		Registry:         h.capRegistry,
+		Store:            h.workflowStore,
+		Config:           config,
+		Binary:           binary,
+		SecretsFetcher:   h,
+		RateLimiter:      h.ratelimiter,
+		WorkflowLimits:   h.workflowLimits,
+		ExecutionHistory: h.executions,
 	}
 	return workflows.NewEngine(ctx, cfg)
 }
@@ -0,0 +1,74 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+)
+
+type inMemoryORM struct {
+	mu     sync.RWMutex
+	perms  []Permission
+	nextID int64
+}
+
+var _ ORM = (*inMemoryORM)(nil)
+
+// NewInMemoryORM returns an ORM holding the given permissions in memory.
+func NewInMemoryORM(perms ...Permission) ORM {
+	o := &inMemoryORM{}
+	for _, p := range perms {
+		o.nextID++
+		p.ID = o.nextID
+		o.perms = append(o.perms, p)
+	}
+	return o
+}
+
+func (o *inMemoryORM) ListPermissions(context.Context) ([]Permission, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return append([]Permission(nil), o.perms...), nil
+}
+
+func (o *inMemoryORM) PolicyForSubjects(_ context.Context, subjects []Subject) (Policy, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	var policy Policy
+	for _, p := range o.perms {
+		for _, s := range subjects {
+			if p.SubjectType == s.Type && (p.SubjectID == Wildcard || strings.EqualFold(p.SubjectID, s.ID)) {
+				policy = append(policy, p)
+				break
+			}
+		}
+	}
+	return policy, nil
+}
+
+func (o *inMemoryORM) GrantPermission(_ context.Context, p *Permission) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.nextID++
+	p.ID = o.nextID
+	p.CreatedAt = time.Now()
+	o.perms = append(o.perms, *p)
+	return nil
+}
+
+func (o *inMemoryORM) RevokePermission(_ context.Context, id int64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i, p := range o.perms {
+		if p.ID == id {
+			o.perms = append(o.perms[:i], o.perms[i+1:]...)
+			return nil
+		}
+	}
+	return sql.ErrNoRows
+}
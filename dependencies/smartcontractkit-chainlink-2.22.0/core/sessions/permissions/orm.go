@@ -0,0 +1,107 @@
+package permissions
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+type ORM interface {
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	PolicyForSubjects(ctx context.Context, subjects []Subject) (Policy, error)
+	GrantPermission(ctx context.Context, p *Permission) error
+	RevokePermission(ctx context.Context, id int64) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// ListPermissions returns every permission in the policy.
+func (o *orm) ListPermissions(ctx context.Context) (perms []Permission, err error) {
+	stmt := `SELECT * FROM permissions ORDER BY subject_type, subject_id, resource_type, id;`
+	err = o.ds.SelectContext(ctx, &perms, stmt)
+	return
+}
+
+// PolicyForSubjects returns the permissions granted to any of the subjects, including
+// those granted to every subject of the same type.
+func (o *orm) PolicyForSubjects(ctx context.Context, subjects []Subject) (Policy, error) {
+	if len(subjects) == 0 {
+		return nil, nil
+	}
+	types := make([]string, len(subjects))
+	ids := make([]string, len(subjects))
+	for i, s := range subjects {
+		types[i] = string(s.Type)
+		ids[i] = s.ID
+	}
+	stmt := `SELECT p.* FROM permissions p
+	JOIN unnest($1::text[], $2::text[]) AS s(subject_type, subject_id)
+	ON p.subject_type = s.subject_type AND (p.subject_id = '*' OR lower(p.subject_id) = lower(s.subject_id));`
+	var perms []Permission
+	if err := o.ds.SelectContext(ctx, &perms, stmt, pq.Array(types), pq.Array(ids)); err != nil {
+		return nil, pkgerrors.Wrap(err, "PolicyForSubjects failed to load permissions")
+	}
+	return perms, nil
+}
+
+// GrantPermission validates and saves the permission.
+func (o *orm) GrantPermission(ctx context.Context, p *Permission) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	stmt := `INSERT INTO permissions (subject_type, subject_id, resource_type, resource_selector, action, created_at)
+	VALUES ($1, $2, $3, $4, $5, now())
+	ON CONFLICT (subject_type, subject_id, resource_type, resource_selector, action) DO UPDATE SET subject_type = EXCLUDED.subject_type
+	RETURNING *;`
+	err := o.ds.GetContext(ctx, p, stmt, p.SubjectType, p.SubjectID, p.ResourceType, p.ResourceSelector, p.Action)
+	return pkgerrors.Wrap(err, "GrantPermission failed")
+}
+
+// RevokePermission deletes the permission with the given ID.
+// Returns sql.ErrNoRows if it does not exist.
+func (o *orm) RevokePermission(ctx context.Context, id int64) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM permissions WHERE id = $1`, id)
+	if err != nil {
+		return pkgerrors.Wrap(err, "RevokePermission failed")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// Authorize returns a NotPermittedError unless one of the subjects is granted action on resource.
+func Authorize(ctx context.Context, o ORM, subjects []Subject, resource Resource, action Action) error {
+	policy, err := o.PolicyForSubjects(ctx, subjects)
+	if err != nil {
+		return err
+	}
+	if policy.Allows(subjects, resource, action) {
+		return nil
+	}
+	if resource.LoadTags != nil && resource.Tags == nil && policy.hasTagSelectors() {
+		if resource.Tags, err = resource.LoadTags(ctx); err != nil {
+			return err
+		}
+		if policy.Allows(subjects, resource, action) {
+			return nil
+		}
+	}
+	return NotPermittedError{Subjects: subjects, Resource: resource, Action: action}
+}
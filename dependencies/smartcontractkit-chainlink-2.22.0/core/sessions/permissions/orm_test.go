@@ -0,0 +1,72 @@
+package permissions_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+)
+
+func TestORM_MigratedRolesMatchDefaultPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := permissions.NewORM(pgtest.NewSqlxDB(t))
+	perms, err := orm.ListPermissions(ctx)
+	require.NoError(t, err)
+
+	type key struct {
+		st  permissions.SubjectType
+		sid string
+		rt  permissions.ResourceType
+		sel string
+		a   permissions.Action
+	}
+	var got, want []key
+	for _, p := range perms {
+		got = append(got, key{p.SubjectType, p.SubjectID, p.ResourceType, p.ResourceSelector, p.Action})
+	}
+	for _, p := range permissions.DefaultPolicy() {
+		want = append(want, key{p.SubjectType, p.SubjectID, p.ResourceType, p.ResourceSelector, p.Action})
+	}
+	assert.ElementsMatch(t, want, got)
+}
+
+func TestORM_GrantRevoke(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := permissions.NewORM(pgtest.NewSqlxDB(t))
+	user := sessions.User{Email: "Bridges@Example.com", Role: sessions.UserRoleView}
+	subjects := permissions.SubjectsForUser(user)
+	bridge := permissions.Resource{Type: permissions.ResourceBridges, ID: "my-bridge"}
+
+	require.Error(t, permissions.Authorize(ctx, orm, subjects, bridge, permissions.ActionEdit))
+
+	p := grant(permissions.SubjectUser, "bridges@example.com", permissions.ResourceBridges, "*", permissions.ActionEdit)
+	require.NoError(t, orm.GrantPermission(ctx, &p))
+	assert.NotZero(t, p.ID)
+
+	// granting twice is idempotent
+	dup := p
+	require.NoError(t, orm.GrantPermission(ctx, &dup))
+	assert.Equal(t, p.ID, dup.ID)
+
+	policy, err := orm.PolicyForSubjects(ctx, subjects)
+	require.NoError(t, err)
+	assert.True(t, policy.Allows(subjects, bridge, permissions.ActionEdit))
+	assert.False(t, policy.Allows(subjects, permissions.Resource{Type: permissions.ResourceJobs}, permissions.ActionEdit))
+
+	invalid := grant(permissions.SubjectRole, "nope", permissions.ResourceBridges, "*", permissions.ActionEdit)
+	require.Error(t, orm.GrantPermission(ctx, &invalid))
+
+	require.NoError(t, orm.RevokePermission(ctx, p.ID))
+	require.ErrorIs(t, orm.RevokePermission(ctx, p.ID), sql.ErrNoRows)
+	require.Error(t, permissions.Authorize(ctx, orm, subjects, bridge, permissions.ActionEdit))
+}
@@ -0,0 +1,241 @@
+// Package permissions implements a fine-grained authorization policy on top of the
+// four global user roles. A policy is a set of Permissions, each granting a single
+// Action on a selection of Resources to a Subject.
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// SubjectType is the kind of principal a permission is granted to.
+type SubjectType string
+
+const (
+	// SubjectRole grants a permission to every user holding the given sessions.UserRole.
+	SubjectRole SubjectType = "role"
+	// SubjectUser grants a permission to a single user, identified by email.
+	SubjectUser SubjectType = "user"
+	// SubjectAPIToken grants a permission to a single API token, identified by its access key.
+	SubjectAPIToken SubjectType = "api_token"
+	// SubjectExternalInitiator grants a permission to an external initiator, identified by name.
+	SubjectExternalInitiator SubjectType = "external_initiator"
+)
+
+// Wildcard matches any subject ID, resource type, resource ID or action.
+const Wildcard = "*"
+
+// tagPrefix marks a resource selector that matches on resource tags rather than IDs.
+const tagPrefix = "tag:"
+
+// Subject identifies the principal making a request.
+type Subject struct {
+	Type SubjectType
+	ID   string
+}
+
+func (s Subject) String() string {
+	return fmt.Sprintf("%s:%s", s.Type, s.ID)
+}
+
+// SubjectsForUser returns the subjects an authenticated user acts as: their role and themselves.
+func SubjectsForUser(user sessions.User) []Subject {
+	return []Subject{
+		{Type: SubjectRole, ID: string(user.Role)},
+		{Type: SubjectUser, ID: strings.ToLower(user.Email)},
+	}
+}
+
+// ResourceType is the kind of resource a permission applies to.
+type ResourceType string
+
+const (
+	ResourceJobs               ResourceType = "jobs"
+	ResourceBridges            ResourceType = "bridges"
+	ResourceKeys               ResourceType = "keys"
+	ResourceChains             ResourceType = "chains"
+	ResourceExternalInitiators ResourceType = "external_initiators"
+	ResourceUsers              ResourceType = "users"
+	ResourceFeedsManagers      ResourceType = "feeds_managers"
+	// ResourceNode covers node-wide operations that have no finer-grained resource, e.g. log
+	// levels, transfers and log replays.
+	ResourceNode ResourceType = "node"
+)
+
+// Resource identifies the object an action is performed on. ID may be empty when the action
+// does not target an existing object (e.g. creation). Tags carry additional attributes that
+// selectors can match on; for jobs this is the job type (e.g. "webhook", "cron").
+//
+// LoadTags, if set, is called to resolve Tags only when the policy cannot be decided without them.
+type Resource struct {
+	Type     ResourceType
+	ID       string
+	Tags     []string
+	LoadTags func(ctx context.Context) ([]string, error)
+}
+
+func (r Resource) String() string {
+	if r.ID == "" {
+		return string(r.Type)
+	}
+	return fmt.Sprintf("%s/%s", r.Type, r.ID)
+}
+
+// Action is an operation performed on a resource.
+type Action string
+
+const (
+	ActionView  Action = "view"
+	ActionRun   Action = "run"
+	ActionEdit  Action = "edit"
+	ActionAdmin Action = "admin"
+)
+
+// ParseAction returns the Action matching s.
+func ParseAction(s string) (Action, error) {
+	switch a := Action(s); a {
+	case ActionView, ActionRun, ActionEdit, ActionAdmin, Wildcard:
+		return a, nil
+	default:
+		return "", fmt.Errorf("invalid action: %q. Allowed actions: '%s', '%s', '%s', '%s', '%s'", s, ActionView, ActionRun, ActionEdit, ActionAdmin, Wildcard)
+	}
+}
+
+// Permission grants Action on the resources matched by ResourceType and ResourceSelector to
+// the subject identified by SubjectType and SubjectID.
+//
+// SubjectID, ResourceType and Action may be Wildcard. ResourceSelector is either Wildcard,
+// an exact resource ID, or "tag:<tag>" to match resources carrying that tag.
+type Permission struct {
+	ID               int64
+	SubjectType      SubjectType  `db:"subject_type"`
+	SubjectID        string       `db:"subject_id"`
+	ResourceType     ResourceType `db:"resource_type"`
+	ResourceSelector string       `db:"resource_selector"`
+	Action           Action
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+// Validate checks that the permission is well-formed.
+func (p Permission) Validate() error {
+	switch p.SubjectType {
+	case SubjectRole:
+		if p.SubjectID != Wildcard {
+			if _, err := sessions.GetUserRole(p.SubjectID); err != nil {
+				return err
+			}
+		}
+	case SubjectUser, SubjectAPIToken, SubjectExternalInitiator:
+	default:
+		return fmt.Errorf("invalid subject type: %q", p.SubjectType)
+	}
+	if p.SubjectID == "" {
+		return fmt.Errorf("subject ID must be set")
+	}
+	switch p.ResourceType {
+	case ResourceJobs, ResourceBridges, ResourceKeys, ResourceChains, ResourceExternalInitiators,
+		ResourceUsers, ResourceFeedsManagers, ResourceNode, Wildcard:
+	default:
+		return fmt.Errorf("invalid resource type: %q", p.ResourceType)
+	}
+	if p.ResourceSelector == "" || p.ResourceSelector == tagPrefix {
+		return fmt.Errorf("resource selector must be set")
+	}
+	_, err := ParseAction(string(p.Action))
+	return err
+}
+
+// Matches returns true if the permission grants action on resource to subject.
+func (p Permission) Matches(subject Subject, resource Resource, action Action) bool {
+	if p.SubjectType != subject.Type {
+		return false
+	}
+	if p.SubjectID != Wildcard && !strings.EqualFold(p.SubjectID, subject.ID) {
+		return false
+	}
+	if p.ResourceType != Wildcard && p.ResourceType != resource.Type {
+		return false
+	}
+	if p.Action != Wildcard && p.Action != action {
+		return false
+	}
+	return p.matchesSelector(resource)
+}
+
+func (p Permission) matchesSelector(resource Resource) bool {
+	if p.ResourceSelector == Wildcard {
+		return true
+	}
+	if tag, ok := strings.CutPrefix(p.ResourceSelector, tagPrefix); ok {
+		for _, t := range resource.Tags {
+			if t == tag {
+				return true
+			}
+		}
+		return false
+	}
+	return resource.ID != "" && p.ResourceSelector == resource.ID
+}
+
+// Policy is a set of permissions.
+type Policy []Permission
+
+// hasTagSelectors returns true if any permission selects resources by tag.
+func (p Policy) hasTagSelectors() bool {
+	for _, perm := range p {
+		if strings.HasPrefix(perm.ResourceSelector, tagPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows returns true if any of the subjects is granted action on resource.
+func (p Policy) Allows(subjects []Subject, resource Resource, action Action) bool {
+	for _, perm := range p {
+		for _, s := range subjects {
+			if perm.Matches(s, resource, action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NotPermittedError is returned when none of the subjects are granted the requested action.
+type NotPermittedError struct {
+	Subjects []Subject
+	Resource Resource
+	Action   Action
+}
+
+func (e NotPermittedError) Error() string {
+	subjects := make([]string, len(e.Subjects))
+	for i, s := range e.Subjects {
+		subjects[i] = s.String()
+	}
+	return fmt.Sprintf("%s is not permitted to %s %s", strings.Join(subjects, ", "), e.Action, e.Resource)
+}
+
+// DefaultPolicy maps the four global user roles, and the implicit 'run' role of external
+// initiators, onto the policy model. It mirrors the rows seeded by the permissions migration.
+func DefaultPolicy() Policy {
+	grant := func(st SubjectType, sid string, rt ResourceType, a Action) Permission {
+		return Permission{SubjectType: st, SubjectID: sid, ResourceType: rt, ResourceSelector: Wildcard, Action: a}
+	}
+	return Policy{
+		grant(SubjectRole, string(sessions.UserRoleAdmin), Wildcard, Wildcard),
+		grant(SubjectRole, string(sessions.UserRoleEdit), Wildcard, ActionView),
+		grant(SubjectRole, string(sessions.UserRoleEdit), Wildcard, ActionRun),
+		grant(SubjectRole, string(sessions.UserRoleEdit), Wildcard, ActionEdit),
+		grant(SubjectRole, string(sessions.UserRoleRun), Wildcard, ActionView),
+		grant(SubjectRole, string(sessions.UserRoleRun), Wildcard, ActionRun),
+		grant(SubjectRole, string(sessions.UserRoleView), Wildcard, ActionView),
+		grant(SubjectExternalInitiator, Wildcard, ResourceJobs, ActionRun),
+		grant(SubjectExternalInitiator, Wildcard, ResourceNode, ActionView),
+	}
+}
@@ -0,0 +1,138 @@
+package permissions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+)
+
+func TestPermission_Matches(t *testing.T) {
+	t.Parallel()
+
+	alice := permissions.Subject{Type: permissions.SubjectUser, ID: "alice@example.com"}
+	webhookJob := permissions.Resource{Type: permissions.ResourceJobs, ID: "7", Tags: []string{"webhook"}}
+	cronJob := permissions.Resource{Type: permissions.ResourceJobs, ID: "8", Tags: []string{"cron"}}
+	newJob := permissions.Resource{Type: permissions.ResourceJobs}
+
+	tests := []struct {
+		name     string
+		perm     permissions.Permission
+		resource permissions.Resource
+		action   permissions.Action
+		want     bool
+	}{
+		{"by id", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "7", permissions.ActionRun), webhookJob, permissions.ActionRun, true},
+		{"by id case insensitive subject", grant(permissions.SubjectUser, "Alice@Example.com", permissions.ResourceJobs, "7", permissions.ActionRun), webhookJob, permissions.ActionRun, true},
+		{"other id", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "7", permissions.ActionRun), cronJob, permissions.ActionRun, false},
+		{"id selector does not match creation", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "7", permissions.ActionEdit), newJob, permissions.ActionEdit, false},
+		{"by tag", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "tag:webhook", permissions.ActionEdit), webhookJob, permissions.ActionEdit, true},
+		{"other tag", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "tag:webhook", permissions.ActionEdit), cronJob, permissions.ActionEdit, false},
+		{"other action", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "*", permissions.ActionRun), cronJob, permissions.ActionEdit, false},
+		{"wildcard action", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceJobs, "*", permissions.Wildcard), cronJob, permissions.ActionAdmin, true},
+		{"other resource type", grant(permissions.SubjectUser, "alice@example.com", permissions.ResourceBridges, "*", permissions.ActionEdit), cronJob, permissions.ActionEdit, false},
+		{"wildcard resource type", grant(permissions.SubjectUser, "alice@example.com", permissions.Wildcard, "*", permissions.ActionEdit), newJob, permissions.ActionEdit, true},
+		{"wildcard subject", grant(permissions.SubjectUser, "*", permissions.ResourceJobs, "*", permissions.ActionView), cronJob, permissions.ActionView, true},
+		{"other subject type", grant(permissions.SubjectAPIToken, "*", permissions.ResourceJobs, "*", permissions.ActionView), cronJob, permissions.ActionView, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.perm.Matches(alice, tc.resource, tc.action))
+		})
+	}
+}
+
+func TestPermission_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, grant(permissions.SubjectRole, "edit", permissions.ResourceJobs, "*", permissions.ActionRun).Validate())
+	require.NoError(t, grant(permissions.SubjectAPIToken, "abc", permissions.Wildcard, "tag:cron", permissions.Wildcard).Validate())
+
+	require.Error(t, grant(permissions.SubjectRole, "superuser", permissions.ResourceJobs, "*", permissions.ActionRun).Validate())
+	require.Error(t, grant("group", "ops", permissions.ResourceJobs, "*", permissions.ActionRun).Validate())
+	require.Error(t, grant(permissions.SubjectUser, "", permissions.ResourceJobs, "*", permissions.ActionRun).Validate())
+	require.Error(t, grant(permissions.SubjectUser, "a@b.c", "cookies", "*", permissions.ActionRun).Validate())
+	require.Error(t, grant(permissions.SubjectUser, "a@b.c", permissions.ResourceJobs, "", permissions.ActionRun).Validate())
+	require.Error(t, grant(permissions.SubjectUser, "a@b.c", permissions.ResourceJobs, "tag:", permissions.ActionRun).Validate())
+	require.Error(t, grant(permissions.SubjectUser, "a@b.c", permissions.ResourceJobs, "*", "delete").Validate())
+}
+
+func TestDefaultPolicy_MatchesRoles(t *testing.T) {
+	t.Parallel()
+
+	policy := permissions.DefaultPolicy()
+	for _, p := range policy {
+		require.NoError(t, p.Validate())
+	}
+
+	job := permissions.Resource{Type: permissions.ResourceJobs, ID: "1"}
+	allowed := map[sessions.UserRole][]permissions.Action{
+		sessions.UserRoleAdmin: {permissions.ActionView, permissions.ActionRun, permissions.ActionEdit, permissions.ActionAdmin},
+		sessions.UserRoleEdit:  {permissions.ActionView, permissions.ActionRun, permissions.ActionEdit},
+		sessions.UserRoleRun:   {permissions.ActionView, permissions.ActionRun},
+		sessions.UserRoleView:  {permissions.ActionView},
+	}
+	for role, actions := range allowed {
+		subjects := permissions.SubjectsForUser(sessions.User{Email: "user@example.com", Role: role})
+		for _, action := range []permissions.Action{permissions.ActionView, permissions.ActionRun, permissions.ActionEdit, permissions.ActionAdmin} {
+			assert.Equal(t, contains(actions, action), policy.Allows(subjects, job, action), "role %s action %s", role, action)
+		}
+	}
+
+	ei := []permissions.Subject{{Type: permissions.SubjectExternalInitiator, ID: "my-ei"}}
+	assert.True(t, policy.Allows(ei, job, permissions.ActionRun))
+	assert.False(t, policy.Allows(ei, job, permissions.ActionEdit))
+	assert.False(t, policy.Allows(ei, permissions.Resource{Type: permissions.ResourceBridges}, permissions.ActionView))
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := permissions.NewInMemoryORM(permissions.DefaultPolicy()...)
+	integration := sessions.User{Email: "integration@example.com", Role: sessions.UserRoleView}
+	subjects := permissions.SubjectsForUser(integration)
+
+	webhookJob := func(loaded *int) permissions.Resource {
+		return permissions.Resource{Type: permissions.ResourceJobs, ID: "3", LoadTags: func(context.Context) ([]string, error) {
+			*loaded++
+			return []string{"webhook"}, nil
+		}}
+	}
+
+	var loaded int
+	err := permissions.Authorize(ctx, orm, subjects, webhookJob(&loaded), permissions.ActionRun)
+	var notPermitted permissions.NotPermittedError
+	require.ErrorAs(t, err, &notPermitted)
+	assert.Equal(t, permissions.ActionRun, notPermitted.Action)
+	assert.Zero(t, loaded, "tags should not be loaded when no permission selects by tag")
+
+	p := grant(permissions.SubjectUser, integration.Email, permissions.ResourceJobs, "tag:webhook", permissions.ActionRun)
+	require.NoError(t, orm.GrantPermission(ctx, &p))
+
+	require.NoError(t, permissions.Authorize(ctx, orm, subjects, webhookJob(&loaded), permissions.ActionRun))
+	assert.Equal(t, 1, loaded)
+	require.Error(t, permissions.Authorize(ctx, orm, subjects, webhookJob(&loaded), permissions.ActionEdit))
+	require.NoError(t, permissions.Authorize(ctx, orm, subjects, webhookJob(&loaded), permissions.ActionView))
+
+	require.NoError(t, orm.RevokePermission(ctx, p.ID))
+	require.Error(t, permissions.Authorize(ctx, orm, subjects, webhookJob(&loaded), permissions.ActionRun))
+}
+
+func grant(st permissions.SubjectType, sid string, rt permissions.ResourceType, selector string, a permissions.Action) permissions.Permission {
+	return permissions.Permission{SubjectType: st, SubjectID: sid, ResourceType: rt, ResourceSelector: selector, Action: a}
+}
+
+func contains(actions []permissions.Action, a permissions.Action) bool {
+	for _, x := range actions {
+		if x == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE permissions (
+    id BIGSERIAL PRIMARY KEY,
+    subject_type TEXT NOT NULL CHECK (subject_type IN ('role', 'user', 'api_token', 'external_initiator')),
+    subject_id TEXT NOT NULL CHECK (subject_id <> ''),
+    resource_type TEXT NOT NULL,
+    resource_selector TEXT NOT NULL CHECK (resource_selector <> ''),
+    action TEXT NOT NULL CHECK (action IN ('view', 'run', 'edit', 'admin', '*')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (subject_type, subject_id, resource_type, resource_selector, action)
+);
+
+CREATE INDEX idx_permissions_subject ON permissions (subject_type, lower(subject_id));
+
+-- Map the four global user roles onto the policy model so that existing users keep the exact
+-- access they had before. Role hierarchy is admin > edit > run > view.
+INSERT INTO permissions (subject_type, subject_id, resource_type, resource_selector, action) VALUES
+    ('role', 'admin', '*', '*', '*'),
+    ('role', 'edit', '*', '*', 'view'),
+    ('role', 'edit', '*', '*', 'run'),
+    ('role', 'edit', '*', '*', 'edit'),
+    ('role', 'run', '*', '*', 'view'),
+    ('role', 'run', '*', '*', 'run'),
+    ('role', 'view', '*', '*', 'view'),
+    -- External initiators have always implicitly acted with the 'run' role on jobs.
+    ('external_initiator', '*', 'jobs', '*', 'run'),
+    ('external_initiator', '*', 'node', '*', 'view');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS permissions;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_id TEXT NOT NULL,
+    data TEXT NOT NULL,
+    prev_hash BYTEA NOT NULL,
+    hash BYTEA NOT NULL CHECK (octet_length(hash) = 32),
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_audit_events_event_id_created_at ON audit_events (event_id, created_at);
+CREATE INDEX idx_audit_events_created_at ON audit_events (created_at);
+
+-- The audit trail is append only. Rows may still be altered by a superuser, which is what the
+-- hash chain is there to detect.
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER audit_events_append_only ON audit_events;
+DROP FUNCTION audit_events_append_only();
+DROP TABLE audit_events;
+-- +goose StatementEnd
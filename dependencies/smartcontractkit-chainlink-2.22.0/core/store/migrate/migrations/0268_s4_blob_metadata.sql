@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Metadata for S4 rows whose payloads are kept in a local content-addressed blob store.
+CREATE TABLE "s4".blob_metadata(
+    id BIGSERIAL PRIMARY KEY,
+    namespace TEXT NOT NULL,
+    address NUMERIC(78,0) NOT NULL,
+    slot_id INT NOT NULL,
+    version NUMERIC NOT NULL,
+    expiration BIGINT NOT NULL,
+    confirmed BOOLEAN NOT NULL,
+    payload_hash BYTEA NOT NULL,
+    payload_size BIGINT NOT NULL,
+    signature BYTEA NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX blob_metadata_namespace_address_slot_id_idx ON "s4".blob_metadata(namespace, address, slot_id);
+CREATE INDEX blob_metadata_namespace_expiration_idx ON "s4".blob_metadata(namespace, expiration);
+CREATE INDEX blob_metadata_namespace_confirmed_idx ON "s4".blob_metadata(namespace, confirmed);
+
+-- +goose Down
+
+DROP TABLE "s4".blob_metadata;
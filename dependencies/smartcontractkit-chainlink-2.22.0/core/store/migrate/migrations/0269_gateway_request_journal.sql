@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Journal of user requests processed by a gateway, used to answer retries with
+-- the original response instead of executing them again.
+CREATE TABLE gateway_request_journal(
+    don_id TEXT NOT NULL,
+    sender TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    response BYTEA,
+    http_status_code INT,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (don_id, sender, message_id)
+);
+
+CREATE INDEX gateway_request_journal_updated_at_idx ON gateway_request_journal(updated_at);
+CREATE INDEX gateway_request_journal_status_idx ON gateway_request_journal(status);
+
+-- +goose Down
+
+DROP TABLE gateway_request_journal;
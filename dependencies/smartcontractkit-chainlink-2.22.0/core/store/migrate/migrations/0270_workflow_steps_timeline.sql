@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Record when each step started and which capability ran it, for the execution history API.
+ALTER TABLE workflow_steps
+    ADD COLUMN capability_id TEXT,
+    ADD COLUMN started_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions(workflow_id, created_at);
+
+-- +goose Down
+
+DROP INDEX idx_workflow_executions_workflow_id_created_at;
+
+ALTER TABLE workflow_steps
+    DROP COLUMN capability_id,
+    DROP COLUMN started_at;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TYPE workflow_status ADD VALUE 'cancelled';
+
+-- +goose Down
+-- +goose StatementBegin
+-- +goose StatementEnd
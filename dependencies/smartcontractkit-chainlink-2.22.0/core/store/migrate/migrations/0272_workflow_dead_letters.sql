@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Workflow executions that failed, along with the trigger event that started
+-- them so that they can be replayed. Rows are kept when the execution itself is
+-- pruned.
+CREATE TABLE workflow_dead_letters (
+    id BIGSERIAL PRIMARY KEY,
+    workflow_id varchar(64) NOT NULL,
+    workflow_execution_id varchar(64) NOT NULL UNIQUE,
+    status workflow_status NOT NULL,
+    error TEXT,
+    trigger_event BYTEA,
+    created_at TIMESTAMPTZ NOT NULL,
+    replayed_at TIMESTAMPTZ,
+    replay_execution_id varchar(64)
+);
+
+CREATE INDEX idx_workflow_dead_letters_workflow_id_created_at ON workflow_dead_letters(workflow_id, created_at);
+
+-- +goose Down
+
+DROP TABLE workflow_dead_letters;
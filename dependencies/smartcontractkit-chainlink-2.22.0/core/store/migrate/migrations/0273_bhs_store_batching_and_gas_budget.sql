@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE blockhash_store_specs
+    ADD COLUMN batch_blockhash_store_address bytea
+    CHECK (octet_length(batch_blockhash_store_address) = 20);
+
+ALTER TABLE blockhash_store_specs ADD COLUMN store_batch_size integer DEFAULT 0 NOT NULL;
+ALTER TABLE blockhash_store_specs ADD COLUMN store_batch_deadline bigint DEFAULT 0 NOT NULL;
+ALTER TABLE blockhash_store_specs ADD COLUMN gas_budget bigint DEFAULT 0 NOT NULL;
+ALTER TABLE blockhash_store_specs ADD COLUMN gas_budget_period bigint DEFAULT 0 NOT NULL;
+
+CREATE TABLE blockhash_store_deferred_blocks (
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    block_number BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    expired BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (job_id, block_number)
+);
+
+-- +goose Down
+DROP TABLE blockhash_store_deferred_blocks;
+ALTER TABLE blockhash_store_specs DROP COLUMN batch_blockhash_store_address;
+ALTER TABLE blockhash_store_specs DROP COLUMN store_batch_size;
+ALTER TABLE blockhash_store_specs DROP COLUMN store_batch_deadline;
+ALTER TABLE blockhash_store_specs DROP COLUMN gas_budget;
+ALTER TABLE blockhash_store_specs DROP COLUMN gas_budget_period;
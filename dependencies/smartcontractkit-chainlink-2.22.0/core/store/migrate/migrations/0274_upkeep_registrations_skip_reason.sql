@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE upkeep_registrations ADD COLUMN last_skip_reason text;
+
+-- +goose Down
+ALTER TABLE upkeep_registrations DROP COLUMN last_skip_reason;
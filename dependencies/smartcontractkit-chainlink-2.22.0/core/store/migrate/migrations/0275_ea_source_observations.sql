@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE ea_source_observations (
+    id BIGSERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    bridge_name TEXT NOT NULL,
+    data_source TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    bridge_task_run_started_at TIMESTAMPTZ NOT NULL,
+    bridge_task_run_ended_at TIMESTAMPTZ NOT NULL,
+    provider_requested_timestamp BIGINT NOT NULL,
+    provider_received_timestamp BIGINT NOT NULL,
+    provider_data_stream_established BIGINT NOT NULL,
+    provider_indicated_time BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_ea_source_observations_job_id_created_at ON ea_source_observations (job_id, created_at);
+
+-- +goose Down
+DROP TABLE ea_source_observations;
@@ -0,0 +1,61 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// AuditEventsController exposes the local audit trail.
+type AuditEventsController struct {
+	App chainlink.Application
+}
+
+// Index lists audit events in chain order.
+// Example:
+// "GET <application>/audit_events?eventID=JOB_CREATED&since=2024-01-01T00:00:00Z"
+//
+// Supported filters are eventID, since and until (RFC3339, until is
+// exclusive) and afterID, which returns only events following the given ID.
+func (aec *AuditEventsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseAuditEventsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	events, count, err := aec.App.AuditORM().FindEvents(c.Request.Context(), filter, offset, size)
+
+	resources := []presenters.AuditEventResource{}
+	for _, e := range events {
+		resources = append(resources, presenters.NewAuditEventResource(e))
+	}
+
+	paginatedResponse(c, "AuditEvents", size, page, resources, count, err)
+}
+
+func parseAuditEventsFilter(c *gin.Context) (filter audit.EventsFilter, err error) {
+	filter.EventID = audit.EventID(c.Query("eventID"))
+	if v := c.Query("since"); v != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, errors.Wrap(err, "invalid since param")
+		}
+	}
+	if v := c.Query("until"); v != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, v); err != nil {
+			return filter, errors.Wrap(err, "invalid until param")
+		}
+	}
+	if v := c.Query("afterID"); v != "" {
+		if filter.AfterID, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return filter, errors.Wrap(err, "invalid afterID param")
+		}
+	}
+	return filter, nil
+}
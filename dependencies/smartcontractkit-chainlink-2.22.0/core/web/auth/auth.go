@@ -107,6 +107,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 	}
 
 	c.Set(SessionUserKey, &user)
+	c.Set(SessionAPITokenKey, token.AccessKey)
 
 	return nil
 }
@@ -225,6 +225,14 @@ var routesRolesMap = [...]routeRules{
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/permissions", false, false, false},
+	{"POST", "/v2/permissions", false, false, false},
+	{"DELETE", "/v2/permissions/MOCK", false, false, false},
+	{"GET", "/v2/audit_events", false, false, false},
+	{"GET", "/v2/debug/profiles", true, true, true},
+	{"GET", "/v2/debug/profiles/MOCK", true, true, true},
+	{"GET", "/v2/debug/profiles/MOCK/diff", true, true, true},
+	{"POST", "/v2/config/reload", false, false, false},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -309,6 +317,15 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"GET", "/v2/jobs/MOCK/sources", true, true, true},
+	{"GET", "/v2/jobs/MOCK/source_health", true, true, true},
+	{"GET", "/v2/workflows/MOCK/executions", true, true, true},
+	{"GET", "/v2/workflow_executions/MOCK", true, true, true},
+	{"POST", "/v2/workflow_executions/MOCK/rerun", false, true, true},
+	{"POST", "/v2/workflow_executions/MOCK/cancel", false, true, true},
+	{"GET", "/v2/workflows/MOCK/dead_letters", true, true, true},
+	{"POST", "/v2/workflow_dead_letters/MOCK/replay", false, true, true},
+	{"GET", "/v2/vrf/subscriptions/at_risk", true, true, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+)
+
+// SessionAPITokenKey is the API token access key in the session map, set when the request
+// was authenticated by token.
+const SessionAPITokenKey = "api_token"
+
+// ResourceFunc resolves the resource targeted by a request.
+type ResourceFunc func(c *gin.Context) (permissions.Resource, error)
+
+// ResourceOfType returns a ResourceFunc for requests that do not target a single resource,
+// e.g. creation.
+func ResourceOfType(typ permissions.ResourceType) ResourceFunc {
+	return func(*gin.Context) (permissions.Resource, error) {
+		return permissions.Resource{Type: typ}, nil
+	}
+}
+
+// ResourceFromParam returns a ResourceFunc identifying the resource by the named path parameter.
+func ResourceFromParam(typ permissions.ResourceType, param string) ResourceFunc {
+	return func(c *gin.Context) (permissions.Resource, error) {
+		return permissions.Resource{Type: typ, ID: c.Param(param)}, nil
+	}
+}
+
+// GetAuthenticatedSubjects returns the policy subjects the request was authenticated as.
+func GetAuthenticatedSubjects(c *gin.Context) ([]permissions.Subject, bool) {
+	if ei, ok := GetAuthenticatedExternalInitiator(c); ok {
+		return []permissions.Subject{{Type: permissions.SubjectExternalInitiator, ID: ei.Name}}, true
+	}
+	user, ok := GetAuthenticatedUser(c)
+	if !ok {
+		return nil, false
+	}
+	subjects := permissions.SubjectsForUser(*user)
+	if accessKey := c.GetString(SessionAPITokenKey); accessKey != "" {
+		subjects = append(subjects, permissions.Subject{Type: permissions.SubjectAPIToken, ID: accessKey})
+	}
+	return subjects, true
+}
+
+// Authorizer enforces the permissions policy on REST routes.
+type Authorizer struct {
+	orm permissions.ORM
+}
+
+func NewAuthorizer(orm permissions.ORM) *Authorizer {
+	return &Authorizer{orm: orm}
+}
+
+// Authorize checks that the request's subjects are granted action on resource.
+func (a *Authorizer) Authorize(ctx context.Context, subjects []permissions.Subject, resource permissions.Resource, action permissions.Action) error {
+	return permissions.Authorize(ctx, a.orm, subjects, resource, action)
+}
+
+// Requires wraps handler, asserting that the authenticated subjects are granted action on the
+// resource resolved by resource.
+//
+// To remain compatible with the role-based checks, denied 'admin' actions are reported as
+// 403 (Forbidden) and all other denials as 401 (Unauthorized).
+func (a *Authorizer) Requires(action permissions.Action, resource ResourceFunc, handler func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		subjects, ok := GetAuthenticatedSubjects(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			return
+		}
+		r, err := resource(c)
+		if err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		err = a.Authorize(c.Request.Context(), subjects, r, action)
+		var notPermitted permissions.NotPermittedError
+		switch {
+		case err == nil:
+			handler(c)
+		case errors.As(err, &notPermitted) && action == permissions.ActionAdmin:
+			c.Abort()
+			var role, email string
+			if user, ok := GetAuthenticatedUser(c); ok {
+				role, email = string(user.Role), user.Email
+			}
+			addForbiddenErrorHeaders(c, string(action), role, email)
+			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+		case errors.As(err, &notPermitted):
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+		default:
+			c.Abort()
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package web
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 
@@ -41,6 +43,30 @@ func (cc *ConfigController) Show(c *gin.Context) {
 	jsonAPIResponse(c, ConfigV2Resource{toml}, "config")
 }
 
+// Reload re-reads the config files and applies the changes which do not
+// require a restart. The response lists the applied fields and the changed
+// fields which only take effect after a restart.
+// Example:
+//
+//	"POST <application>/config/reload"
+func (cc *ConfigController) Reload(c *gin.Context) {
+	result, err := cc.App.ReloadConfig()
+	if errors.Is(err, chainlink.ErrReloadUnsupported) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	cc.App.GetAuditLogger().Audit(audit.ConfigUpdated, map[string]interface{}{
+		"applied":         result.Applied,
+		"restartRequired": result.RestartRequired,
+	})
+
+	jsonAPIResponse(c, NewConfigReloadResource(result), "configReload")
+}
+
 type ConfigV2Resource struct {
 	Config string `json:"config"`
 }
@@ -52,3 +78,27 @@ func (c ConfigV2Resource) GetID() string {
 func (c *ConfigV2Resource) SetID(string) error {
 	return nil
 }
+
+type ConfigReloadResource struct {
+	Applied         []string `json:"applied"`
+	RestartRequired []string `json:"restartRequired"`
+}
+
+func NewConfigReloadResource(result chainlink.ReloadResult) ConfigReloadResource {
+	r := ConfigReloadResource{Applied: result.Applied, RestartRequired: result.RestartRequired}
+	if r.Applied == nil {
+		r.Applied = []string{}
+	}
+	if r.RestartRequired == nil {
+		r.RestartRequired = []string{}
+	}
+	return r
+}
+
+func (c ConfigReloadResource) GetID() string {
+	return utils.NewBytes32ID()
+}
+
+func (c *ConfigReloadResource) SetID(string) error {
+	return nil
+}
@@ -0,0 +1,38 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// JobSourceHealthController exposes the health of the sources of the median
+// tasks of OCR2 median jobs.
+type JobSourceHealthController struct {
+	App chainlink.Application
+}
+
+// Index lists the scores of the sources of a running job tracking the health
+// of its median sources.
+// Example:
+// "GET <application>/jobs/:ID/source_health"
+func (jshc *JobSourceHealthController) Index(c *gin.Context) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	scores, ok := jshc.App.MedianSourceHealth().Scores(jb.ID)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job is not running or does not track the health of its median sources"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewMedianSourceScoreResources(jb.ID, scores), "medianSourceScores")
+}
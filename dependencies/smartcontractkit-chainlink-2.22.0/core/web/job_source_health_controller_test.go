@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/plugins/median/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobSourceHealthController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	jb := job.Job{ID: 42, PipelineSpec: &pipeline.Spec{DotDagSource: `
+	ds1    [type=memo value=1];
+	ds2    [type=memo value=1];
+	answer [type=median];
+
+	ds1 -> answer;
+	ds2 -> answer;
+`}}
+	tracker, err := ocrcommon.NewSourceHealthTracker(logger.TestLogger(t), jb, config.SourceHealth{Window: 2}, nil, app.MedianSourceHealth(), nil)
+	require.NoError(t, err)
+	servicetest.Run(t, tracker)
+
+	output := func(v string) jsonserializable.JSONSerializable {
+		return jsonserializable.JSONSerializable{Val: v, Valid: true}
+	}
+	tracker.ObserveRun(ctx, &pipeline.Run{PipelineTaskRuns: []pipeline.TaskRun{
+		{DotID: "ds1", Output: output("100")},
+		{DotID: "ds2", Output: output("200")},
+		{DotID: "answer", Output: output("100")},
+	}})
+
+	t.Run("lists the scores of the sources", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/source_health", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.MedianSourceScoreResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, "answer", resources[0].Median)
+		assert.Equal(t, "ds1", resources[0].Source)
+		assert.InDelta(t, 1, resources[0].Score, 0)
+		assert.Equal(t, 1, resources[0].Rounds)
+		assert.Equal(t, "ds2", resources[1].Source)
+		assert.InDelta(t, 0, resources[1].Score, 0)
+	})
+
+	t.Run("job not tracking its sources", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/43/source_health")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("invalid job ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/jobs/abc/source_health")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
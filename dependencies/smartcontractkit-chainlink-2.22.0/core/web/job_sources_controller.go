@@ -0,0 +1,66 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// defaultJobSourcesSince is how far back the observations of a job's external
+// adapters are returned when no since parameter is given.
+const defaultJobSourcesSince = time.Hour
+
+// JobSourcesController exposes the observations recorded for the external
+// adapters of jobs collecting enhanced EA telemetry.
+type JobSourcesController struct {
+	App chainlink.Application
+}
+
+// Index lists a page of the observations of a job's external adapters
+// recorded within the since duration, which defaults to an hour.
+// Example:
+// "GET <application>/jobs/:ID/sources?since=1h&size=100&page=1"
+func (jsc *JobSourcesController) Index(c *gin.Context, size, page, offset int) {
+	ctx := c.Request.Context()
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	since := defaultJobSourcesSince
+	if s := c.Query("since"); s != "" {
+		var err error
+		since, err = time.ParseDuration(s)
+		if err != nil || since <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid since duration %q", s))
+			return
+		}
+	}
+
+	if _, err := jsc.App.JobORM().FindJob(ctx, jb.ID); err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	history := ocrcommon.NewEASourceHistory(jsc.App.GetDB())
+	observations, count, err := history.FindObservations(ctx, jb.ID, time.Now().Add(-since), offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "eaSourceObservations", size, page, presenters.NewEASourceObservationResources(observations), count, err)
+}
@@ -0,0 +1,89 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestJobSourcesController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	now := time.Now()
+	require.NoError(t, ocrcommon.NewEASourceHistory(app.GetDB()).InsertObservations(ctx, []ocrcommon.EASourceObservation{
+		{JobID: jb.ID, BridgeName: "bridge", DataSource: "adapter", Value: 1.5, BridgeTaskRunStartedAt: now.Add(-3*time.Hour - time.Second), BridgeTaskRunEndedAt: now.Add(-3 * time.Hour), CreatedAt: now.Add(-3 * time.Hour)},
+		{JobID: jb.ID, BridgeName: "bridge", DataSource: "adapter", Value: 2.5, BridgeTaskRunStartedAt: now.Add(-time.Second), BridgeTaskRunEndedAt: now, CreatedAt: now},
+	}))
+
+	t.Run("defaults to the last hour", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/sources", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.EASourceObservationResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, "bridge", resources[0].BridgeName)
+		assert.Equal(t, "adapter", resources[0].DataSource)
+		assert.InDelta(t, 2.5, resources[0].Value, 0)
+		assert.Equal(t, int64(1000), resources[0].LatencyMs)
+	})
+
+	t.Run("with since", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/sources?since=4h", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.EASourceObservationResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+		assert.Len(t, resources, 2)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/sources?since=4h&size=1&page=2", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		body := cltest.ParseResponseBody(t, resp)
+		metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		assert.Equal(t, 2, metaCount)
+
+		var links jsonapi.Links
+		var resources []presenters.EASourceObservationResource
+		require.NoError(t, web.ParsePaginatedResponse(body, &resources, &links))
+		require.Len(t, resources, 1)
+		assert.InDelta(t, 2.5, resources[0].Value, 0)
+		assert.NotEmpty(t, links["prev"].Href)
+		assert.Empty(t, links["next"].Href)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/sources", jb.ID+1000))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/sources?since=yesterday", jb.ID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
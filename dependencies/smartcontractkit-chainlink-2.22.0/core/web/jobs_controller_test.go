@@ -37,6 +37,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -742,6 +744,63 @@ func TestJobsController_Update_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Update_Permissions(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	user := &cltest.User{Email: "webhooks@chainlink.test", Role: sessions.UserRoleView}
+	client := app.NewHTTPClient(user)
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+
+	put := func(t *testing.T, spec string) *http.Response {
+		body, err := json.Marshal(web.UpdateJobRequest{TOML: spec})
+		require.NoError(t, err)
+		resp, cleanup := client.Put(fmt.Sprintf("/v2/jobs/%d", jb.ID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	grant := func(t *testing.T, selector string) {
+		p := permissions.Permission{
+			SubjectType:      permissions.SubjectUser,
+			SubjectID:        user.Email,
+			ResourceType:     permissions.ResourceJobs,
+			ResourceSelector: selector,
+			Action:           permissions.ActionEdit,
+		}
+		require.NoError(t, app.PermissionsORM().GrantPermission(ctx, &p))
+		t.Cleanup(func() { assert.NoError(t, app.PermissionsORM().RevokePermission(ctx, p.ID)) })
+	}
+	cronSpec := fmt.Sprintf(testspecs.CronSpecTemplate, uuid.New())
+	webhookSpec := testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml()
+
+	for name, selector := range map[string]string{
+		"tag grant": "tag:webhook",
+		"id grant":  strconv.Itoa(int(jb.ID)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			grant(t, selector)
+
+			resp := put(t, cronSpec)
+			cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+			dbJb, err := app.JobORM().FindJob(ctx, jb.ID)
+			require.NoError(t, err)
+			assert.Equal(t, job.Webhook, dbJb.Type)
+
+			resp = put(t, webhookSpec)
+			cltest.AssertServerResponse(t, resp, http.StatusOK)
+			dbJb, err = app.JobORM().FindJob(ctx, jb.ID)
+			require.NoError(t, err)
+			assert.Equal(t, job.Webhook, dbJb.Type)
+		})
+	}
+
+	t.Run("no grant", func(t *testing.T) {
+		resp := put(t, webhookSpec)
+		cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+	})
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
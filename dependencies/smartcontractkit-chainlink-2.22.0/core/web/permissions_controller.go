@@ -0,0 +1,182 @@
+package web
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// PermissionsController manages the fine-grained authorization policy.
+type PermissionsController struct {
+	App chainlink.Application
+}
+
+// CreatePermissionRequest represents a request to grant a permission.
+type CreatePermissionRequest struct {
+	SubjectType      permissions.SubjectType  `json:"subjectType"`
+	SubjectID        string                   `json:"subjectID"`
+	ResourceType     permissions.ResourceType `json:"resourceType"`
+	ResourceSelector string                   `json:"resourceSelector"`
+	Action           permissions.Action       `json:"action"`
+}
+
+// Index lists all granted permissions.
+// Example:
+// "GET <application>/permissions"
+func (pc *PermissionsController) Index(c *gin.Context) {
+	perms, err := pc.App.PermissionsORM().ListPermissions(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.PermissionResource{}
+	for _, p := range perms {
+		resources = append(resources, presenters.NewPermissionResource(p))
+	}
+	jsonAPIResponse(c, resources, "permissions")
+}
+
+// Create grants a new permission.
+// Example:
+// "POST <application>/permissions"
+func (pc *PermissionsController) Create(c *gin.Context) {
+	request := CreatePermissionRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	p := permissions.Permission{
+		SubjectType:      request.SubjectType,
+		SubjectID:        request.SubjectID,
+		ResourceType:     request.ResourceType,
+		ResourceSelector: request.ResourceSelector,
+		Action:           request.Action,
+	}
+	if err := p.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := pc.App.PermissionsORM().GrantPermission(c.Request.Context(), &p); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pc.App.GetAuditLogger().Audit(audit.PermissionGranted, map[string]interface{}{
+		"id":               p.ID,
+		"subjectType":      p.SubjectType,
+		"subjectID":        p.SubjectID,
+		"resourceType":     p.ResourceType,
+		"resourceSelector": p.ResourceSelector,
+		"action":           p.Action,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewPermissionResource(p), "permission", http.StatusCreated)
+}
+
+// Delete revokes a permission.
+// Example:
+// "DELETE <application>/permissions/:ID"
+func (pc *PermissionsController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	err = pc.App.PermissionsORM().RevokePermission(c.Request.Context(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("permission not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pc.App.GetAuditLogger().Audit(audit.PermissionRevoked, map[string]interface{}{"id": id})
+	jsonAPIResponseWithStatus(c, nil, "permission", http.StatusNoContent)
+}
+
+// jobResource resolves the job addressed by the :ID path parameter, which may be either a job
+// ID or an external job ID. The job type is attached as a tag so that policies can select jobs
+// by type. Unknown jobs resolve to a resource without tags, leaving the handler to respond
+// with 404.
+func jobResource(app chainlink.Application) auth.ResourceFunc {
+	return func(c *gin.Context) (permissions.Resource, error) {
+		ctx := c.Request.Context()
+		idStr := c.Param("ID")
+		resource := permissions.Resource{Type: permissions.ResourceJobs, ID: idStr}
+
+		var jb job.Job
+		var err error
+		if externalJobID, pErr := uuid.Parse(idStr); pErr == nil {
+			jb, err = app.JobORM().FindJobByExternalJobID(ctx, externalJobID)
+		} else if pErr = jb.SetID(idStr); pErr == nil {
+			jb, err = app.JobORM().FindJob(ctx, jb.ID)
+		} else {
+			return resource, nil
+		}
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			return resource, nil
+		} else if err != nil {
+			return resource, err
+		}
+		resource.ID = strconv.Itoa(int(jb.ID))
+		resource.Tags = []string{jb.Type.String()}
+		return resource, nil
+	}
+}
+
+// jobSpecResource resolves the type of the job submitted in the request body, so that policies
+// can restrict creation to certain job types. The body is restored for the handler.
+func jobSpecResource(c *gin.Context) (permissions.Resource, error) {
+	resource := permissions.Resource{Type: permissions.ResourceJobs}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return resource, err
+	}
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+	request := CreateJobRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return resource, nil
+	}
+	if jobType, err := job.ValidateSpec(request.TOML); err == nil {
+		resource.Tags = []string{jobType.String()}
+	}
+	return resource, nil
+}
+
+// jobSpecUpdateResource resolves the job submitted to replace the job addressed by the :ID path
+// parameter. The ID of the replaced job is only kept when the job type is unchanged, so that a
+// subject granted edit on a job cannot replace it with a job of another type.
+func jobSpecUpdateResource(app chainlink.Application) auth.ResourceFunc {
+	jobByID := jobResource(app)
+	return func(c *gin.Context) (permissions.Resource, error) {
+		replaced, err := jobByID(c)
+		if err != nil {
+			return replaced, err
+		}
+		resource, err := jobSpecResource(c)
+		if err != nil {
+			return resource, err
+		}
+		if len(replaced.Tags) > 0 && slices.Equal(replaced.Tags, resource.Tags) {
+			resource.ID = replaced.ID
+		}
+		return resource, nil
+	}
+}
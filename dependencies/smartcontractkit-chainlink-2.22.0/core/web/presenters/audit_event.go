@@ -0,0 +1,35 @@
+package presenters
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// AuditEventResource represents a single entry of the local audit trail.
+type AuditEventResource struct {
+	JAID
+	EventID   audit.EventID `json:"eventID"`
+	Data      string        `json:"data"`
+	PrevHash  string        `json:"prevHash"`
+	Hash      string        `json:"hash"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// NewAuditEventResource constructs a new AuditEventResource.
+func NewAuditEventResource(e audit.Event) AuditEventResource {
+	return AuditEventResource{
+		JAID:      NewJAIDInt64(e.ID),
+		EventID:   e.EventID,
+		Data:      e.Data,
+		PrevHash:  hex.EncodeToString(e.PrevHash),
+		Hash:      hex.EncodeToString(e.Hash),
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (AuditEventResource) GetName() string {
+	return "auditEvents"
+}
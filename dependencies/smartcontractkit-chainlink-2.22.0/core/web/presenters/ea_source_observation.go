@@ -0,0 +1,59 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// EASourceObservationResource represents a value returned by an external
+// adapter to a job. Provider timestamps are in unix milliseconds.
+type EASourceObservationResource struct {
+	JAID
+	JobID                         int32     `json:"jobID"`
+	BridgeName                    string    `json:"bridgeName"`
+	DataSource                    string    `json:"dataSource"`
+	Value                         float64   `json:"value"`
+	LatencyMs                     int64     `json:"latencyMs"`
+	BridgeTaskRunStartedAt        time.Time `json:"bridgeTaskRunStartedAt"`
+	BridgeTaskRunEndedAt          time.Time `json:"bridgeTaskRunEndedAt"`
+	ProviderRequestedTimestamp    int64     `json:"providerRequestedTimestamp"`
+	ProviderReceivedTimestamp     int64     `json:"providerReceivedTimestamp"`
+	ProviderDataStreamEstablished int64     `json:"providerDataStreamEstablished"`
+	ProviderIndicatedTime         int64     `json:"providerIndicatedTime"`
+	CreatedAt                     time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (EASourceObservationResource) GetName() string {
+	return "eaSourceObservations"
+}
+
+// NewEASourceObservationResource constructs a new EASourceObservationResource.
+func NewEASourceObservationResource(o ocrcommon.EASourceObservation) EASourceObservationResource {
+	return EASourceObservationResource{
+		JAID:                          NewJAID(strconv.FormatInt(o.ID, 10)),
+		JobID:                         o.JobID,
+		BridgeName:                    o.BridgeName,
+		DataSource:                    o.DataSource,
+		Value:                         o.Value,
+		LatencyMs:                     o.Latency().Milliseconds(),
+		BridgeTaskRunStartedAt:        o.BridgeTaskRunStartedAt,
+		BridgeTaskRunEndedAt:          o.BridgeTaskRunEndedAt,
+		ProviderRequestedTimestamp:    o.ProviderRequestedTimestamp,
+		ProviderReceivedTimestamp:     o.ProviderReceivedTimestamp,
+		ProviderDataStreamEstablished: o.ProviderDataStreamEstablished,
+		ProviderIndicatedTime:         o.ProviderIndicatedTime,
+		CreatedAt:                     o.CreatedAt,
+	}
+}
+
+// NewEASourceObservationResources initializes a slice of JSONAPI EA source observation resources
+func NewEASourceObservationResources(observations []ocrcommon.EASourceObservation) []EASourceObservationResource {
+	rs := []EASourceObservationResource{}
+	for _, o := range observations {
+		rs = append(rs, NewEASourceObservationResource(o))
+	}
+	return rs
+}
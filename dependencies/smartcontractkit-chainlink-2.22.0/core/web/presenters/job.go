@@ -329,6 +329,11 @@ type BlockhashStoreSpec struct {
 	BlockhashStoreAddress          types.EIP55Address   `json:"blockhashStoreAddress"`
 	TrustedBlockhashStoreAddress   *types.EIP55Address  `json:"trustedBlockhashStoreAddress"`
 	TrustedBlockhashStoreBatchSize int32                `json:"trustedBlockhashStoreBatchSize"`
+	BatchBlockhashStoreAddress     *types.EIP55Address  `json:"batchBlockhashStoreAddress"`
+	StoreBatchSize                 int32                `json:"storeBatchSize"`
+	StoreBatchDeadline             time.Duration        `json:"storeBatchDeadline"`
+	GasBudget                      uint64               `json:"gasBudget"`
+	GasBudgetPeriod                time.Duration        `json:"gasBudgetPeriod"`
 	PollPeriod                     time.Duration        `json:"pollPeriod"`
 	RunTimeout                     time.Duration        `json:"runTimeout"`
 	EVMChainID                     *big.Big             `json:"evmChainID"`
@@ -349,6 +354,11 @@ func NewBlockhashStoreSpec(spec *job.BlockhashStoreSpec) *BlockhashStoreSpec {
 		BlockhashStoreAddress:          spec.BlockhashStoreAddress,
 		TrustedBlockhashStoreAddress:   spec.TrustedBlockhashStoreAddress,
 		TrustedBlockhashStoreBatchSize: spec.TrustedBlockhashStoreBatchSize,
+		BatchBlockhashStoreAddress:     spec.BatchBlockhashStoreAddress,
+		StoreBatchSize:                 spec.StoreBatchSize,
+		StoreBatchDeadline:             spec.StoreBatchDeadline,
+		GasBudget:                      spec.GasBudget,
+		GasBudgetPeriod:                spec.GasBudgetPeriod,
 		PollPeriod:                     spec.PollPeriod,
 		RunTimeout:                     spec.RunTimeout,
 		EVMChainID:                     spec.EVMChainID,
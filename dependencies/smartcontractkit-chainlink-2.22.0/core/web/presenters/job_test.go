@@ -641,6 +641,11 @@ func TestJob(t *testing.T) {
 							"blockhashStoreAddress": "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
 							"trustedBlockhashStoreAddress": "0x0ad9FE7a58216242a8475ca92F222b0640E26B63",
 							"trustedBlockhashStoreBatchSize": 20,
+							"batchBlockhashStoreAddress": null,
+							"storeBatchSize": 0,
+							"storeBatchDeadline": 0,
+							"gasBudget": 0,
+							"gasBudgetPeriod": 0,
 							"pollPeriod": 25000000000,
 							"runTimeout": 10000000000,
 							"evmChainID": "4",
@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/ocrcommon"
+)
+
+// MedianSourceScoreResource represents the health of a source of a median task
+// of an OCR2 median job.
+type MedianSourceScoreResource struct {
+	JAID
+	JobID     int32   `json:"jobID"`
+	Median    string  `json:"median"`
+	Source    string  `json:"source"`
+	Score     float64 `json:"score"`
+	Rounds    int     `json:"rounds"`
+	Unhealthy bool    `json:"unhealthy"`
+	Excluded  bool    `json:"excluded"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (MedianSourceScoreResource) GetName() string {
+	return "medianSourceScores"
+}
+
+// NewMedianSourceScoreResource constructs a new MedianSourceScoreResource.
+func NewMedianSourceScoreResource(jobID int32, s ocrcommon.SourceScore) MedianSourceScoreResource {
+	return MedianSourceScoreResource{
+		JAID:      NewJAID(fmt.Sprintf("%d-%s-%s", jobID, s.Median, s.Source)),
+		JobID:     jobID,
+		Median:    s.Median,
+		Source:    s.Source,
+		Score:     s.Score,
+		Rounds:    s.Rounds,
+		Unhealthy: s.Unhealthy,
+		Excluded:  s.Excluded,
+	}
+}
+
+// NewMedianSourceScoreResources constructs a slice of MedianSourceScoreResource.
+func NewMedianSourceScoreResources(jobID int32, scores []ocrcommon.SourceScore) []MedianSourceScoreResource {
+	rs := make([]MedianSourceScoreResource, len(scores))
+	for i, s := range scores {
+		rs[i] = NewMedianSourceScoreResource(jobID, s)
+	}
+	return rs
+}
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+)
+
+// PermissionResource represents a single grant of the authorization policy.
+type PermissionResource struct {
+	JAID
+	SubjectType      permissions.SubjectType  `json:"subjectType"`
+	SubjectID        string                   `json:"subjectID"`
+	ResourceType     permissions.ResourceType `json:"resourceType"`
+	ResourceSelector string                   `json:"resourceSelector"`
+	Action           permissions.Action       `json:"action"`
+	CreatedAt        time.Time                `json:"createdAt"`
+}
+
+// NewPermissionResource constructs a new PermissionResource.
+func NewPermissionResource(p permissions.Permission) PermissionResource {
+	return PermissionResource{
+		JAID:             NewJAIDInt64(p.ID),
+		SubjectType:      p.SubjectType,
+		SubjectID:        p.SubjectID,
+		ResourceType:     p.ResourceType,
+		ResourceSelector: p.ResourceSelector,
+		Action:           p.Action,
+		CreatedAt:        p.CreatedAt,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (PermissionResource) GetName() string {
+	return "permissions"
+}
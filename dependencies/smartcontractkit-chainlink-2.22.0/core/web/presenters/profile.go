@@ -0,0 +1,32 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+)
+
+// ProfileResource represents a profile collected by the Nurse.
+type ProfileResource struct {
+	JAID
+	Type       string    `json:"type"`
+	Size       int64     `json:"size"`
+	Continuous bool      `json:"continuous"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// NewProfileResource constructs a new ProfileResource.
+func NewProfileResource(p services.ProfileFile) ProfileResource {
+	return ProfileResource{
+		JAID:       NewJAID(p.Name),
+		Type:       p.Type,
+		Size:       p.Size,
+		Continuous: p.Continuous,
+		CreatedAt:  p.CreatedAt,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (ProfileResource) GetName() string {
+	return "profiles"
+}
@@ -0,0 +1,70 @@
+package presenters
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+)
+
+// VRFSubscriptionResource represents the balance of a subscription served by
+// a VRF job, along with the forecast cost of its pending requests. Amounts are
+// in juels for LINK and in wei for native.
+type VRFSubscriptionResource struct {
+	JAID
+	JobID              int32     `json:"jobID"`
+	EVMChainID         string    `json:"evmChainID"`
+	CoordinatorAddress string    `json:"coordinatorAddress"`
+	VRFVersion         string    `json:"vrfVersion"`
+	SubID              string    `json:"subID"`
+	Active             bool      `json:"active"`
+	Balance            string    `json:"balance"`
+	NativeBalance      string    `json:"nativeBalance,omitempty"`
+	ForecastCost       string    `json:"forecastCost,omitempty"`
+	ForecastNativeCost string    `json:"forecastNativeCost,omitempty"`
+	PendingRequests    int       `json:"pendingRequests"`
+	DeferredRequestIDs []string  `json:"deferredRequestIDs"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (VRFSubscriptionResource) GetName() string {
+	return "vrfSubscriptions"
+}
+
+// NewVRFSubscriptionResource constructs a new VRFSubscriptionResource.
+func NewVRFSubscriptionResource(s vrfcommon.SubscriptionStatus) VRFSubscriptionResource {
+	return VRFSubscriptionResource{
+		JAID:               NewJAID(fmt.Sprintf("%d-%s", s.JobID, s.SubID)),
+		JobID:              s.JobID,
+		EVMChainID:         s.ChainID,
+		CoordinatorAddress: s.Coordinator.Hex(),
+		VRFVersion:         string(s.Version),
+		SubID:              s.SubID.String(),
+		Active:             s.Active,
+		Balance:            bigString(s.Balance),
+		NativeBalance:      bigString(s.NativeBalance),
+		ForecastCost:       bigString(s.ForecastCost),
+		ForecastNativeCost: bigString(s.ForecastNativeCost),
+		PendingRequests:    s.PendingRequests,
+		DeferredRequestIDs: s.DeferredRequestIDs,
+		UpdatedAt:          s.UpdatedAt,
+	}
+}
+
+// NewVRFSubscriptionResources initializes a slice of JSONAPI VRF subscription resources
+func NewVRFSubscriptionResources(statuses []vrfcommon.SubscriptionStatus) []VRFSubscriptionResource {
+	rs := []VRFSubscriptionResource{}
+	for _, s := range statuses {
+		rs = append(rs, NewVRFSubscriptionResource(s))
+	}
+	return rs
+}
+
+func bigString(i *big.Int) string {
+	if i == nil {
+		return ""
+	}
+	return i.String()
+}
@@ -0,0 +1,130 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution, and when shown
+// individually, the timeline of its steps.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                 `json:"workflowID"`
+	Status     string                 `json:"status"`
+	CreatedAt  *time.Time             `json:"createdAt"`
+	UpdatedAt  *time.Time             `json:"updatedAt"`
+	FinishedAt *time.Time             `json:"finishedAt"`
+	Steps      []WorkflowStepResource `json:"steps,omitempty"`
+}
+
+// WorkflowStepResource represents a single step of a workflow execution.
+type WorkflowStepResource struct {
+	Ref          string     `json:"ref"`
+	Status       string     `json:"status"`
+	CapabilityID string     `json:"capabilityID,omitempty"`
+	Error        string     `json:"error,omitempty"`
+	StartedAt    *time.Time `json:"startedAt"`
+	FinishedAt   *time.Time `json:"finishedAt"`
+	DurationMs   *int64     `json:"durationMs"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (WorkflowExecutionResource) GetName() string {
+	return "workflowExecutions"
+}
+
+// NewWorkflowExecutionResource constructs a new WorkflowExecutionResource,
+// with its steps ordered by start time.
+func NewWorkflowExecutionResource(we store.WorkflowExecution) WorkflowExecutionResource {
+	r := WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+	}
+	for _, s := range we.Steps {
+		step := WorkflowStepResource{
+			Ref:          s.Ref,
+			Status:       s.Status,
+			CapabilityID: s.CapabilityID,
+			StartedAt:    s.StartedAt,
+		}
+		if s.Outputs.Err != nil {
+			step.Error = s.Outputs.Err.Error()
+		}
+		// the trigger step has no execution time
+		if s.StartedAt != nil && s.UpdatedAt != nil {
+			step.FinishedAt = s.UpdatedAt
+			duration := s.UpdatedAt.Sub(*s.StartedAt).Milliseconds()
+			step.DurationMs = &duration
+		}
+		r.Steps = append(r.Steps, step)
+	}
+	sort.Slice(r.Steps, func(i, j int) bool {
+		a, b := r.Steps[i].StartedAt, r.Steps[j].StartedAt
+		if a == nil || b == nil || a.Equal(*b) {
+			if (a == nil) != (b == nil) {
+				return a == nil
+			}
+			return r.Steps[i].Ref < r.Steps[j].Ref
+		}
+		return a.Before(*b)
+	})
+	return r
+}
+
+// NewWorkflowExecutionResources constructs a list of WorkflowExecutionResource.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, we := range wes {
+		rs = append(rs, NewWorkflowExecutionResource(we))
+	}
+	return rs
+}
+
+// WorkflowDeadLetterResource represents a failed workflow execution that can
+// be replayed.
+type WorkflowDeadLetterResource struct {
+	JAID
+	WorkflowID        string     `json:"workflowID"`
+	ExecutionID       string     `json:"executionID"`
+	Status            string     `json:"status"`
+	Error             string     `json:"error,omitempty"`
+	HasTriggerEvent   bool       `json:"hasTriggerEvent"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	ReplayedAt        *time.Time `json:"replayedAt"`
+	ReplayExecutionID string     `json:"replayExecutionID,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (WorkflowDeadLetterResource) GetName() string {
+	return "workflowDeadLetters"
+}
+
+// NewWorkflowDeadLetterResource constructs a new WorkflowDeadLetterResource.
+func NewWorkflowDeadLetterResource(dl store.DeadLetter) WorkflowDeadLetterResource {
+	return WorkflowDeadLetterResource{
+		JAID:              NewJAIDInt64(dl.ID),
+		WorkflowID:        dl.WorkflowID,
+		ExecutionID:       dl.ExecutionID,
+		Status:            dl.Status,
+		Error:             dl.Error,
+		HasTriggerEvent:   dl.TriggerEvent != nil,
+		CreatedAt:         dl.CreatedAt,
+		ReplayedAt:        dl.ReplayedAt,
+		ReplayExecutionID: dl.ReplayExecutionID,
+	}
+}
+
+// NewWorkflowDeadLetterResources constructs a list of WorkflowDeadLetterResource.
+func NewWorkflowDeadLetterResources(dls []store.DeadLetter) []WorkflowDeadLetterResource {
+	rs := []WorkflowDeadLetterResource{}
+	for _, dl := range dls {
+		rs = append(rs, NewWorkflowDeadLetterResource(dl))
+	}
+	return rs
+}
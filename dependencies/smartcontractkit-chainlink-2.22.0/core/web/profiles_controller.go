@@ -0,0 +1,80 @@
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ProfilesController exposes the profiles collected by the Nurse.
+type ProfilesController struct {
+	App chainlink.Application
+}
+
+// Index lists the collected profiles, newest first.
+// Example:
+// "GET <application>/debug/profiles"
+func (pc *ProfilesController) Index(c *gin.Context) {
+	profiles, err := services.ListProfileFiles(pc.App.GetConfig().AutoPprof().ProfileRoot())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := []presenters.ProfileResource{}
+	for _, p := range profiles {
+		resources = append(resources, presenters.NewProfileResource(p))
+	}
+
+	jsonAPIResponse(c, resources, "profiles")
+}
+
+// Show downloads a single profile.
+// Example:
+// "GET <application>/debug/profiles/1700000000000000.heap.pprof"
+func (pc *ProfilesController) Show(c *gin.Context) {
+	name := c.Param("name")
+	path, err := services.ProfileFilePath(pc.App.GetConfig().AutoPprof().ProfileRoot(), name)
+	if errors.Is(err, services.ErrProfileNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	c.FileAttachment(path, name)
+}
+
+// Diff downloads the difference between two profiles of the same type, in
+// pprof format, suitable for `go tool pprof`.
+// Example:
+// "GET <application>/debug/profiles/1700000600000000.heap.pprof/diff?base=1700000000000000.heap.pprof"
+func (pc *ProfilesController) Diff(c *gin.Context) {
+	name := c.Param("name")
+	base := c.Query("base")
+	if base == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("base param is required"))
+		return
+	}
+
+	var buf bytes.Buffer
+	err := services.DiffProfiles(&buf, pc.App.GetConfig().AutoPprof().ProfileRoot(), base, name)
+	if errors.Is(err, services.ErrProfileNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "diff."+name))
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+}
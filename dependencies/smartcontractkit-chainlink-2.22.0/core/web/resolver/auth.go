@@ -2,9 +2,13 @@ package resolver
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 
-	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
@@ -16,42 +20,49 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts they are granted action on resource
+// by the permissions policy.
+func authenticateUserCan(ctx context.Context, orm permissions.ORM, resource permissions.Resource, action permissions.Action) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
+	return permissions.Authorize(ctx, orm, permissions.SubjectsForUser(*session.User), resource, action)
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
-	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
+// jobResource identifies an existing job, loading its type as a tag only if the policy needs it.
+func (r *Resolver) jobResource(id string) permissions.Resource {
+	return permissions.Resource{
+		Type: permissions.ResourceJobs,
+		ID:   id,
+		LoadTags: func(ctx context.Context) ([]string, error) {
+			jobID, err := stringutils.ToInt32(id)
+			if err != nil {
+				return []string{}, nil
+			}
+			jb, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, jobID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return []string{}, nil
+			} else if err != nil {
+				return nil, err
+			}
+			return []string{jb.Type.String()}, nil
+		},
 	}
-	return nil
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
-	session, ok := auth.GetGQLAuthenticatedSession(ctx)
-	if !ok {
-		return unauthorizedError{}
+// jobSpecResource identifies a job to be created, tagged by the type of its TOML spec.
+func jobSpecResource(toml string) permissions.Resource {
+	return permissions.Resource{
+		Type: permissions.ResourceJobs,
+		LoadTags: func(context.Context) ([]string, error) {
+			jobType, err := job.ValidateSpec(toml)
+			if err != nil {
+				return []string{}, nil
+			}
+			return []string{jobType.String()}, nil
+		},
 	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
-	}
-	return nil
 }
 
 type unauthorizedError struct{}
@@ -65,11 +76,3 @@ func (e unauthorizedError) Extensions() map[string]interface{} {
 		"code": "UNAUTHORIZED",
 	}
 }
-
-type RoleNotPermittedErr struct {
-	Role sessions.UserRole
-}
-
-func (e RoleNotPermittedErr) Error() string {
-	return fmt.Sprintf("Not permitted with current role: %s", e.Role)
-}
@@ -43,6 +43,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -63,7 +64,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceBridges}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +114,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +138,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys, ID: string(args.ID)}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -180,7 +181,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -265,7 +266,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -321,7 +322,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +406,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +459,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceBridges, ID: string(args.ID)}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -526,7 +527,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers, ID: string(args.ID)}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -574,7 +575,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers, ID: string(args.ID)}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +597,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers, ID: string(args.ID)}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -615,7 +616,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -635,7 +636,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys, ID: args.ID}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -654,7 +655,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceBridges, ID: string(args.ID)}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -690,7 +691,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -714,7 +715,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys, ID: string(args.ID)}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +741,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +763,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys, ID: string(args.ID)}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -787,7 +788,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -826,7 +827,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -861,7 +862,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -897,7 +898,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceFeedsManagers}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -971,7 +972,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceNode}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -1062,7 +1063,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), jobSpecResource(args.Input.TOML), permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1140,7 +1141,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), r.jobResource(string(args.ID)), permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1174,7 +1175,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceJobs}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1208,7 +1209,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), r.jobResource(string(args.ID)), permissions.ActionRun); err != nil {
 		return nil, err
 	}
 
@@ -1238,7 +1239,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceNode}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -1264,7 +1265,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys}, permissions.ActionEdit); err != nil {
 		return nil, err
 	}
 
@@ -1292,7 +1293,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceKeys, ID: string(args.ID)}, permissions.ActionAdmin); err != nil {
 		return nil, err
 	}
 
@@ -1310,3 +1311,64 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	r.App.GetAuditLogger().Audit(audit.OCR2KeyBundleDeleted, map[string]interface{}{"id": id})
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// RerunWorkflowExecution re-executes a failed workflow execution from the given
+// step. The step and all the steps depending on it are executed again.
+func (r *Resolver) RerunWorkflowExecution(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		StepRef string
+	}
+}) (*RerunWorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceJobs}, permissions.ActionRun); err != nil {
+		return nil, err
+	}
+
+	if args.Input.StepRef == "" {
+		return NewRerunWorkflowExecutionPayload(nil, errors.New("stepRef is required")), nil
+	}
+
+	executionID := string(args.ID)
+	if err := r.App.WorkflowExecutions().Rerun(ctx, executionID, args.Input.StepRef); err != nil {
+		return NewRerunWorkflowExecutionPayload(nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowExecutionRerun, map[string]interface{}{
+		"executionID": executionID,
+		"stepRef":     args.Input.StepRef,
+	})
+
+	execution, err := r.App.WorkflowExecutions().Get(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRerunWorkflowExecutionPayload(&execution, nil), nil
+}
+
+// CancelWorkflowExecution stops a running workflow execution. The steps that
+// are in flight are cancelled and the execution finishes with the cancelled
+// status.
+func (r *Resolver) CancelWorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*CancelWorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUserCan(ctx, r.App.PermissionsORM(), permissions.Resource{Type: permissions.ResourceJobs}, permissions.ActionRun); err != nil {
+		return nil, err
+	}
+
+	executionID := string(args.ID)
+	if err := r.App.WorkflowExecutions().Cancel(ctx, executionID); err != nil {
+		return NewCancelWorkflowExecutionPayload(nil, err), nil
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowExecutionCancelled, map[string]interface{}{
+		"executionID": executionID,
+	})
+
+	execution, err := r.App.WorkflowExecutions().Get(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCancelWorkflowExecutionPayload(&execution, nil), nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
@@ -661,3 +662,59 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution along with the timeline of
+// its steps.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct{ ID graphql.ID }) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowExecutions().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
+// WorkflowExecutions retrieves a paginated list of the executions of a
+// workflow, most recent first. until is exclusive.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	WorkflowID string
+	Status     *WorkflowExecutionStatus
+	Since      *graphql.Time
+	Until      *graphql.Time
+	Offset     *int32
+	Limit      *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	filter := store.ListFilter{
+		WorkflowID: args.WorkflowID,
+		Offset:     pageOffset(args.Offset),
+		Limit:      pageLimit(args.Limit),
+	}
+	if args.Status != nil {
+		filter.Status = FromWorkflowExecutionStatus(*args.Status)
+	}
+	if args.Since != nil {
+		filter.CreatedAfter = &args.Since.Time
+	}
+	if args.Until != nil {
+		filter.CreatedBefore = &args.Until.Time
+	}
+
+	executions, count, err := r.App.WorkflowExecutions().List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
@@ -29,6 +29,7 @@ import (
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/schema"
@@ -127,6 +128,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 	lggr := logger.TestLogger(t)
 	app.Mock.On("GetAuditLogger", mock.Anything, mock.Anything).Return(audit.NoopLogger).Maybe()
 	app.Mock.On("GetLogger").Return(lggr).Maybe()
+	app.Mock.On("PermissionsORM").Return(permissions.NewInMemoryORM(permissions.DefaultPolicy()...)).Maybe()
 
 	f := &gqlTestFramework{
 		t:          t,
@@ -808,6 +808,35 @@ func (b *BlockhashStoreSpecResolver) TrustedBlockhashStoreBatchSize() int32 {
 	return b.spec.TrustedBlockhashStoreBatchSize
 }
 
+// BatchBlockhashStoreAddress returns the address of the job's BatchBlockhashStoreAddress, if any.
+func (b *BlockhashStoreSpecResolver) BatchBlockhashStoreAddress() *string {
+	if b.spec.BatchBlockhashStoreAddress == nil {
+		return nil
+	}
+	addr := b.spec.BatchBlockhashStoreAddress.String()
+	return &addr
+}
+
+// StoreBatchSize returns the job's StoreBatchSize param.
+func (b *BlockhashStoreSpecResolver) StoreBatchSize() int32 {
+	return b.spec.StoreBatchSize
+}
+
+// StoreBatchDeadline returns the job's StoreBatchDeadline param.
+func (b *BlockhashStoreSpecResolver) StoreBatchDeadline() string {
+	return b.spec.StoreBatchDeadline.String()
+}
+
+// GasBudget returns the job's GasBudget param.
+func (b *BlockhashStoreSpecResolver) GasBudget() string {
+	return strconv.FormatUint(b.spec.GasBudget, 10)
+}
+
+// GasBudgetPeriod returns the job's GasBudgetPeriod param.
+func (b *BlockhashStoreSpecResolver) GasBudgetPeriod() string {
+	return b.spec.GasBudgetPeriod.String()
+}
+
 // PollPeriod return's the job's PollPeriod param.
 func (b *BlockhashStoreSpecResolver) PollPeriod() string {
 	return b.spec.PollPeriod.String()
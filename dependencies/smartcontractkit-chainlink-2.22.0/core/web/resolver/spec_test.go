@@ -781,6 +781,10 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 						BlockhashStoreAddress:          blockhashStoreAddress,
 						TrustedBlockhashStoreAddress:   &trustedBlockhashStoreAddress,
 						TrustedBlockhashStoreBatchSize: trustedBlockhashStoreBatchSize,
+						StoreBatchSize:                 10,
+						StoreBatchDeadline:             time.Minute,
+						GasBudget:                      5_000_000,
+						GasBudgetPeriod:                time.Hour,
 					},
 				}, nil)
 			},
@@ -804,6 +808,11 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 									blockhashStoreAddress
 									trustedBlockhashStoreAddress
 									trustedBlockhashStoreBatchSize
+									batchBlockhashStoreAddress
+									storeBatchSize
+									storeBatchDeadline
+									gasBudget
+									gasBudgetPeriod
 									heartbeatPeriod
 								}
 							}
@@ -829,6 +838,11 @@ func TestResolver_BlockhashStoreSpec(t *testing.T) {
 							"blockhashStoreAddress": "0xb26A6829D454336818477B946f03Fb21c9706f3A",
 							"trustedBlockhashStoreAddress": "0x0ad9FE7a58216242a8475ca92F222b0640E26B63",
 							"trustedBlockhashStoreBatchSize": 20,
+							"batchBlockhashStoreAddress": null,
+							"storeBatchSize": 10,
+							"storeBatchDeadline": "1m0s",
+							"gasBudget": "5000000",
+							"gasBudgetPeriod": "1h0m0s",
 							"heartbeatPeriod": "7m30s"
 						}
 					}
@@ -0,0 +1,307 @@
+package resolver
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type WorkflowExecutionStatus string
+
+const WorkflowExecutionStatusUnknown WorkflowExecutionStatus = "UNKNOWN"
+
+// ToWorkflowExecutionStatus converts a status of the workflow store into its
+// enum value.
+func ToWorkflowExecutionStatus(status string) WorkflowExecutionStatus {
+	if !store.ValidStatuses[status] {
+		return WorkflowExecutionStatusUnknown
+	}
+	return WorkflowExecutionStatus(strings.ToUpper(status))
+}
+
+// FromWorkflowExecutionStatus converts the enum value of a status into the
+// status of the workflow store.
+func FromWorkflowExecutionStatus(status WorkflowExecutionStatus) string {
+	return strings.ToLower(string(status))
+}
+
+func isWorkflowExecutionNotFoundError(err error) bool {
+	return errors.Is(err, store.ErrNotFound)
+}
+
+// WorkflowExecutionResolver resolves a workflow execution.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+
+	for _, execution := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(execution))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.execution.Status)
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	if r.execution.CreatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.CreatedAt}
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	if r.execution.UpdatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.UpdatedAt}
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	if r.execution.FinishedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.execution.FinishedAt}
+}
+
+// Steps resolves the timeline of the steps of the execution, ordered by start
+// time. Executions fetched as part of a page have no steps.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowStepResolver {
+	steps := []*WorkflowStepResolver{}
+	for _, step := range r.execution.Steps {
+		steps = append(steps, &WorkflowStepResolver{step: *step})
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		a, b := steps[i].step.StartedAt, steps[j].step.StartedAt
+		if a == nil || b == nil || a.Equal(*b) {
+			// the trigger step has no start time and comes first
+			if (a == nil) != (b == nil) {
+				return a == nil
+			}
+			return steps[i].step.Ref < steps[j].step.Ref
+		}
+		return a.Before(*b)
+	})
+	return steps
+}
+
+// WorkflowStepResolver resolves a step of a workflow execution.
+type WorkflowStepResolver struct {
+	step store.WorkflowExecutionStep
+}
+
+func (r *WorkflowStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowStepResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.step.Status)
+}
+
+func (r *WorkflowStepResolver) CapabilityID() *string {
+	if r.step.CapabilityID == "" {
+		return nil
+	}
+	return &r.step.CapabilityID
+}
+
+func (r *WorkflowStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	msg := r.step.Outputs.Err.Error()
+	return &msg
+}
+
+func (r *WorkflowStepResolver) StartedAt() *graphql.Time {
+	if r.step.StartedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.step.StartedAt}
+}
+
+// FinishedAt resolves the time the step was last updated, once it has started.
+func (r *WorkflowStepResolver) FinishedAt() *graphql.Time {
+	if r.step.StartedAt == nil || r.step.UpdatedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.step.UpdatedAt}
+}
+
+func (r *WorkflowStepResolver) DurationMs() *int32 {
+	if r.step.StartedAt == nil || r.step.UpdatedAt == nil {
+		return nil
+	}
+	duration := int32(r.step.UpdatedAt.Sub(*r.step.StartedAt).Milliseconds())
+	return &duration
+}
+
+// -- WorkflowExecution Query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: isWorkflowExecutionNotFoundError}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions Query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{
+		executions: executions,
+		total:      total,
+	}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- RerunWorkflowExecution and CancelWorkflowExecution Mutations --
+
+// workflowExecutionChangePayload resolves the errors shared by the mutations
+// of a workflow execution.
+type workflowExecutionChangePayload struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func newWorkflowExecutionChangePayload(execution *store.WorkflowExecution, err error) workflowExecutionChangePayload {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: err.Error(), isExpectedErrorFn: isWorkflowExecutionNotFoundError}
+	}
+
+	return workflowExecutionChangePayload{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *workflowExecutionChangePayload) ToWorkflowNotRunningError() (*WorkflowNotRunningErrorResolver, bool) {
+	if r.err == nil || !errors.Is(r.err, workflows.ErrWorkflowNotRunning) {
+		return nil, false
+	}
+
+	return &WorkflowNotRunningErrorResolver{message: r.err.Error()}, true
+}
+
+func (r *workflowExecutionChangePayload) ToWorkflowExecutionCannotChangeError() (*WorkflowExecutionCannotChangeErrorResolver, bool) {
+	if r.err == nil || isWorkflowExecutionNotFoundError(r.err) || errors.Is(r.err, workflows.ErrWorkflowNotRunning) {
+		return nil, false
+	}
+
+	return &WorkflowExecutionCannotChangeErrorResolver{message: r.err.Error(), code: ErrorCodeUnprocessable}, true
+}
+
+type RerunWorkflowExecutionPayloadResolver struct {
+	workflowExecutionChangePayload
+}
+
+func NewRerunWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *RerunWorkflowExecutionPayloadResolver {
+	return &RerunWorkflowExecutionPayloadResolver{newWorkflowExecutionChangePayload(execution, err)}
+}
+
+func (r *RerunWorkflowExecutionPayloadResolver) ToRerunWorkflowExecutionSuccess() (*WorkflowExecutionChangeSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &WorkflowExecutionChangeSuccessResolver{execution: *r.execution}, true
+}
+
+type CancelWorkflowExecutionPayloadResolver struct {
+	workflowExecutionChangePayload
+}
+
+func NewCancelWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *CancelWorkflowExecutionPayloadResolver {
+	return &CancelWorkflowExecutionPayloadResolver{newWorkflowExecutionChangePayload(execution, err)}
+}
+
+func (r *CancelWorkflowExecutionPayloadResolver) ToCancelWorkflowExecutionSuccess() (*WorkflowExecutionChangeSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &WorkflowExecutionChangeSuccessResolver{execution: *r.execution}, true
+}
+
+// WorkflowExecutionChangeSuccessResolver resolves the execution re-run or
+// cancelled by a mutation.
+type WorkflowExecutionChangeSuccessResolver struct {
+	execution store.WorkflowExecution
+}
+
+func (r *WorkflowExecutionChangeSuccessResolver) WorkflowExecution() *WorkflowExecutionResolver {
+	return NewWorkflowExecution(r.execution)
+}
+
+type WorkflowNotRunningErrorResolver struct {
+	message string
+}
+
+func (r *WorkflowNotRunningErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *WorkflowNotRunningErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
+
+type WorkflowExecutionCannotChangeErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *WorkflowExecutionCannotChangeErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *WorkflowExecutionCannotChangeErrorResolver) Code() ErrorCode {
+	return r.code
+}
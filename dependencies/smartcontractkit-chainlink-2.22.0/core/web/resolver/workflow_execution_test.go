@@ -0,0 +1,426 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
+)
+
+// workflowExecutionsStore serves a fixed set of executions.
+type workflowExecutionsStore struct {
+	store.Store
+	executions []store.WorkflowExecution
+	err        error
+}
+
+func (s *workflowExecutionsStore) Get(_ context.Context, executionID string) (store.WorkflowExecution, error) {
+	if s.err != nil {
+		return store.WorkflowExecution{}, s.err
+	}
+	for _, execution := range s.executions {
+		if execution.ExecutionID == executionID {
+			return execution, nil
+		}
+	}
+	return store.WorkflowExecution{}, store.ErrNotFound
+}
+
+func (s *workflowExecutionsStore) List(_ context.Context, filter store.ListFilter) ([]store.WorkflowExecution, int, error) {
+	if s.err != nil {
+		return nil, 0, s.err
+	}
+	var executions []store.WorkflowExecution
+	for _, execution := range s.executions {
+		if execution.WorkflowID != filter.WorkflowID || (filter.Status != "" && execution.Status != filter.Status) {
+			continue
+		}
+		execution.Steps = nil
+		executions = append(executions, execution)
+	}
+	return executions, len(executions), nil
+}
+
+func newWorkflowExecutionsStore(ts time.Time) *workflowExecutionsStore {
+	started, finished := ts.Add(time.Second), ts.Add(3*time.Second)
+	return &workflowExecutionsStore{executions: []store.WorkflowExecution{
+		{
+			ExecutionID: "execution-1",
+			WorkflowID:  "workflow-1",
+			Status:      store.StatusErrored,
+			CreatedAt:   &ts,
+			UpdatedAt:   &finished,
+			FinishedAt:  &finished,
+			Steps: map[string]*store.WorkflowExecutionStep{
+				"step-1": {
+					Ref:          "step-1",
+					Status:       store.StatusErrored,
+					CapabilityID: "write@1.0.0",
+					Outputs:      store.StepOutput{Err: errors.New("boom")},
+					StartedAt:    &started,
+					UpdatedAt:    &finished,
+				},
+				"trigger": {
+					Ref:    "trigger",
+					Status: store.StatusCompleted,
+				},
+			},
+		},
+		{
+			ExecutionID: "execution-2",
+			WorkflowID:  "workflow-1",
+			Status:      store.StatusCompleted,
+			CreatedAt:   &ts,
+		},
+	}}
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+					steps {
+						ref
+						status
+						capabilityID
+						error
+						startedAt
+						finishedAt
+						durationMs
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"id": "execution-1",
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution-1",
+						"workflowID": "workflow-1",
+						"status": "ERRORED",
+						"createdAt": "2021-01-01T00:00:00Z",
+						"finishedAt": "2021-01-01T00:00:03Z",
+						"steps": [{
+							"ref": "trigger",
+							"status": "COMPLETED",
+							"capabilityID": null,
+							"error": null,
+							"startedAt": null,
+							"finishedAt": null,
+							"durationMs": null
+						}, {
+							"ref": "step-1",
+							"status": "ERRORED",
+							"capabilityID": "write@1.0.0",
+							"error": "boom",
+							"startedAt": "2021-01-01T00:00:01Z",
+							"finishedAt": "2021-01-01T00:00:03Z",
+							"durationMs": 2000
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"id": "execution-3",
+			},
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(&workflowExecutionsStore{err: gError}))
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: gError,
+					Path:          []interface{}{"workflowExecution"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($status: WorkflowExecutionStatus) {
+			workflowExecutions(workflowID: "workflow-1", status: $status) {
+				results {
+					id
+					status
+					steps {
+						ref
+					}
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query: query,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"status": "ERRORED",
+							"steps": []
+						}, {
+							"id": "execution-2",
+							"status": "COMPLETED",
+							"steps": []
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`,
+		},
+		{
+			name:          "filtered by status",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query: query,
+			variables: map[string]interface{}{
+				"status": "COMPLETED",
+			},
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-2",
+							"status": "COMPLETED",
+							"steps": []
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RerunWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RerunWorkflowExecution($id: ID!, $input: RerunWorkflowExecutionInput!) {
+			rerunWorkflowExecution(id: $id, input: $input) {
+				... on RerunWorkflowExecutionSuccess {
+					workflowExecution {
+						id
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on WorkflowNotRunningError {
+					code
+					message
+				}
+				... on WorkflowExecutionCannotChangeError {
+					code
+					message
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"id": "execution-1",
+		"input": map[string]interface{}{
+			"stepRef": "step-1",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rerunWorkflowExecution"),
+		{
+			name:          "workflow not running",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"code": "UNPROCESSABLE",
+						"message": "cannot re-run execution execution-1: workflow is not running on this node"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "execution-3",
+				"input": map[string]interface{}{
+					"stepRef": "step-1",
+				},
+			},
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+		{
+			name:          "missing step",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"id": "execution-1",
+				"input": map[string]interface{}{
+					"stepRef": "",
+				},
+			},
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"code": "UNPROCESSABLE",
+						"message": "stepRef is required"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CancelWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CancelWorkflowExecution($id: ID!) {
+			cancelWorkflowExecution(id: $id) {
+				... on CancelWorkflowExecutionSuccess {
+					workflowExecution {
+						id
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on WorkflowNotRunningError {
+					code
+					message
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"id": "execution-1",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelWorkflowExecution"),
+		{
+			name:          "workflow not running",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutions").Return(workflows.NewExecutionHistory(newWorkflowExecutionsStore(f.Timestamp())))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelWorkflowExecution": {
+						"code": "UNPROCESSABLE",
+						"message": "cannot cancel execution execution-1: workflow is not running on this node"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+
+	t.Run("requires the run permission on jobs", func(t *testing.T) {
+		f := setupFramework(t)
+		ctx := auth.WithGQLAuthenticatedSession(testutils.Context(t), clsessions.User{Email: "viewer@chain.link", Role: clsessions.UserRoleView}, "viewerSession")
+
+		resp := f.RootSchema.Exec(ctx, mutation, "", variables)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, []interface{}{"cancelWorkflowExecution"}, resp.Errors[0].Path)
+	})
+}
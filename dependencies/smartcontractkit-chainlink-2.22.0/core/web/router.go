@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Depado/ginprom"
@@ -38,6 +39,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/permissions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -78,10 +80,9 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	rl := config.WebServer().RateLimit()
 	api := engine.Group(
 		"/",
-		rateLimiter(
-			rl.AuthenticatedPeriod(),
-			rl.Authenticated(),
-		),
+		reloadableRateLimiter(func() (time.Duration, int64) {
+			return rl.AuthenticatedPeriod(), rl.Authenticated()
+		}),
 		sessions.Sessions(auth.SessionName, sessionStore),
 	)
 
@@ -138,6 +139,29 @@ func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	return mgin.NewMiddleware(limiter.New(store, rate))
 }
 
+// reloadableRateLimiter is a rateLimiter which re-reads its rate on every
+// request, and starts over with a fresh limiter when the rate changes, so
+// config reloads take effect without a restart.
+func reloadableRateLimiter(rate func() (period time.Duration, limit int64)) gin.HandlerFunc {
+	var (
+		mu            sync.Mutex
+		currentPeriod time.Duration
+		currentLimit  int64
+		handler       gin.HandlerFunc
+	)
+	return func(c *gin.Context) {
+		period, limit := rate()
+		mu.Lock()
+		if handler == nil || period != currentPeriod || limit != currentLimit {
+			currentPeriod, currentLimit = period, limit
+			handler = rateLimiter(period, limit)
+		}
+		h := handler
+		mu.Unlock()
+		h(c)
+	}
+}
+
 // secureOptions configure security options for the secure middleware, mostly
 // for TLS redirection
 func secureOptions(tlsRedirect bool, tlsHost string, devWebServer bool) secure.Options {
@@ -205,10 +229,9 @@ func ginHandlerFromHTTP(h http.HandlerFunc) gin.HandlerFunc {
 func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	config := app.GetConfig()
 	rl := config.WebServer().RateLimit()
-	unauth := r.Group("/", rateLimiter(
-		rl.UnauthenticatedPeriod(),
-		rl.Unauthenticated(),
-	))
+	unauth := r.Group("/", reloadableRateLimiter(func() (time.Duration, int64) {
+		return rl.UnauthenticatedPeriod(), rl.Unauthenticated()
+	}))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
@@ -237,47 +260,77 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
+	authz := auth.NewAuthorizer(app.PermissionsORM())
+	var (
+		anyUser              = auth.ResourceOfType(permissions.ResourceUsers)
+		anyExternalInitiator = auth.ResourceOfType(permissions.ResourceExternalInitiators)
+		anyBridge            = auth.ResourceOfType(permissions.ResourceBridges)
+		anyKey               = auth.ResourceOfType(permissions.ResourceKeys)
+		anyChain             = auth.ResourceOfType(permissions.ResourceChains)
+		anyJob               = auth.ResourceOfType(permissions.ResourceJobs)
+		node                 = auth.ResourceOfType(permissions.ResourceNode)
+		keyByID              = auth.ResourceFromParam(permissions.ResourceKeys, "ID")
+		keyByKeyID           = auth.ResourceFromParam(permissions.ResourceKeys, "keyID")
+		keyByAddress         = auth.ResourceFromParam(permissions.ResourceKeys, "address")
+		jobByID              = jobResource(app)
+		jobSpecUpdate        = jobSpecUpdateResource(app)
+	)
+
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
 	))
 	{
 		uc := UserController{app}
-		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
-		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
-		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
-		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.GET("/users", authz.Requires(permissions.ActionAdmin, anyUser, uc.Index))
+		authv2.POST("/users", authz.Requires(permissions.ActionAdmin, anyUser, uc.Create))
+		authv2.PATCH("/users", authz.Requires(permissions.ActionAdmin, anyUser, uc.UpdateRole))
+		authv2.DELETE("/users/:email", authz.Requires(permissions.ActionAdmin, auth.ResourceFromParam(permissions.ResourceUsers, "email"), uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		pmc := PermissionsController{app}
+		authv2.GET("/permissions", authz.Requires(permissions.ActionAdmin, anyUser, pmc.Index))
+		authv2.POST("/permissions", authz.Requires(permissions.ActionAdmin, anyUser, pmc.Create))
+		authv2.DELETE("/permissions/:ID", authz.Requires(permissions.ActionAdmin, anyUser, pmc.Delete))
+
+		aec := AuditEventsController{app}
+		authv2.GET("/audit_events", authz.Requires(permissions.ActionAdmin, node, paginatedRequest(aec.Index)))
+
+		pfc := ProfilesController{app}
+		authv2.GET("/debug/profiles", authz.Requires(permissions.ActionView, node, pfc.Index))
+		authv2.GET("/debug/profiles/:name", authz.Requires(permissions.ActionView, node, pfc.Show))
+		authv2.GET("/debug/profiles/:name/diff", authz.Requires(permissions.ActionView, node, pfc.Diff))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.POST("/external_initiators", authz.Requires(permissions.ActionEdit, anyExternalInitiator, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", authz.Requires(permissions.ActionEdit, auth.ResourceFromParam(permissions.ResourceExternalInitiators, "Name"), eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
+		authv2.POST("/bridge_types", authz.Requires(permissions.ActionEdit, anyBridge, bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.PATCH("/bridge_types/:BridgeName", authz.Requires(permissions.ActionEdit, auth.ResourceFromParam(permissions.ResourceBridges, "BridgeName"), bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", authz.Requires(permissions.ActionEdit, auth.ResourceFromParam(permissions.ResourceBridges, "BridgeName"), bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
-		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers", authz.Requires(permissions.ActionAdmin, anyKey, ets.Create))
+		authv2.POST("/transfers/evm", authz.Requires(permissions.ActionAdmin, anyKey, ets.Create))
 		tts := CosmosTransfersController{app}
-		authv2.POST("/transfers/cosmos", auth.RequiresAdminRole(tts.Create))
+		authv2.POST("/transfers/cosmos", authz.Requires(permissions.ActionAdmin, anyKey, tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
+		authv2.POST("/transfers/solana", authz.Requires(permissions.ActionAdmin, anyKey, sts.Create))
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.GET("/config/v2", cc.Show)
+		authv2.POST("/config/reload", authz.Requires(permissions.ActionAdmin, node, cc.Reload))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -290,22 +343,22 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", authz.Requires(permissions.ActionRun, anyChain, rc.ReplayFromBlock))
 		lcaC := LCAController{app}
-		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		authv2.GET("/find_lca", authz.Requires(permissions.ActionRun, anyChain, lcaC.FindLCA))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.POST("/keys/csa", authz.Requires(permissions.ActionEdit, anyKey, csakc.Create))
+		authv2.POST("/keys/csa/import", authz.Requires(permissions.ActionAdmin, anyKey, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", authz.Requires(permissions.ActionAdmin, keyByID, csakc.Export))
 
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.POST("/keys/eth", authz.Requires(permissions.ActionEdit, anyKey, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, ekc.Delete))
+		authv2.POST("/keys/eth/import", authz.Requires(permissions.ActionAdmin, anyKey, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", authz.Requires(permissions.ActionAdmin, keyByAddress, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
@@ -316,32 +369,32 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
 		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		ethKeysGroup.POST("/keys/evm", authz.Requires(permissions.ActionEdit, anyKey, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", authz.Requires(permissions.ActionAdmin, keyByAddress, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", authz.Requires(permissions.ActionAdmin, anyKey, ekc.Import))
+		authv2.POST("/keys/evm/export/:address", authz.Requires(permissions.ActionAdmin, keyByAddress, ekc.Export))
+		ethKeysGroup.POST("/keys/evm/chain", authz.Requires(permissions.ActionAdmin, anyKey, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.POST("/keys/ocr", authz.Requires(permissions.ActionEdit, anyKey, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", authz.Requires(permissions.ActionAdmin, anyKey, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", authz.Requires(permissions.ActionAdmin, keyByID, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
 		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.POST("/keys/ocr2/:chainType", authz.Requires(permissions.ActionEdit, anyKey, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", authz.Requires(permissions.ActionAdmin, anyKey, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", authz.Requires(permissions.ActionAdmin, keyByID, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.POST("/keys/p2p", authz.Requires(permissions.ActionEdit, anyKey, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", authz.Requires(permissions.ActionAdmin, anyKey, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", authz.Requires(permissions.ActionAdmin, keyByID, p2pkc.Export))
 
 		for _, keys := range []struct {
 			path string
@@ -354,41 +407,58 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"tron", NewTronKeysController(app)},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.POST("/keys/"+keys.path, authz.Requires(permissions.ActionEdit, anyKey, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", authz.Requires(permissions.ActionAdmin, anyKey, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", authz.Requires(permissions.ActionAdmin, keyByID, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.POST("/keys/vrf", authz.Requires(permissions.ActionEdit, anyKey, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", authz.Requires(permissions.ActionAdmin, anyKey, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", authz.Requires(permissions.ActionAdmin, keyByKeyID, vrfkc.Export))
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs", authz.Requires(permissions.ActionEdit, jobSpecResource, jc.Create))
+		authv2.PUT("/jobs/:ID", authz.Requires(permissions.ActionEdit, jobByID, authz.Requires(permissions.ActionEdit, jobSpecUpdate, jc.Update)))
+		authv2.DELETE("/jobs/:ID", authz.Requires(permissions.ActionEdit, jobByID, jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		jsc := JobSourcesController{app}
+		authv2.GET("/jobs/:ID/sources", paginatedRequest(jsc.Index))
+
+		jshc := JobSourceHealthController{app}
+		authv2.GET("/jobs/:ID/source_health", jshc.Index)
+
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/:workflowID/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflow_executions/:executionID", wec.Show)
+		authv2.POST("/workflow_executions/:executionID/rerun", authz.Requires(permissions.ActionRun, anyJob, wec.Rerun))
+		authv2.POST("/workflow_executions/:executionID/cancel", authz.Requires(permissions.ActionRun, anyJob, wec.Cancel))
+		authv2.GET("/workflows/:workflowID/dead_letters", paginatedRequest(wec.DeadLetters))
+		authv2.POST("/workflow_dead_letters/:ID/replay", authz.Requires(permissions.ActionRun, anyJob, wec.Replay))
+
+		vsc := VRFSubscriptionsController{app}
+		authv2.GET("/vrf/subscriptions/at_risk", vsc.AtRisk)
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", authz.Requires(permissions.ActionEdit, anyJob, psec.Destroy))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+		authv2.PATCH("/log", authz.Requires(permissions.ActionAdmin, node, lgc.Patch))
 
 		chains := authv2.Group("chains")
 		chainController := NewChainsController(
@@ -411,8 +481,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.POST("/nodes/evm/forwarders/track", authz.Requires(permissions.ActionEdit, anyChain, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", authz.Requires(permissions.ActionEdit, anyChain, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
@@ -428,7 +498,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", authz.Requires(permissions.ActionRun, jobByID, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
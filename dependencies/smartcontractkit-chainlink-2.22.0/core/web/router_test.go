@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -195,3 +196,24 @@ func TestRouter_GinHelmetHeaders(t *testing.T) {
 			"wrong header for helmet's %s handler", tt.HelmetName)
 	}
 }
+
+func TestRouter_JobPipelineRuns(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	jb, _ := cltest.MustInsertWebhookSpec(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+
+	resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/runs", jb.ID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	count, err := cltest.ParseJSONAPIResponseMetaCount(cltest.ParseResponseBody(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// pipeline run IDs are numeric
+	resp, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/runs/not-a-run", jb.ID))
+	defer cleanup()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+}
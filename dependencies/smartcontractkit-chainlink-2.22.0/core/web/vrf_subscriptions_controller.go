@@ -0,0 +1,25 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// VRFSubscriptionsController exposes the balances of the subscriptions served
+// by VRF jobs.
+type VRFSubscriptionsController struct {
+	App chainlink.Application
+}
+
+// AtRisk lists the subscriptions whose balance does not cover the forecast
+// cost of their pending requests, along with the requests deferred until they
+// are funded.
+// Example:
+// "GET <application>/vrf/subscriptions/at_risk"
+func (vsc *VRFSubscriptionsController) AtRisk(c *gin.Context) {
+	atRisk := vsc.App.VRFSubscriptions().AtRisk()
+
+	jsonAPIResponse(c, presenters.NewVRFSubscriptionResources(atRisk), "vrfSubscriptions")
+}
@@ -0,0 +1,74 @@
+package web_test
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestVRFSubscriptionsController_AtRisk(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	t.Run("no subscriptions", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/vrf/subscriptions/at_risk")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.VRFSubscriptionResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+		assert.Empty(t, resources)
+	})
+
+	t.Run("lists the subscriptions at risk", func(t *testing.T) {
+		coordinator := testutils.NewAddress()
+		status := func(subID int64, balance int64, deferred ...string) vrfcommon.SubscriptionStatus {
+			return vrfcommon.SubscriptionStatus{
+				JobID:              1,
+				ChainID:            "1",
+				Coordinator:        coordinator,
+				Version:            vrfcommon.V2Plus,
+				SubID:              big.NewInt(subID),
+				Active:             true,
+				Balance:            big.NewInt(balance),
+				ForecastCost:       big.NewInt(100),
+				PendingRequests:    2,
+				DeferredRequestIDs: deferred,
+				UpdatedAt:          time.Now(),
+			}
+		}
+		app.VRFSubscriptions().SetJob(1, []vrfcommon.SubscriptionStatus{
+			status(1, 1000),
+			status(2, 50, "42"),
+		})
+
+		resp, cleanup := client.Get("/v2/vrf/subscriptions/at_risk")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var resources []presenters.VRFSubscriptionResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, "1-2", resources[0].ID)
+		assert.Equal(t, int32(1), resources[0].JobID)
+		assert.Equal(t, coordinator.Hex(), resources[0].CoordinatorAddress)
+		assert.Equal(t, "V2Plus", resources[0].VRFVersion)
+		assert.Equal(t, "2", resources[0].SubID)
+		assert.Equal(t, "50", resources[0].Balance)
+		assert.Equal(t, "100", resources[0].ForecastCost)
+		assert.Empty(t, resources[0].NativeBalance)
+		assert.Equal(t, []string{"42"}, resources[0].DeferredRequestIDs)
+	})
+}
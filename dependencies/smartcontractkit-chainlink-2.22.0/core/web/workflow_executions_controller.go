@@ -0,0 +1,194 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController exposes the execution history of workflows.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// WorkflowExecutionRerunRequest selects the step a failed execution is re-run from.
+type WorkflowExecutionRerunRequest struct {
+	StepRef string `json:"stepRef"`
+}
+
+// Index lists the executions of a workflow, most recent first.
+// Example:
+// "GET <application>/workflows/:workflowID/executions?status=errored&since=2024-01-01T00:00:00Z"
+//
+// Supported filters are status, since and until (RFC3339, until is exclusive).
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseWorkflowExecutionsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	filter.Offset, filter.Limit = offset, size
+	executions, count, err := wec.App.WorkflowExecutions().List(c.Request.Context(), filter)
+
+	paginatedResponse(c, "WorkflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Show returns an execution along with the timeline of its steps.
+// Example:
+// "GET <application>/workflow_executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowExecutions().Get(c.Request.Context(), c.Param("executionID"))
+	if errors.Is(err, store.ErrNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
+
+// Rerun re-executes a failed execution from the given step. The step and all
+// the steps depending on it are executed again.
+// Example:
+// "POST <application>/workflow_executions/:executionID/rerun"
+func (wec *WorkflowExecutionsController) Rerun(c *gin.Context) {
+	var request WorkflowExecutionRerunRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.StepRef == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("stepRef is required"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+	err := wec.App.WorkflowExecutions().Rerun(ctx, executionID, request.StepRef)
+	if errors.Is(err, workflows.ErrWorkflowNotRunning) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionRerun, map[string]interface{}{
+		"executionID": executionID,
+		"stepRef":     request.StepRef,
+	})
+
+	execution, err := wec.App.WorkflowExecutions().Get(ctx, executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution", http.StatusAccepted)
+}
+
+// Cancel stops a running execution. The steps that are in flight are
+// cancelled and the execution finishes with the cancelled status.
+// Example:
+// "POST <application>/workflow_executions/:executionID/cancel"
+func (wec *WorkflowExecutionsController) Cancel(c *gin.Context) {
+	ctx := c.Request.Context()
+	executionID := c.Param("executionID")
+	err := wec.App.WorkflowExecutions().Cancel(ctx, executionID)
+	if errors.Is(err, workflows.ErrWorkflowNotRunning) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionCancelled, map[string]interface{}{
+		"executionID": executionID,
+	})
+
+	execution, err := wec.App.WorkflowExecutions().Get(ctx, executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution", http.StatusAccepted)
+}
+
+// DeadLetters lists the failed executions of a workflow that can be replayed,
+// most recent first.
+// Example:
+// "GET <application>/workflows/:workflowID/dead_letters"
+func (wec *WorkflowExecutionsController) DeadLetters(c *gin.Context, size, page, offset int) {
+	deadLetters, count, err := wec.App.WorkflowExecutions().ListDeadLetters(c.Request.Context(), c.Param("workflowID"), offset, size)
+
+	paginatedResponse(c, "WorkflowDeadLetters", size, page, presenters.NewWorkflowDeadLetterResources(deadLetters), count, err)
+}
+
+// Replay starts a new execution with the trigger event of a dead letter.
+// Example:
+// "POST <application>/workflow_dead_letters/:ID/replay"
+func (wec *WorkflowExecutionsController) Replay(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	executionID, err := wec.App.WorkflowExecutions().Replay(ctx, id)
+	if errors.Is(err, workflows.ErrWorkflowNotRunning) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowDeadLetterReplayed, map[string]interface{}{
+		"deadLetterID": id,
+		"executionID":  executionID,
+	})
+
+	execution, err := wec.App.WorkflowExecutions().Get(ctx, executionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution", http.StatusAccepted)
+}
+
+func parseWorkflowExecutionsFilter(c *gin.Context) (filter store.ListFilter, err error) {
+	filter.WorkflowID = c.Param("workflowID")
+	if v := c.Query("status"); v != "" {
+		if _, ok := store.ValidStatuses[v]; !ok {
+			return filter, errors.Errorf("invalid status param: %s", v)
+		}
+		filter.Status = v
+	}
+	if v := c.Query("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid since param")
+		}
+		filter.CreatedAfter = &since
+	}
+	if v := c.Query("until"); v != "" {
+		until, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid until param")
+		}
+		filter.CreatedBefore = &until
+	}
+	return filter, nil
+}
@@ -110,7 +110,7 @@ func (cs *LogEventTriggerGRPCService) Initialise(
 
 	// Set relayer and trigger in LogEventTriggerGRPCService
 	cs.config = logEventConfig
-	triggerService, err := logevent.NewTriggerService(ctx, cs.s.Logger, relayer, logEventConfig)
+	triggerService, err := logevent.NewTriggerService(ctx, cs.s.Logger, relayer, logEventConfig, store)
 	if err != nil {
 		return fmt.Errorf("error creating trigger service for chainID %s: %w", logEventConfig.ChainID, err)
 	}